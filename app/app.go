@@ -11,9 +11,14 @@ import (
 	"github.com/SecurityBrewery/catalyst/app/mail"
 	"github.com/SecurityBrewery/catalyst/app/migration"
 	"github.com/SecurityBrewery/catalyst/app/reaction"
+	"github.com/SecurityBrewery/catalyst/app/reaction/action"
+	"github.com/SecurityBrewery/catalyst/app/reaction/action/python"
+	"github.com/SecurityBrewery/catalyst/app/reaction/queue"
 	"github.com/SecurityBrewery/catalyst/app/reaction/schedule"
 	"github.com/SecurityBrewery/catalyst/app/router"
+	"github.com/SecurityBrewery/catalyst/app/secret"
 	"github.com/SecurityBrewery/catalyst/app/service"
+	"github.com/SecurityBrewery/catalyst/app/stream"
 	"github.com/SecurityBrewery/catalyst/app/upload"
 	"github.com/SecurityBrewery/catalyst/app/webhook"
 )
@@ -21,7 +26,12 @@ import (
 type App struct {
 	Queries *sqlc.Queries
 	Hooks   *hook.Hooks
+	Venvs   *python.Cache
 	router  http.Handler
+
+	queue  *queue.Queue
+	outbox *webhook.Outbox
+	broker *stream.Broker
 }
 
 func New(ctx context.Context, dir string) (*App, func(), error) {
@@ -41,33 +51,85 @@ func New(ctx context.Context, dir string) (*App, func(), error) {
 
 	mailer := mail.New(queries)
 
-	scheduler, err := schedule.New(ctx, queries)
+	secrets, err := secret.FromEnv()
 	if err != nil {
-		return nil, cleanup, fmt.Errorf("failed to create scheduler: %w", err)
+		return nil, cleanup, err
+	}
+
+	venvs, err := python.NewCache(dir)
+	if err != nil {
+		return nil, cleanup, fmt.Errorf("failed to create python environment cache: %w", err)
 	}
 
 	hooks := hook.NewHooks()
 
-	service := service.New(queries, hooks, uploader, scheduler)
+	runner := action.NewRunner(queries, venvs, mailer, secrets)
+
+	queue := queue.New(queries, runner)
 
-	router, err := router.New(service, queries, uploader, mailer)
+	scheduler, err := schedule.New(ctx, queries, queue)
+	if err != nil {
+		return nil, cleanup, fmt.Errorf("failed to create scheduler: %w", err)
+	}
+
+	service := service.New(queries, hooks, uploader, scheduler, venvs, runner, secrets)
+
+	runner.SetRecords(service)
+
+	broker := stream.NewBroker(queries)
+
+	router, err := router.New(service, broker, queries, uploader, mailer)
 	if err != nil {
 		return nil, nil, fmt.Errorf("failed to create router: %w", err)
 	}
 
-	if err := reaction.BindHooks(hooks, router, queries, false); err != nil {
+	if err := reaction.BindHooks(hooks, router, queries, secrets, queue, runner); err != nil {
 		return nil, nil, err
 	}
 
-	webhook.BindHooks(hooks, queries)
+	outbox := webhook.NewOutbox(queries)
+
+	webhook.BindHooks(hooks, queries, outbox)
+
+	stream.BindHooks(hooks, broker)
 
 	app := &App{
 		Queries: queries,
 		Hooks:   hooks,
+		Venvs:   venvs,
 		router:  router,
+		queue:   queue,
+		outbox:  outbox,
+		broker:  broker,
 	}
 
-	return app, cleanup, nil
+	return app, func() {
+		broker.Stop()
+		outbox.Stop()
+		queue.Stop()
+		venvs.Stop()
+		cleanup()
+	}, nil
+}
+
+// Start starts the background workers of the server: the eviction of
+// Python environments, the reaction queue, the webhook outbox and the event
+// stream. CLI commands that only use the database do not start them. The
+// workers are stopped by the cleanup function of New.
+func (a *App) Start(ctx context.Context) error {
+	a.Venvs.Start(ctx)
+
+	if err := a.queue.Start(ctx); err != nil {
+		return fmt.Errorf("failed to start reaction queue: %w", err)
+	}
+
+	if err := a.outbox.Start(ctx); err != nil {
+		return fmt.Errorf("failed to start webhook outbox: %w", err)
+	}
+
+	a.broker.Start(ctx)
+
+	return nil
 }
 
 func (a *App) ServeHTTP(w http.ResponseWriter, r *http.Request) {
@@ -0,0 +1,169 @@
+package auth
+
+import (
+	"context"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"log/slog"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/SecurityBrewery/catalyst/app/auth/usercontext"
+	"github.com/SecurityBrewery/catalyst/app/database/sqlc"
+)
+
+const (
+	apiTokenPrefix       = "cat_"
+	apiTokenPrefixLength = len(apiTokenPrefix) + 6
+
+	// apiTokenUsageInterval limits how often the last usage of a token is
+	// written, so busy integrations do not write on every request.
+	apiTokenUsageInterval = time.Minute
+
+	adminPermission = "admin"
+)
+
+var (
+	// ErrInvalidAPIToken marks API tokens that cannot be created as
+	// requested, e.g. with scopes that the user does not have.
+	ErrInvalidAPIToken = errors.New("invalid api token")
+
+	// ErrAPITokenCreation is returned if the caller authenticated with an
+	// API token. A leaked token could otherwise outlive its expiry and
+	// revocation by creating new tokens.
+	ErrAPITokenCreation = errors.New("api tokens cannot create api tokens")
+)
+
+// CreateAPIToken creates a personal access token for the user. The scopes
+// must be granted to the user and to the caller of the request. Only the
+// hash of the token is stored, so the token is returned once. Callers that
+// authenticated with an API token cannot create tokens.
+func CreateAPIToken(ctx context.Context, queries *sqlc.Queries, userID, name string, scopes []string, expires *time.Time) (*sqlc.ApiToken, string, error) {
+	if _, ok := usercontext.APITokenFromContext(ctx); ok {
+		return nil, "", ErrAPITokenCreation
+	}
+
+	if name == "" {
+		return nil, "", fmt.Errorf("%w: name is required", ErrInvalidAPIToken)
+	}
+
+	if expires != nil && expires.Before(time.Now()) {
+		return nil, "", fmt.Errorf("%w: expiry must be in the future", ErrInvalidAPIToken)
+	}
+
+	userPermissions, err := queries.ListUserPermissions(ctx, userID)
+	if err != nil {
+		return nil, "", fmt.Errorf("failed to get user permissions: %w", err)
+	}
+
+	callerPermissions, _ := usercontext.PermissionFromContext(ctx)
+
+	if err := validateAPITokenScopes(scopes, userPermissions, callerPermissions); err != nil {
+		return nil, "", fmt.Errorf("%w: %w", ErrInvalidAPIToken, err)
+	}
+
+	token := apiTokenPrefix + rand.Text()
+
+	apiToken, err := queries.CreateAPIToken(ctx, sqlc.CreateAPITokenParams{
+		User:      userID,
+		Name:      name,
+		TokenHash: hashAPIToken(token),
+		Prefix:    token[:apiTokenPrefixLength],
+		Scopes:    ToJSONArray(ctx, scopes),
+		Expires:   expires,
+		Now:       time.Now().UTC(),
+	})
+	if err != nil {
+		return nil, "", fmt.Errorf("failed to create api token: %w", err)
+	}
+
+	return &apiToken, token, nil
+}
+
+func validateAPITokenScopes(scopes, userPermissions, callerPermissions []string) error {
+	if len(scopes) == 0 {
+		return errors.New("at least one scope is required")
+	}
+
+	all := All()
+
+	for _, scope := range scopes {
+		if scope != adminPermission && !slices.Contains(all, scope) {
+			return fmt.Errorf("unknown permission %q", scope)
+		}
+
+		if !HasScope(userPermissions, []string{scope}) {
+			return fmt.Errorf("user does not have permission %q", scope)
+		}
+
+		if !HasScope(callerPermissions, []string{scope}) {
+			return fmt.Errorf("cannot grant permission %q that you do not have", scope)
+		}
+	}
+
+	return nil
+}
+
+func isAPIToken(bearerToken string) bool {
+	return strings.HasPrefix(bearerToken, apiTokenPrefix)
+}
+
+func hashAPIToken(token string) string {
+	hash := sha256.Sum256([]byte(token))
+
+	return hex.EncodeToString(hash[:])
+}
+
+// verifyAPIToken returns the user of the token, the token and the scopes of
+// the token that the user still has.
+func verifyAPIToken(ctx context.Context, bearerToken, remoteAddr string, queries *sqlc.Queries) (*sqlc.User, *sqlc.ApiToken, []string, error) {
+	now := time.Now().UTC()
+
+	apiToken, err := queries.GetAPITokenByHash(ctx, sqlc.GetAPITokenByHashParams{
+		TokenHash: hashAPIToken(bearerToken),
+		Now:       &now,
+	})
+	if err != nil {
+		return nil, nil, nil, fmt.Errorf("unknown, revoked or expired api token: %w", err)
+	}
+
+	user, err := queries.GetUser(ctx, apiToken.User)
+	if err != nil {
+		return nil, nil, nil, fmt.Errorf("failed to retrieve user %s: %w", apiToken.User, err)
+	}
+
+	if !user.Active {
+		return nil, nil, nil, ErrUserInactive
+	}
+
+	permissions, err := queries.ListUserPermissions(ctx, user.ID)
+	if err != nil {
+		return nil, nil, nil, fmt.Errorf("failed to get user permissions: %w", err)
+	}
+
+	var scopes []string
+
+	for _, scope := range FromJSONArray(ctx, apiToken.Scopes) {
+		if HasScope(permissions, []string{scope}) {
+			scopes = append(scopes, scope)
+		}
+	}
+
+	if apiToken.LastUsed == nil || now.Sub(*apiToken.LastUsed) > apiTokenUsageInterval {
+		ip := remoteIP(remoteAddr)
+
+		if err := queries.UpdateAPITokenUsage(ctx, sqlc.UpdateAPITokenUsageParams{
+			Now: &now,
+			Ip:  &ip,
+			ID:  apiToken.ID,
+		}); err != nil {
+			slog.ErrorContext(ctx, "failed to update api token usage", "error", err, "token", apiToken.ID)
+		}
+	}
+
+	return &user, &apiToken, scopes, nil
+}
@@ -0,0 +1,84 @@
+package auth
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func Test_validateAPITokenScopes(t *testing.T) {
+	t.Parallel()
+
+	analyst := []string{TicketReadPermission, TicketWritePermission}
+	admin := []string{"admin"}
+
+	tests := []struct {
+		name              string
+		scopes            []string
+		userPermissions   []string
+		callerPermissions []string
+		wantErr           string
+	}{
+		{
+			name:              "subset of the user permissions",
+			scopes:            []string{TicketReadPermission},
+			userPermissions:   analyst,
+			callerPermissions: analyst,
+		},
+		{
+			name:              "admin grants all permissions",
+			scopes:            []string{"admin", WebhookWritePermission},
+			userPermissions:   admin,
+			callerPermissions: admin,
+		},
+		{
+			name:              "no scopes",
+			scopes:            []string{},
+			userPermissions:   analyst,
+			callerPermissions: analyst,
+			wantErr:           "at least one scope is required",
+		},
+		{
+			name:              "unknown permission",
+			scopes:            []string{"ticket:delete"},
+			userPermissions:   admin,
+			callerPermissions: admin,
+			wantErr:           `unknown permission "ticket:delete"`,
+		},
+		{
+			name:              "missing user permission",
+			scopes:            []string{UserWritePermission},
+			userPermissions:   analyst,
+			callerPermissions: admin,
+			wantErr:           `user does not have permission "user:write"`,
+		},
+		{
+			name:              "missing caller permission",
+			scopes:            []string{TicketWritePermission},
+			userPermissions:   analyst,
+			callerPermissions: []string{TicketReadPermission},
+			wantErr:           `cannot grant permission "ticket:write" that you do not have`,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			err := validateAPITokenScopes(tt.scopes, tt.userPermissions, tt.callerPermissions)
+			if tt.wantErr == "" {
+				assert.NoError(t, err)
+			} else {
+				assert.EqualError(t, err, tt.wantErr)
+			}
+		})
+	}
+}
+
+func Test_isAPIToken(t *testing.T) {
+	t.Parallel()
+
+	assert.True(t, isAPIToken("cat_ABCDEFGHIJKLMNOPQRSTUVWXYZ"))
+	assert.False(t, isAPIToken("eyJhbGciOiJIUzI1NiIsInR5cCI6IkpXVCJ9.e30.sig"))
+	assert.Equal(t, hashAPIToken("cat_a"), hashAPIToken("cat_a"))
+	assert.NotEqual(t, hashAPIToken("cat_a"), hashAPIToken("cat_b"))
+}
@@ -30,6 +30,25 @@ func Middleware(queries *sqlc.Queries) func(next http.Handler) http.Handler {
 			authorizationHeader := r.Header.Get("Authorization")
 			bearerToken := strings.TrimPrefix(authorizationHeader, bearerPrefix)
 
+			if isAPIToken(bearerToken) {
+				user, apiToken, scopes, err := verifyAPIToken(r.Context(), bearerToken, r.RemoteAddr, queries)
+				if err != nil {
+					slog.ErrorContext(r.Context(), "invalid api token", "error", err)
+
+					unauthorizedJSON(w, "invalid bearer token")
+
+					return
+				}
+
+				r = usercontext.UserRequest(r, user)
+				r = usercontext.PermissionRequest(r, scopes)
+				r = usercontext.APITokenRequest(r, apiToken.ID)
+
+				next.ServeHTTP(w, r)
+
+				return
+			}
+
 			user, claims, err := verifyAccessToken(r.Context(), bearerToken, queries)
 			if err != nil {
 				slog.ErrorContext(r.Context(), "invalid bearer token", "error", err)
@@ -52,11 +71,35 @@ func Middleware(queries *sqlc.Queries) func(next http.Handler) http.Handler {
 			r = usercontext.UserRequest(r, user)
 			r = usercontext.PermissionRequest(r, scopes)
 
+			if session, ok := claims["jti"].(string); ok {
+				r = usercontext.SessionRequest(r, session)
+			}
+
 			next.ServeHTTP(w, r)
 		})
 	}
 }
 
+// Verify checks the bearer token of a request again and returns its current
+// permissions. Long-lived requests like the event stream use it to end when
+// the session is revoked, the token expires or the user is deactivated.
+func Verify(ctx context.Context, queries *sqlc.Queries, r *http.Request) ([]string, error) {
+	bearerToken := strings.TrimPrefix(r.Header.Get("Authorization"), bearerPrefix)
+
+	if isAPIToken(bearerToken) {
+		_, _, scopes, err := verifyAPIToken(ctx, bearerToken, r.RemoteAddr, queries)
+
+		return scopes, err
+	}
+
+	_, claims, err := verifyAccessToken(ctx, bearerToken, queries)
+	if err != nil {
+		return nil, err
+	}
+
+	return scopes(claims)
+}
+
 func ValidateFileScopes(next http.Handler) http.Handler {
 	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
 		requiredScopes := []string{"file:read"}
@@ -120,7 +163,7 @@ func validateScopes(ctx context.Context, requiredScopes []string) error {
 			return errors.New("missing permissions")
 		}
 
-		if !hasScope(permissions, requiredScopes) {
+		if !HasScope(permissions, requiredScopes) {
 			return fmt.Errorf("missing required scopes: %v", requiredScopes)
 		}
 	}
@@ -142,7 +185,9 @@ func requiredScopes(ctx context.Context) ([]string, error) {
 	return requiredScopes, nil
 }
 
-func hasScope(scopes []string, requiredScopes []string) bool {
+// HasScope reports whether the scopes contain all required scopes. The admin
+// scope grants all scopes.
+func HasScope(scopes []string, requiredScopes []string) bool {
 	if slices.Contains(scopes, "admin") {
 		// If the user has admin scope, they can access everything
 		return true
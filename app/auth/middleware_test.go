@@ -96,7 +96,7 @@ func TestService_ValidateScopes(t *testing.T) {
 	}
 }
 
-func Test_hasScope(t *testing.T) {
+func TestHasScope(t *testing.T) {
 	t.Parallel()
 
 	type args struct {
@@ -137,7 +137,7 @@ func Test_hasScope(t *testing.T) {
 		t.Run(tt.name, func(t *testing.T) {
 			t.Parallel()
 
-			assert.Equalf(t, tt.want, hasScope(tt.args.scopes, tt.args.requiredScopes), "hasScope(%v, %v)", tt.args.scopes, tt.args.requiredScopes)
+			assert.Equalf(t, tt.want, HasScope(tt.args.scopes, tt.args.requiredScopes), "HasScope(%v, %v)", tt.args.scopes, tt.args.requiredScopes)
 		})
 	}
 }
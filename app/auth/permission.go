@@ -3,7 +3,9 @@ package auth
 import (
 	"context"
 	"encoding/json"
+	"fmt"
 	"log/slog"
+	"slices"
 )
 
 var (
@@ -23,6 +25,7 @@ var (
 	WebhookWritePermission  = "webhook:write"
 	SettingsReadPermission  = "settings:read"
 	SettingsWritePermission = "settings:write"
+	ChangesReadPermission   = "changes:read"
 )
 
 func All() []string {
@@ -43,9 +46,23 @@ func All() []string {
 		WebhookWritePermission,
 		SettingsReadPermission,
 		SettingsWritePermission,
+		ChangesReadPermission,
 	}
 }
 
+// ValidatePermissions checks that all permissions exist.
+func ValidatePermissions(permissions []string) error {
+	all := All()
+
+	for _, permission := range permissions {
+		if !slices.Contains(all, permission) {
+			return fmt.Errorf("unknown permission %q", permission)
+		}
+	}
+
+	return nil
+}
+
 func FromJSONArray(ctx context.Context, permissions string) []string {
 	var result []string
 	if err := json.Unmarshal([]byte(permissions), &result); err != nil {
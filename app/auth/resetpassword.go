@@ -163,6 +163,12 @@ func handlePassword(queries *sqlc.Queries) func(w http.ResponseWriter, r *http.R
 			return
 		}
 
+		if err := RevokeSessions(r.Context(), queries, user.ID); err != nil {
+			errorJSON(w, http.StatusInternalServerError, "Failed to revoke sessions: "+err.Error())
+
+			return
+		}
+
 		b, err := json.Marshal(map[string]any{
 			"message": "Password reset successfully",
 		})
@@ -11,13 +11,18 @@ import (
 	"github.com/SecurityBrewery/catalyst/app/mail"
 )
 
-func Server(queries *sqlc.Queries, mailer *mail.Mailer) http.Handler {
+func Server(queries *sqlc.Queries, mailer *mail.Mailer, users Users) http.Handler {
 	router := chi.NewRouter()
 
 	router.Get("/user", handleUser(queries))
+	router.Post("/logout", handleLogout(queries, false))
+	router.Post("/logout/all", handleLogout(queries, true))
 	router.Post("/local/login", handleLogin(queries))
 	router.Post("/local/reset-password-mail", handleResetPasswordMail(queries, mailer))
 	router.Post("/local/reset-password", handlePassword(queries))
+	router.Get("/providers", handleProviders(queries))
+	router.Get("/oidc/login", handleOIDCLogin(queries))
+	router.Get("/oidc/callback", handleOIDCCallback(queries, users))
 
 	return router
 }
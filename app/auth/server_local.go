@@ -59,7 +59,7 @@ func handleLogin(queries *sqlc.Queries) func(w http.ResponseWriter, r *http.Requ
 
 		duration := time.Duration(settings.RecordAuthToken.Duration) * time.Second
 
-		token, err := CreateAccessToken(r.Context(), user, permissions, duration, queries)
+		token, err := loginToken(r, user, permissions, duration, queries)
 		if err != nil {
 			errorJSON(w, http.StatusInternalServerError, "Failed to create login token")
 
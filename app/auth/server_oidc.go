@@ -0,0 +1,541 @@
+package auth
+
+import (
+	"context"
+	"crypto/rand"
+	"database/sql"
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"log/slog"
+	"net/http"
+	"net/url"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/coreos/go-oidc/v3/oidc"
+	"golang.org/x/oauth2"
+
+	"github.com/SecurityBrewery/catalyst/app/auth/usercontext"
+	"github.com/SecurityBrewery/catalyst/app/database/sqlc"
+	"github.com/SecurityBrewery/catalyst/app/openapi"
+	"github.com/SecurityBrewery/catalyst/app/settings"
+)
+
+const (
+	oidcPath         = "/auth/oidc"
+	oidcCallbackPath = oidcPath + "/callback"
+	oidcCookie       = "catalyst_oidc"
+	oidcCookieMaxAge = 10 * 60
+
+	loginPage = "/ui/login"
+)
+
+var (
+	ErrOIDCDisabled       = errors.New("oidc login is disabled")
+	ErrOIDCUserExists     = errors.New("a user exists for the email")
+	ErrOIDCIdentityExists = errors.New("the user with the email is linked to another identity")
+)
+
+// oidcState is kept in a cookie between the login redirect and the callback.
+type oidcState struct {
+	State    string `json:"state"`
+	Nonce    string `json:"nonce"`
+	Verifier string `json:"verifier"`
+}
+
+// oidcIdentity is the user described by the claims of an ID token. The
+// issuer and the subject identify the account at the identity provider.
+type oidcIdentity struct {
+	Issuer        string
+	Subject       string
+	Email         string
+	EmailVerified bool
+	Name          string
+	Username      string
+	Groups        []string
+}
+
+type oidcClient struct {
+	settings *settings.Settings
+	provider *oidc.Provider
+	config   *oauth2.Config
+}
+
+func handleProviders(queries *sqlc.Queries) func(w http.ResponseWriter, r *http.Request) {
+	return func(w http.ResponseWriter, r *http.Request) {
+		settings, err := settings.Load(r.Context(), queries)
+		if err != nil {
+			errorJSON(w, http.StatusInternalServerError, "Failed to load settings")
+
+			return
+		}
+
+		w.Header().Set("Content-Type", "application/json")
+
+		_ = json.NewEncoder(w).Encode(map[string]bool{
+			"oidc": settings.OIDC.Enabled,
+		})
+	}
+}
+
+func handleOIDCLogin(queries *sqlc.Queries) func(w http.ResponseWriter, r *http.Request) {
+	return func(w http.ResponseWriter, r *http.Request) {
+		client, err := newOIDCClient(r.Context(), queries)
+		if err != nil {
+			if errors.Is(err, ErrOIDCDisabled) {
+				errorJSON(w, http.StatusNotFound, "OIDC login is disabled")
+
+				return
+			}
+
+			slog.ErrorContext(r.Context(), "failed to load oidc provider", "error", err)
+			errorJSON(w, http.StatusInternalServerError, "Failed to load OIDC provider")
+
+			return
+		}
+
+		state := oidcState{
+			State:    rand.Text(),
+			Nonce:    rand.Text(),
+			Verifier: oauth2.GenerateVerifier(),
+		}
+
+		b, err := json.Marshal(state)
+		if err != nil {
+			errorJSON(w, http.StatusInternalServerError, "Failed to create login state")
+
+			return
+		}
+
+		http.SetCookie(w, &http.Cookie{
+			Name:     oidcCookie,
+			Value:    base64.RawURLEncoding.EncodeToString(b),
+			Path:     oidcPath,
+			MaxAge:   oidcCookieMaxAge,
+			HttpOnly: true,
+			Secure:   strings.HasPrefix(client.settings.Meta.AppURL, "https://"),
+			SameSite: http.SameSiteLaxMode,
+		})
+
+		authURL := client.config.AuthCodeURL(state.State, oidc.Nonce(state.Nonce), oauth2.S256ChallengeOption(state.Verifier))
+
+		http.Redirect(w, r, authURL, http.StatusFound)
+	}
+}
+
+// handleOIDCCallback completes the login and redirects to the login page,
+// which picks up the token or the error from the URL fragment.
+func handleOIDCCallback(queries *sqlc.Queries, users Users) func(w http.ResponseWriter, r *http.Request) {
+	return func(w http.ResponseWriter, r *http.Request) {
+		http.SetCookie(w, &http.Cookie{
+			Name:     oidcCookie,
+			Path:     oidcPath,
+			MaxAge:   -1,
+			HttpOnly: true,
+		})
+
+		token, err := loginWithOIDC(r.Context(), r, queries, users)
+		if err != nil {
+			slog.ErrorContext(r.Context(), "oidc login failed", "error", err)
+
+			message := "SSO login failed"
+			switch {
+			case errors.Is(err, ErrUserInactive):
+				message = "User is inactive"
+			case errors.Is(err, ErrOIDCUserExists):
+				message = "User already exists and is not linked to SSO"
+			case errors.Is(err, ErrOIDCIdentityExists):
+				message = "User is linked to another SSO account"
+			}
+
+			http.Redirect(w, r, loginPage+"#error="+url.QueryEscape(message), http.StatusFound)
+
+			return
+		}
+
+		http.Redirect(w, r, loginPage+"#token="+url.QueryEscape(token), http.StatusFound)
+	}
+}
+
+func newOIDCClient(ctx context.Context, queries *sqlc.Queries) (*oidcClient, error) {
+	settings, err := settings.Load(ctx, queries)
+	if err != nil {
+		return nil, fmt.Errorf("failed to load settings: %w", err)
+	}
+
+	if !settings.OIDC.Enabled {
+		return nil, ErrOIDCDisabled
+	}
+
+	provider, err := oidc.NewProvider(ctx, settings.OIDC.Issuer)
+	if err != nil {
+		return nil, fmt.Errorf("failed to discover issuer %q: %w", settings.OIDC.Issuer, err)
+	}
+
+	scopes := settings.OIDC.Scopes
+	if !slices.Contains(scopes, oidc.ScopeOpenID) {
+		scopes = append([]string{oidc.ScopeOpenID}, scopes...)
+	}
+
+	return &oidcClient{
+		settings: settings,
+		provider: provider,
+		config: &oauth2.Config{
+			ClientID:     settings.OIDC.ClientID,
+			ClientSecret: settings.OIDC.ClientSecret,
+			Endpoint:     provider.Endpoint(),
+			RedirectURL:  settings.Meta.AppURL + oidcCallbackPath,
+			Scopes:       scopes,
+		},
+	}, nil
+}
+
+func loginWithOIDC(ctx context.Context, r *http.Request, queries *sqlc.Queries, users Users) (string, error) {
+	client, err := newOIDCClient(ctx, queries)
+	if err != nil {
+		return "", err
+	}
+
+	cookie, err := r.Cookie(oidcCookie)
+	if err != nil {
+		return "", fmt.Errorf("missing login state: %w", err)
+	}
+
+	b, err := base64.RawURLEncoding.DecodeString(cookie.Value)
+	if err != nil {
+		return "", fmt.Errorf("invalid login state: %w", err)
+	}
+
+	var state oidcState
+	if err := json.Unmarshal(b, &state); err != nil {
+		return "", fmt.Errorf("invalid login state: %w", err)
+	}
+
+	query := r.URL.Query()
+
+	if query.Get("state") == "" || query.Get("state") != state.State {
+		return "", errors.New("state does not match")
+	}
+
+	if e := query.Get("error"); e != "" {
+		return "", fmt.Errorf("identity provider returned %q: %s", e, query.Get("error_description"))
+	}
+
+	oauthToken, err := client.config.Exchange(ctx, query.Get("code"), oauth2.VerifierOption(state.Verifier))
+	if err != nil {
+		return "", fmt.Errorf("failed to exchange code: %w", err)
+	}
+
+	rawIDToken, ok := oauthToken.Extra("id_token").(string)
+	if !ok {
+		return "", errors.New("missing id token")
+	}
+
+	idToken, err := client.provider.Verifier(&oidc.Config{ClientID: client.settings.OIDC.ClientID}).Verify(ctx, rawIDToken)
+	if err != nil {
+		return "", fmt.Errorf("failed to verify id token: %w", err)
+	}
+
+	if idToken.Nonce != state.Nonce {
+		return "", errors.New("nonce does not match")
+	}
+
+	var claims map[string]any
+	if err := idToken.Claims(&claims); err != nil {
+		return "", fmt.Errorf("failed to parse id token claims: %w", err)
+	}
+
+	identity, err := newOIDCIdentity(client.settings.OIDC, claims)
+	if err != nil {
+		return "", err
+	}
+
+	// the users are provisioned by the system user, like the records of
+	// reactions
+	system, err := queries.SystemUser(ctx)
+	if err != nil {
+		return "", fmt.Errorf("failed to find system user: %w", err)
+	}
+
+	systemCtx := usercontext.UserContext(ctx, &system)
+
+	user, err := provisionUser(systemCtx, queries, users, client.settings.OIDC, identity)
+	if err != nil {
+		return "", err
+	}
+
+	if err := syncGroups(systemCtx, queries, users, user.ID, client.settings.OIDC.GroupMapping, identity.Groups); err != nil {
+		return "", err
+	}
+
+	permissions, err := queries.ListUserPermissions(ctx, user.ID)
+	if err != nil {
+		return "", fmt.Errorf("failed to get user permissions: %w", err)
+	}
+
+	duration := time.Duration(client.settings.RecordAuthToken.Duration) * time.Second
+
+	return loginToken(r, user, permissions, duration, queries)
+}
+
+func newOIDCIdentity(config settings.OIDC, claims map[string]any) (*oidcIdentity, error) {
+	issuer, _ := claims["iss"].(string)
+	subject, _ := claims["sub"].(string)
+
+	if issuer == "" || subject == "" {
+		return nil, errors.New("missing iss or sub claim")
+	}
+
+	email, _ := claims[claimName(config.EmailClaim, "email")].(string)
+	if email == "" {
+		return nil, errors.New("missing email claim")
+	}
+
+	name, _ := claims[claimName(config.NameClaim, "name")].(string)
+	if name == "" {
+		name = email
+	}
+
+	username, _ := claims["preferred_username"].(string)
+	if username == "" {
+		username = email
+	}
+
+	var groups []string
+
+	switch value := claims[claimName(config.GroupsClaim, "groups")].(type) {
+	case string:
+		groups = []string{value}
+	case []any:
+		for _, group := range value {
+			if group, ok := group.(string); ok {
+				groups = append(groups, group)
+			}
+		}
+	}
+
+	return &oidcIdentity{
+		Issuer:        issuer,
+		Subject:       subject,
+		Email:         email,
+		EmailVerified: emailVerified(claims["email_verified"]),
+		Name:          name,
+		Username:      username,
+		Groups:        groups,
+	}, nil
+}
+
+// emailVerified reads the email_verified claim. Some providers send it as a
+// string instead of a boolean.
+func emailVerified(claim any) bool {
+	switch value := claim.(type) {
+	case bool:
+		return value
+	case string:
+		return strings.EqualFold(value, "true")
+	default:
+		return false
+	}
+}
+
+func claimName(name, fallback string) string {
+	if name == "" {
+		return fallback
+	}
+
+	return name
+}
+
+// Users writes the users and group memberships of SSO logins. It is
+// implemented by the service, so hooks, the change feed and webhooks see
+// provisioned users like any other change.
+type Users interface {
+	CreateUser(ctx context.Context, request openapi.CreateUserRequestObject) (openapi.CreateUserResponseObject, error)
+	UpdateUser(ctx context.Context, request openapi.UpdateUserRequestObject) (openapi.UpdateUserResponseObject, error)
+	AddUserGroup(ctx context.Context, request openapi.AddUserGroupRequestObject) (openapi.AddUserGroupResponseObject, error)
+	RemoveUserGroup(ctx context.Context, request openapi.RemoveUserGroupRequestObject) (openapi.RemoveUserGroupResponseObject, error)
+}
+
+// provisionUser returns the user of the identity. Logins are matched by the
+// issuer and the subject, the email is only used to link the first login of
+// an identity. Unknown users are created, they have no password and can only
+// log in with SSO. Existing users are only linked if the settings allow it.
+func provisionUser(ctx context.Context, queries *sqlc.Queries, users Users, config settings.OIDC, identity *oidcIdentity) (*sqlc.User, error) {
+	linked, err := queries.GetOIDCIdentity(ctx, sqlc.GetOIDCIdentityParams{Issuer: identity.Issuer, Subject: identity.Subject})
+	if err == nil {
+		user, err := getUser(ctx, queries, linked.User)
+		if err != nil {
+			return nil, err
+		}
+
+		return updateOIDCUser(ctx, queries, users, user, identity)
+	}
+
+	if !errors.Is(err, sql.ErrNoRows) {
+		return nil, fmt.Errorf("failed to find identity: %w", err)
+	}
+
+	user, err := linkOIDCUser(ctx, queries, users, config, identity)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := queries.CreateOIDCIdentity(ctx, sqlc.CreateOIDCIdentityParams{
+		Issuer:  identity.Issuer,
+		Subject: identity.Subject,
+		User:    user.ID,
+		Now:     time.Now().UTC(),
+	}); err != nil {
+		return nil, fmt.Errorf("failed to link identity: %w", err)
+	}
+
+	return user, nil
+}
+
+// linkOIDCUser returns the user with the email of a new identity or creates
+// it. Existing users are only linked if the identity provider verified the
+// email. A user that is already linked to another identity of the issuer, e.g.
+// of an account whose email was reassigned, is not linked.
+func linkOIDCUser(ctx context.Context, queries *sqlc.Queries, users Users, config settings.OIDC, identity *oidcIdentity) (*sqlc.User, error) {
+	user, err := queries.UserByEmail(ctx, &identity.Email)
+	if err == nil {
+		// an unverified email could belong to anyone, it must not sign in to
+		// the user with that email
+		if !identity.EmailVerified {
+			return nil, fmt.Errorf("email %q is not verified", identity.Email)
+		}
+
+		if !user.Active {
+			return nil, ErrUserInactive
+		}
+
+		linked, err := queries.CountOIDCIdentities(ctx, sqlc.CountOIDCIdentitiesParams{User: user.ID, Issuer: identity.Issuer})
+		if err != nil {
+			return nil, fmt.Errorf("failed to find identity: %w", err)
+		}
+
+		if linked > 0 {
+			return nil, fmt.Errorf("%w: %s", ErrOIDCIdentityExists, identity.Email)
+		}
+
+		if !config.LinkExistingUsers {
+			return nil, fmt.Errorf("%w: %s", ErrOIDCUserExists, identity.Email)
+		}
+
+		return updateOIDCUser(ctx, queries, users, &user, identity)
+	}
+
+	if !errors.Is(err, sql.ErrNoRows) {
+		return nil, fmt.Errorf("failed to find user by email %q: %w", identity.Email, err)
+	}
+
+	username := identity.Username
+	if _, err := queries.UserByUserName(ctx, username); err == nil {
+		username = identity.Email
+	}
+
+	response, err := users.CreateUser(ctx, openapi.CreateUserRequestObject{
+		Body: &openapi.NewUser{
+			Name:     &identity.Name,
+			Email:    &identity.Email,
+			Username: username,
+			Active:   true,
+		},
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to create user: %w", err)
+	}
+
+	created, ok := response.(openapi.CreateUser200JSONResponse)
+	if !ok {
+		return nil, fmt.Errorf("unexpected create user response %T", response)
+	}
+
+	return getUser(ctx, queries, created.Id)
+}
+
+// updateOIDCUser updates the name of an existing user from the identity.
+func updateOIDCUser(ctx context.Context, queries *sqlc.Queries, users Users, user *sqlc.User, identity *oidcIdentity) (*sqlc.User, error) {
+	if !user.Active {
+		return nil, ErrUserInactive
+	}
+
+	if user.Name != nil && *user.Name == identity.Name {
+		return user, nil
+	}
+
+	if _, err := users.UpdateUser(ctx, openapi.UpdateUserRequestObject{
+		Id:   user.ID,
+		Body: &openapi.UserUpdate{Name: &identity.Name},
+	}); err != nil {
+		return nil, fmt.Errorf("failed to update user: %w", err)
+	}
+
+	return getUser(ctx, queries, user.ID)
+}
+
+func getUser(ctx context.Context, queries *sqlc.Queries, id string) (*sqlc.User, error) {
+	user, err := queries.GetUser(ctx, id)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get user %s: %w", id, err)
+	}
+
+	return &user, nil
+}
+
+// syncGroups updates the direct group memberships of the user to match the
+// IdP groups. Only the Catalyst groups in the mapping are managed by the
+// IdP, memberships in all other groups are kept.
+func syncGroups(ctx context.Context, queries *sqlc.Queries, users Users, userID string, mapping map[string]string, idpGroups []string) error {
+	managed := map[string]bool{}
+	want := map[string]bool{}
+
+	for idpGroup, group := range mapping {
+		managed[group] = true
+
+		if slices.Contains(idpGroups, idpGroup) {
+			want[group] = true
+		}
+	}
+
+	groups, err := queries.ListUserGroups(ctx, userID)
+	if err != nil {
+		return fmt.Errorf("failed to list user groups: %w", err)
+	}
+
+	have := map[string]bool{}
+
+	for _, group := range groups {
+		if group.GroupType == "direct" {
+			have[group.ID] = true
+		}
+	}
+
+	for group := range want {
+		if have[group] {
+			continue
+		}
+
+		if _, err := users.AddUserGroup(ctx, openapi.AddUserGroupRequestObject{
+			Id:   userID,
+			Body: &openapi.GroupRelation{GroupId: group},
+		}); err != nil {
+			return fmt.Errorf("failed to assign group %q: %w", group, err)
+		}
+	}
+
+	for group := range have {
+		if !managed[group] || want[group] {
+			continue
+		}
+
+		if _, err := users.RemoveUserGroup(ctx, openapi.RemoveUserGroupRequestObject{Id: userID, GroupId: group}); err != nil {
+			return fmt.Errorf("failed to remove group %q: %w", group, err)
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,435 @@
+package auth_test
+
+import (
+	"encoding/base64"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/SecurityBrewery/catalyst/app/auth"
+	"github.com/SecurityBrewery/catalyst/app/auth/oidctest"
+	"github.com/SecurityBrewery/catalyst/app/data"
+	"github.com/SecurityBrewery/catalyst/app/database"
+	"github.com/SecurityBrewery/catalyst/app/database/sqlc"
+	"github.com/SecurityBrewery/catalyst/app/hook"
+	"github.com/SecurityBrewery/catalyst/app/pointer"
+	"github.com/SecurityBrewery/catalyst/app/service"
+	"github.com/SecurityBrewery/catalyst/app/settings"
+)
+
+func newOIDCTest(t *testing.T, enabled bool) (*sqlc.Queries, *oidctest.Issuer, http.Handler) {
+	t.Helper()
+
+	queries := data.NewTestDB(t, t.TempDir())
+
+	issuer, err := oidctest.NewIssuer("catalyst", "client-secret")
+	require.NoError(t, err)
+	t.Cleanup(issuer.Close)
+
+	_, err = settings.Update(t.Context(), queries, func(settings *settings.Settings) {
+		settings.Meta.AppURL = "http://catalyst.test"
+		settings.OIDC.Enabled = enabled
+		settings.OIDC.Issuer = issuer.URL
+		settings.OIDC.ClientID = "catalyst"
+		settings.OIDC.ClientSecret = "client-secret"
+		settings.OIDC.GroupsClaim = "roles"
+		settings.OIDC.GroupMapping = map[string]string{
+			"soc-admins":   "admin",
+			"soc-analysts": "analyst",
+		}
+	})
+	require.NoError(t, err)
+
+	return queries, issuer, auth.Server(queries, nil, service.New(queries, hook.NewHooks(), nil, nil, nil, nil, nil))
+}
+
+var noRedirect = &http.Client{
+	CheckRedirect: func(*http.Request, []*http.Request) error {
+		return http.ErrUseLastResponse
+	},
+}
+
+// startLogin starts the login and signs in at the issuer. It returns the
+// callback URL and the login state cookie.
+func startLogin(t *testing.T, server http.Handler) (*url.URL, *http.Cookie) {
+	t.Helper()
+
+	rec := httptest.NewRecorder()
+	server.ServeHTTP(rec, httptest.NewRequest(http.MethodGet, "/oidc/login", nil))
+
+	require.Equal(t, http.StatusFound, rec.Code)
+
+	cookies := rec.Result().Cookies()
+	require.Len(t, cookies, 1)
+
+	authURL, err := url.Parse(rec.Header().Get("Location"))
+	require.NoError(t, err)
+
+	assert.Equal(t, "S256", authURL.Query().Get("code_challenge_method"))
+	assert.Equal(t, "http://catalyst.test/auth/oidc/callback", authURL.Query().Get("redirect_uri"))
+
+	req, err := http.NewRequestWithContext(t.Context(), http.MethodGet, authURL.String(), nil)
+	require.NoError(t, err)
+
+	res, err := noRedirect.Do(req)
+	require.NoError(t, err)
+	res.Body.Close()
+
+	require.Equal(t, http.StatusFound, res.StatusCode)
+
+	callbackURL, err := url.Parse(res.Header.Get("Location"))
+	require.NoError(t, err)
+
+	return callbackURL, cookies[0]
+}
+
+// callback completes the login and returns the fragment of the redirect to
+// the login page.
+func callback(t *testing.T, server http.Handler, callbackURL *url.URL, cookie *http.Cookie) url.Values {
+	t.Helper()
+
+	req := httptest.NewRequest(http.MethodGet, "/oidc/callback?"+callbackURL.RawQuery, nil)
+	req.AddCookie(cookie)
+
+	rec := httptest.NewRecorder()
+	server.ServeHTTP(rec, req)
+
+	require.Equal(t, http.StatusFound, rec.Code)
+
+	location, err := url.Parse(rec.Header().Get("Location"))
+	require.NoError(t, err)
+	require.Equal(t, "/ui/login", location.Path)
+
+	fragment, err := url.ParseQuery(location.Fragment)
+	require.NoError(t, err)
+
+	return fragment
+}
+
+func login(t *testing.T, server http.Handler) url.Values {
+	t.Helper()
+
+	callbackURL, cookie := startLogin(t, server)
+
+	return callback(t, server, callbackURL, cookie)
+}
+
+func currentUser(t *testing.T, server http.Handler, token string) (sqlc.User, []string) {
+	t.Helper()
+
+	req := httptest.NewRequest(http.MethodGet, "/user", nil)
+	req.Header.Set("Authorization", "Bearer "+token)
+
+	rec := httptest.NewRecorder()
+	server.ServeHTTP(rec, req)
+
+	var response struct {
+		User        sqlc.User `json:"user"`
+		Permissions []string  `json:"permissions"`
+	}
+
+	require.NoError(t, json.Unmarshal(rec.Body.Bytes(), &response))
+
+	return response.User, response.Permissions
+}
+
+func directGroups(t *testing.T, queries *sqlc.Queries, userID string) []string {
+	t.Helper()
+
+	groups, err := queries.ListUserGroups(t.Context(), userID)
+	require.NoError(t, err)
+
+	var ids []string
+
+	for _, group := range groups {
+		if group.GroupType == "direct" {
+			ids = append(ids, group.ID)
+		}
+	}
+
+	return ids
+}
+
+func TestOIDC_providers(t *testing.T) {
+	t.Parallel()
+
+	_, _, server := newOIDCTest(t, true)
+
+	rec := httptest.NewRecorder()
+	server.ServeHTTP(rec, httptest.NewRequest(http.MethodGet, "/providers", nil))
+
+	assert.Equal(t, http.StatusOK, rec.Code)
+	assert.JSONEq(t, `{"oidc":true}`, rec.Body.String())
+}
+
+func TestOIDC_disabled(t *testing.T) {
+	t.Parallel()
+
+	_, _, server := newOIDCTest(t, false)
+
+	rec := httptest.NewRecorder()
+	server.ServeHTTP(rec, httptest.NewRequest(http.MethodGet, "/oidc/login", nil))
+
+	assert.Equal(t, http.StatusNotFound, rec.Code)
+}
+
+func TestOIDC_provisionUser(t *testing.T) {
+	t.Parallel()
+
+	queries, issuer, server := newOIDCTest(t, true)
+
+	issuer.SetClaims(map[string]any{
+		"sub":                "idp-user-1",
+		"email":              "sso@example.com",
+		"email_verified":     true,
+		"name":               "SSO User",
+		"preferred_username": "sso",
+		"roles":              []string{"soc-admins", "unmapped"},
+	})
+
+	fragment := login(t, server)
+	require.Empty(t, fragment.Get("error"))
+	require.NotEmpty(t, fragment.Get("token"))
+
+	user, permissions := currentUser(t, server, fragment.Get("token"))
+	assert.Equal(t, "sso", user.Username)
+	assert.Equal(t, "SSO User", *user.Name)
+	assert.Equal(t, "sso@example.com", *user.Email)
+	assert.True(t, user.Active)
+	assert.Contains(t, permissions, "admin")
+	assert.Equal(t, []string{"admin"}, directGroups(t, queries, user.ID))
+
+	// the next login updates the name and the mapped groups of the same user
+	issuer.SetClaims(map[string]any{
+		"sub":            "idp-user-1",
+		"email":          "sso@example.com",
+		"email_verified": true,
+		"name":           "Renamed User",
+		"roles":          "soc-analysts",
+	})
+
+	fragment = login(t, server)
+	require.Empty(t, fragment.Get("error"))
+
+	again, permissions := currentUser(t, server, fragment.Get("token"))
+	assert.Equal(t, user.ID, again.ID)
+	assert.Equal(t, "Renamed User", *again.Name)
+	assert.NotContains(t, permissions, "admin")
+	assert.Equal(t, []string{"analyst"}, directGroups(t, queries, user.ID))
+
+	// the writes are recorded like any other change, by the system user
+	changes, err := queries.ListChangesAfter(t.Context(), sqlc.ListChangesAfterParams{After: 0, Limit: 100})
+	require.NoError(t, err)
+
+	var recorded []string
+
+	for _, change := range changes {
+		assert.Equal(t, "system", pointer.Dereference(change.Actor))
+
+		recorded = append(recorded, change.Collection+" "+change.Action)
+	}
+
+	assert.Equal(t, []string{
+		database.UsersTable.ID + " create",
+		database.UserGroupTable.ID + " create",
+		database.UsersTable.ID + " update",
+		database.UserGroupTable.ID + " create",
+		database.UserGroupTable.ID + " delete",
+	}, recorded)
+}
+
+func TestOIDC_subject(t *testing.T) {
+	t.Parallel()
+
+	_, issuer, server := newOIDCTest(t, true)
+
+	issuer.SetClaims(map[string]any{"sub": "idp-user-1", "email": "sso@example.com", "email_verified": true})
+
+	fragment := login(t, server)
+	require.Empty(t, fragment.Get("error"))
+
+	user, _ := currentUser(t, server, fragment.Get("token"))
+
+	// the identity is matched by its subject, even if the email changes
+	issuer.SetClaims(map[string]any{"sub": "idp-user-1", "email": "renamed@example.com", "email_verified": true})
+
+	fragment = login(t, server)
+	require.Empty(t, fragment.Get("error"))
+
+	again, _ := currentUser(t, server, fragment.Get("token"))
+	assert.Equal(t, user.ID, again.ID)
+
+	// another account that got the email of the user is not linked
+	issuer.SetClaims(map[string]any{"sub": "idp-user-2", "email": "sso@example.com", "email_verified": true})
+
+	fragment = login(t, server)
+	assert.Equal(t, "User is linked to another SSO account", fragment.Get("error"))
+	assert.Empty(t, fragment.Get("token"))
+}
+
+func TestOIDC_existingUser(t *testing.T) {
+	t.Parallel()
+
+	queries, issuer, server := newOIDCTest(t, true)
+
+	// an admin-created user without a password
+	_, err := queries.CreateUser(t.Context(), sqlc.CreateUserParams{
+		Email:    pointer.Pointer("nopassword@example.com"),
+		Username: "nopassword",
+		TokenKey: "token-key",
+		Active:   true,
+	})
+	require.NoError(t, err)
+
+	// existing users are not linked by default
+	for _, email := range []string{data.AnalystEmail, "nopassword@example.com"} {
+		issuer.SetClaims(map[string]any{
+			"sub":            "idp-" + email,
+			"email":          email,
+			"email_verified": true,
+			"roles":          []string{"soc-admins"},
+		})
+
+		fragment := login(t, server)
+		assert.Equal(t, "User already exists and is not linked to SSO", fragment.Get("error"), email)
+		assert.Empty(t, fragment.Get("token"), email)
+	}
+}
+
+func TestOIDC_linkExistingUser(t *testing.T) {
+	t.Parallel()
+
+	queries, issuer, server := newOIDCTest(t, true)
+
+	_, err := settings.Update(t.Context(), queries, func(settings *settings.Settings) {
+		settings.OIDC.LinkExistingUsers = true
+	})
+	require.NoError(t, err)
+
+	issuer.SetClaims(map[string]any{
+		"sub":            "idp-bob",
+		"email":          data.AnalystEmail,
+		"email_verified": true,
+		"roles":          []string{"soc-analysts"},
+	})
+
+	fragment := login(t, server)
+	require.Empty(t, fragment.Get("error"))
+
+	user, _ := currentUser(t, server, fragment.Get("token"))
+	assert.Equal(t, "u_bob_analyst", user.ID)
+	assert.Equal(t, []string{"analyst"}, directGroups(t, queries, user.ID))
+}
+
+func TestOIDC_inactiveUser(t *testing.T) {
+	t.Parallel()
+
+	queries, issuer, server := newOIDCTest(t, true)
+
+	_, err := queries.CreateUser(t.Context(), sqlc.CreateUserParams{
+		Email:    pointer.Pointer("inactive@example.com"),
+		Username: "inactive",
+		TokenKey: "token-key",
+		Active:   false,
+	})
+	require.NoError(t, err)
+
+	issuer.SetClaims(map[string]any{"sub": "idp-inactive", "email": "inactive@example.com", "email_verified": true})
+
+	fragment := login(t, server)
+	assert.Equal(t, "User is inactive", fragment.Get("error"))
+	assert.Empty(t, fragment.Get("token"))
+}
+
+func TestOIDC_unverifiedEmail(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name    string
+		claims  map[string]any
+		wantErr string
+	}{
+		{name: "unverified", claims: map[string]any{"sub": "idp-user", "email": data.AdminEmail, "email_verified": false}, wantErr: "SSO login failed"},
+		{name: "missing", claims: map[string]any{"sub": "idp-user", "email": data.AdminEmail}, wantErr: "SSO login failed"},
+		{name: "string", claims: map[string]any{"sub": "idp-user", "email": data.AdminEmail, "email_verified": "true"}},
+		// the email of a new user is not used to link an existing one
+		{name: "new user", claims: map[string]any{"sub": "idp-user", "email": "sso@example.com"}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			queries, issuer, server := newOIDCTest(t, true)
+
+			_, err := settings.Update(t.Context(), queries, func(settings *settings.Settings) {
+				settings.OIDC.LinkExistingUsers = true
+			})
+			require.NoError(t, err)
+
+			issuer.SetClaims(tt.claims)
+
+			fragment := login(t, server)
+			assert.Equal(t, tt.wantErr, fragment.Get("error"))
+
+			if tt.wantErr != "" {
+				assert.Empty(t, fragment.Get("token"))
+
+				return
+			}
+
+			user, _ := currentUser(t, server, fragment.Get("token"))
+			assert.Equal(t, tt.claims["email"], pointer.Dereference(user.Email))
+		})
+	}
+}
+
+func TestOIDC_invalidState(t *testing.T) {
+	t.Parallel()
+
+	_, issuer, server := newOIDCTest(t, true)
+
+	issuer.SetClaims(map[string]any{"sub": "idp-user", "email": "sso@example.com"})
+
+	callbackURL, cookie := startLogin(t, server)
+
+	query := callbackURL.Query()
+	query.Set("state", "forged")
+	callbackURL.RawQuery = query.Encode()
+
+	fragment := callback(t, server, callbackURL, cookie)
+	assert.Equal(t, "SSO login failed", fragment.Get("error"))
+}
+
+func TestOIDC_invalidVerifier(t *testing.T) {
+	t.Parallel()
+
+	_, issuer, server := newOIDCTest(t, true)
+
+	issuer.SetClaims(map[string]any{"sub": "idp-user", "email": "sso@example.com"})
+
+	callbackURL, cookie := startLogin(t, server)
+
+	// replace the PKCE verifier, the issuer must reject the code exchange
+	state, err := base64.RawURLEncoding.DecodeString(cookie.Value)
+	require.NoError(t, err)
+
+	var values map[string]string
+	require.NoError(t, json.Unmarshal(state, &values))
+
+	values["verifier"] = strings.Repeat("x", 43)
+
+	state, err = json.Marshal(values)
+	require.NoError(t, err)
+
+	cookie.Value = base64.RawURLEncoding.EncodeToString(state)
+
+	fragment := callback(t, server, callbackURL, cookie)
+	assert.Equal(t, "SSO login failed", fragment.Get("error"))
+}
@@ -0,0 +1,136 @@
+package auth
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"log/slog"
+	"net"
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/SecurityBrewery/catalyst/app/database/sqlc"
+	"github.com/SecurityBrewery/catalyst/app/settings"
+)
+
+// sessionSeenInterval limits how often the last activity of a session is
+// written.
+const sessionSeenInterval = time.Minute
+
+// createSessionToken creates a session for the device and returns an access
+// token with the session ID as jti. The token is valid until the session
+// expires or is revoked.
+func createSessionToken(ctx context.Context, user *sqlc.User, permissions []string, duration time.Duration, userAgent, ip string, queries *sqlc.Queries) (string, error) {
+	settings, err := settings.Load(ctx, queries)
+	if err != nil {
+		return "", fmt.Errorf("failed to load settings: %w", err)
+	}
+
+	now := time.Now().UTC()
+
+	if err := queries.DeleteExpiredSessions(ctx, now); err != nil {
+		return "", fmt.Errorf("failed to delete expired sessions: %w", err)
+	}
+
+	session, err := queries.CreateSession(ctx, sqlc.CreateSessionParams{
+		User:      user.ID,
+		UserAgent: userAgent,
+		Ip:        ip,
+		Expires:   now.Add(duration),
+		Now:       now,
+	})
+	if err != nil {
+		return "", fmt.Errorf("failed to create session: %w", err)
+	}
+
+	return createToken(user, duration, purposeAccess, permissions, session.ID, settings.Meta.AppURL, settings.RecordAuthToken.Secret)
+}
+
+// loginToken creates the access token of a login with the device of the
+// request.
+func loginToken(r *http.Request, user *sqlc.User, permissions []string, duration time.Duration, queries *sqlc.Queries) (string, error) {
+	return createSessionToken(r.Context(), user, permissions, duration, r.UserAgent(), remoteIP(r.RemoteAddr), queries)
+}
+
+// verifySession checks that the jti of an access token belongs to an active
+// session or action run of the user.
+func verifySession(ctx context.Context, queries *sqlc.Queries, id, userID string) error {
+	now := time.Now().UTC()
+
+	session, err := queries.GetSession(ctx, sqlc.GetSessionParams{ID: id, Now: now})
+	if err == nil {
+		if session.User != userID {
+			return errors.New("session belongs to a different user")
+		}
+
+		if now.Sub(session.LastSeen) > sessionSeenInterval {
+			if err := queries.UpdateSessionLastSeen(ctx, sqlc.UpdateSessionLastSeenParams{ID: id, Now: now}); err != nil {
+				slog.ErrorContext(ctx, "failed to update session", "error", err, "session", id)
+			}
+		}
+
+		return nil
+	}
+
+	if !errors.Is(err, sql.ErrNoRows) {
+		return fmt.Errorf("failed to get session: %w", err)
+	}
+
+	// tokens of action runs are not bound to a session
+	if _, err := queries.GetActionToken(ctx, sqlc.GetActionTokenParams{ID: id, Now: now}); err != nil {
+		return fmt.Errorf("unknown session: %w", err)
+	}
+
+	return nil
+}
+
+// RevokeSessions ends all sessions of the user, e.g. when the user is
+// deactivated.
+func RevokeSessions(ctx context.Context, queries *sqlc.Queries, userID string) error {
+	return queries.DeleteUserSessions(ctx, userID)
+}
+
+func handleLogout(queries *sqlc.Queries, all bool) func(w http.ResponseWriter, r *http.Request) {
+	return func(w http.ResponseWriter, r *http.Request) {
+		authorizationHeader := r.Header.Get("Authorization")
+		bearerToken := strings.TrimPrefix(authorizationHeader, bearerPrefix)
+
+		user, claims, err := verifyAccessToken(r.Context(), bearerToken, queries)
+		if err != nil {
+			unauthorizedJSON(w, "invalid bearer token")
+
+			return
+		}
+
+		if all {
+			err = RevokeSessions(r.Context(), queries, user.ID)
+		} else {
+			id, _ := claims["jti"].(string)
+
+			_, err = queries.DeleteSession(r.Context(), sqlc.DeleteSessionParams{ID: id, User: user.ID})
+			if errors.Is(err, sql.ErrNoRows) {
+				// action tokens are revoked when the run ends
+				err = nil
+			}
+		}
+
+		if err != nil {
+			errorJSON(w, http.StatusInternalServerError, "Failed to revoke session")
+
+			return
+		}
+
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = w.Write([]byte(`{"message":"Logged out"}`))
+	}
+}
+
+func remoteIP(remoteAddr string) string {
+	if host, _, err := net.SplitHostPort(remoteAddr); err == nil {
+		return host
+	}
+
+	return remoteAddr
+}
@@ -0,0 +1,126 @@
+package auth
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/SecurityBrewery/catalyst/app/database/sqlc"
+	"github.com/SecurityBrewery/catalyst/app/settings"
+)
+
+func TestCreateSessionToken(t *testing.T) {
+	t.Parallel()
+
+	queries := testDB(t)
+
+	user, err := queries.SystemUser(t.Context())
+	require.NoError(t, err)
+
+	token, err := createSessionToken(t.Context(), &user, []string{TicketReadPermission}, time.Minute, "curl/8.0", "192.0.2.10", queries)
+	require.NoError(t, err)
+
+	_, claims, err := verifyAccessToken(t.Context(), token, queries)
+	require.NoError(t, err)
+
+	id, ok := claims["jti"].(string)
+	require.True(t, ok)
+
+	session, err := queries.GetSession(t.Context(), sqlc.GetSessionParams{ID: id, Now: time.Now()})
+	require.NoError(t, err)
+	assert.Equal(t, user.ID, session.User)
+	assert.Equal(t, "curl/8.0", session.UserAgent)
+	assert.Equal(t, "192.0.2.10", session.Ip)
+
+	require.NoError(t, RevokeSessions(t.Context(), queries, user.ID))
+
+	_, _, err = verifyAccessToken(t.Context(), token, queries)
+	assert.ErrorContains(t, err, "token revoked")
+}
+
+func TestVerifyAccessToken_NoSession(t *testing.T) {
+	t.Parallel()
+
+	queries := testDB(t)
+
+	user, err := queries.SystemUser(t.Context())
+	require.NoError(t, err)
+
+	settings, err := settings.Load(t.Context(), queries)
+	require.NoError(t, err)
+
+	// tokens issued before sessions existed have no jti
+	token, err := createToken(&user, time.Minute, purposeAccess, []string{TicketReadPermission}, "", settings.Meta.AppURL, settings.RecordAuthToken.Secret)
+	require.NoError(t, err)
+
+	_, _, err = verifyAccessToken(t.Context(), token, queries)
+	assert.EqualError(t, err, "token has no session")
+
+	// a session of another user is not accepted
+	other, err := queries.CreateUser(t.Context(), sqlc.CreateUserParams{Username: "other", TokenKey: "other-key", Active: true})
+	require.NoError(t, err)
+
+	session, err := queries.CreateSession(t.Context(), sqlc.CreateSessionParams{User: other.ID, Expires: time.Now().Add(time.Minute), Now: time.Now()})
+	require.NoError(t, err)
+
+	token, err = createToken(&user, time.Minute, purposeAccess, []string{TicketReadPermission}, session.ID, settings.Meta.AppURL, settings.RecordAuthToken.Secret)
+	require.NoError(t, err)
+
+	_, _, err = verifyAccessToken(t.Context(), token, queries)
+	assert.ErrorContains(t, err, "session belongs to a different user")
+}
+
+func TestHandleLogout(t *testing.T) {
+	t.Parallel()
+
+	queries := testDB(t)
+
+	user, err := queries.SystemUser(t.Context())
+	require.NoError(t, err)
+
+	first, err := CreateAccessToken(t.Context(), &user, nil, time.Minute, queries)
+	require.NoError(t, err)
+
+	second, err := CreateAccessToken(t.Context(), &user, nil, time.Minute, queries)
+	require.NoError(t, err)
+
+	third, err := CreateAccessToken(t.Context(), &user, nil, time.Minute, queries)
+	require.NoError(t, err)
+
+	logout := func(path, token string) int {
+		req := httptest.NewRequest(http.MethodPost, path, nil)
+		req.Header.Set("Authorization", bearerPrefix+token)
+
+		rec := httptest.NewRecorder()
+		Server(queries, nil, nil).ServeHTTP(rec, req)
+
+		return rec.Code
+	}
+
+	// logout only ends the session of the token
+	assert.Equal(t, http.StatusOK, logout("/logout", first))
+	assert.Equal(t, http.StatusUnauthorized, logout("/logout", first))
+
+	_, _, err = verifyAccessToken(t.Context(), second, queries)
+	require.NoError(t, err)
+
+	// logout of all sessions ends the remaining sessions
+	assert.Equal(t, http.StatusOK, logout("/logout/all", second))
+
+	for _, token := range []string{second, third} {
+		_, _, err = verifyAccessToken(t.Context(), token, queries)
+		assert.ErrorContains(t, err, "token revoked")
+	}
+}
+
+func Test_remoteIP(t *testing.T) {
+	t.Parallel()
+
+	assert.Equal(t, "192.0.2.10", remoteIP("192.0.2.10:51234"))
+	assert.Equal(t, "192.0.2.10", remoteIP("192.0.2.10"))
+	assert.Equal(t, "2001:db8::1", remoteIP("[2001:db8::1]:443"))
+}
@@ -17,13 +17,50 @@ const (
 	scopeReset    = "reset"
 )
 
+// CreateAccessToken creates an access token with a new session. Use
+// createSessionToken to record the device of a login.
 func CreateAccessToken(ctx context.Context, user *sqlc.User, permissions []string, duration time.Duration, queries *sqlc.Queries) (string, error) {
+	return createSessionToken(ctx, user, permissions, duration, "", "", queries)
+}
+
+// CreateActionToken creates an access token for a single run of an action.
+// The token is only valid until it expires or is revoked with
+// RevokeActionToken. It returns the token and its ID.
+func CreateActionToken(ctx context.Context, user *sqlc.User, permissions []string, duration time.Duration, queries *sqlc.Queries) (string, string, error) {
+	if err := ValidatePermissions(permissions); err != nil {
+		return "", "", err
+	}
+
 	settings, err := settings.Load(ctx, queries)
 	if err != nil {
-		return "", fmt.Errorf("failed to load settings: %w", err)
+		return "", "", fmt.Errorf("failed to load settings: %w", err)
+	}
+
+	now := time.Now().UTC()
+
+	if err := queries.DeleteExpiredActionTokens(ctx, now); err != nil {
+		return "", "", fmt.Errorf("failed to delete expired action tokens: %w", err)
+	}
+
+	actionToken, err := queries.CreateActionToken(ctx, sqlc.CreateActionTokenParams{
+		Expires: now.Add(duration),
+		Now:     now,
+	})
+	if err != nil {
+		return "", "", fmt.Errorf("failed to create action token: %w", err)
 	}
 
-	return createToken(user, duration, purposeAccess, permissions, settings.Meta.AppURL, settings.RecordAuthToken.Secret)
+	token, err := createToken(user, duration, purposeAccess, permissions, actionToken.ID, settings.Meta.AppURL, settings.RecordAuthToken.Secret)
+	if err != nil {
+		return "", "", err
+	}
+
+	return token, actionToken.ID, nil
+}
+
+// RevokeActionToken revokes a token created with CreateActionToken.
+func RevokeActionToken(ctx context.Context, queries *sqlc.Queries, id string) error {
+	return queries.DeleteActionToken(ctx, id)
 }
 
 func createResetToken(user *sqlc.User, settings *settings.Settings) (string, error) {
@@ -33,10 +70,10 @@ func createResetToken(user *sqlc.User, settings *settings.Settings) (string, err
 }
 
 func createResetTokenWithDuration(user *sqlc.User, url, appToken string, duration time.Duration) (string, error) {
-	return createToken(user, duration, purposeReset, []string{scopeReset}, url, appToken)
+	return createToken(user, duration, purposeReset, []string{scopeReset}, "", url, appToken)
 }
 
-func createToken(user *sqlc.User, duration time.Duration, purpose string, scopes []string, url, appToken string) (string, error) {
+func createToken(user *sqlc.User, duration time.Duration, purpose string, scopes []string, id, url, appToken string) (string, error) {
 	if scopes == nil {
 		scopes = []string{}
 	}
@@ -50,6 +87,10 @@ func createToken(user *sqlc.User, duration time.Duration, purpose string, scopes
 		"scopes":  scopes,
 	}
 
+	if id != "" {
+		claims["jti"] = id
+	}
+
 	token := jwt.NewWithClaims(jwt.SigningMethodHS256, claims)
 
 	signingKey := user.Tokenkey + appToken
@@ -145,6 +186,15 @@ func verifyAccessToken(ctx context.Context, bearerToken string, queries *sqlc.Qu
 		return nil, nil, fmt.Errorf("failed to check scopes: %w", err)
 	}
 
+	id, ok := claims["jti"].(string)
+	if !ok {
+		return nil, nil, fmt.Errorf("token has no session")
+	}
+
+	if err := verifySession(ctx, queries, id, user.ID); err != nil {
+		return nil, nil, fmt.Errorf("token revoked: %w", err)
+	}
+
 	return &user, claims, nil
 }
 
@@ -0,0 +1,65 @@
+package auth
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/SecurityBrewery/catalyst/app/database"
+	"github.com/SecurityBrewery/catalyst/app/database/sqlc"
+	"github.com/SecurityBrewery/catalyst/app/migration"
+	"github.com/SecurityBrewery/catalyst/app/upload"
+)
+
+func testDB(t *testing.T) *sqlc.Queries {
+	t.Helper()
+
+	dir := t.TempDir()
+
+	queries := database.TestDB(t, dir)
+
+	uploader, err := upload.New(dir)
+	require.NoError(t, err)
+
+	require.NoError(t, migration.Apply(t.Context(), queries, dir, uploader))
+
+	return queries
+}
+
+func TestCreateActionToken(t *testing.T) {
+	t.Parallel()
+
+	queries := testDB(t)
+
+	user, err := queries.SystemUser(t.Context())
+	require.NoError(t, err)
+
+	token, id, err := CreateActionToken(t.Context(), &user, []string{TicketReadPermission}, time.Minute, queries)
+	require.NoError(t, err)
+
+	_, claims, err := verifyAccessToken(t.Context(), token, queries)
+	require.NoError(t, err)
+
+	got, err := scopes(claims)
+	require.NoError(t, err)
+	assert.Equal(t, []string{TicketReadPermission}, got)
+
+	require.NoError(t, RevokeActionToken(t.Context(), queries, id))
+
+	_, _, err = verifyAccessToken(t.Context(), token, queries)
+	assert.ErrorContains(t, err, "token revoked")
+}
+
+func TestCreateActionToken_UnknownPermission(t *testing.T) {
+	t.Parallel()
+
+	queries := testDB(t)
+
+	user, err := queries.SystemUser(t.Context())
+	require.NoError(t, err)
+
+	_, _, err = CreateActionToken(t.Context(), &user, []string{"user:delete"}, time.Minute, queries)
+	assert.EqualError(t, err, `unknown permission "user:delete"`)
+}
@@ -44,3 +44,41 @@ func PermissionFromContext(ctx context.Context) ([]string, bool) {
 
 	return permissions, true
 }
+
+type sessionKey struct{}
+
+func SessionRequest(r *http.Request, session string) *http.Request {
+	return r.WithContext(SessionContext(r.Context(), session))
+}
+
+func SessionContext(ctx context.Context, session string) context.Context {
+	return context.WithValue(ctx, sessionKey{}, session)
+}
+
+func SessionFromContext(ctx context.Context) (string, bool) {
+	session, ok := ctx.Value(sessionKey{}).(string)
+	if !ok {
+		return "", false
+	}
+
+	return session, true
+}
+
+type apiTokenKey struct{}
+
+func APITokenRequest(r *http.Request, token string) *http.Request {
+	return r.WithContext(APITokenContext(r.Context(), token))
+}
+
+func APITokenContext(ctx context.Context, token string) context.Context {
+	return context.WithValue(ctx, apiTokenKey{}, token)
+}
+
+func APITokenFromContext(ctx context.Context) (string, bool) {
+	token, ok := ctx.Value(apiTokenKey{}).(string)
+	if !ok {
+		return "", false
+	}
+
+	return token, true
+}
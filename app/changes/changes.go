@@ -0,0 +1,147 @@
+package changes
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"time"
+
+	"github.com/SecurityBrewery/catalyst/app/auth/usercontext"
+	"github.com/SecurityBrewery/catalyst/app/database/sqlc"
+	"github.com/SecurityBrewery/catalyst/app/openapi"
+)
+
+const (
+	pageSize     = 100
+	pollInterval = time.Second
+)
+
+// Write runs the write in a transaction and records the change of the
+// returned record in the same transaction, so a change is recorded if and
+// only if the write is committed. Unlike the event log of the event stream,
+// the changes are never deleted, so consumers can export them with a cursor
+// after any downtime.
+//
+// Updates read the old record again in the write, with the queries of the
+// transaction, so the old record published to the after hooks is the one
+// that was replaced and not a concurrent update that was committed between
+// the before hooks and the write.
+func Write[T any](ctx context.Context, queries *sqlc.Queries, action, collection string, write func(*sqlc.Queries) (T, error)) (T, error) {
+	var record T
+
+	err := queries.WriteTx(ctx, func(tx *sqlc.Queries) error {
+		var err error
+
+		if record, err = write(tx); err != nil {
+			return err
+		}
+
+		return create(ctx, tx, action, collection, record)
+	})
+
+	return record, err
+}
+
+// Record records the change of a record in the transaction of a Write, for
+// records that the write changes in addition to the returned one, e.g. the
+// children that are deleted with a ticket.
+func Record(ctx context.Context, tx *sqlc.Queries, action, collection string, record any) error {
+	return create(ctx, tx, action, collection, record)
+}
+
+func create(ctx context.Context, queries *sqlc.Queries, action, collection string, record any) error {
+	snapshot, err := json.Marshal(record)
+	if err != nil {
+		return fmt.Errorf("failed to marshal change snapshot: %w", err)
+	}
+
+	var actor *string
+
+	if user, ok := usercontext.UserFromContext(ctx); ok {
+		actor = &user.ID
+	}
+
+	if _, err := queries.CreateChange(ctx, sqlc.CreateChangeParams{
+		Collection: collection,
+		Record:     recordID(snapshot),
+		Action:     action,
+		Actor:      actor,
+		Snapshot:   snapshot,
+		Now:        time.Now().UTC(),
+	}); err != nil {
+		return fmt.Errorf("failed to record change: %w", err)
+	}
+
+	return nil
+}
+
+// recordID returns the id of the snapshot. Relations like group
+// memberships have an id made of the ids of both records.
+func recordID(snapshot []byte) string {
+	var r struct {
+		ID string `json:"id"`
+	}
+
+	_ = json.Unmarshal(snapshot, &r)
+
+	return r.ID
+}
+
+// Map returns the API representation of a change.
+func Map(change sqlc.Change) openapi.Change {
+	var snapshot map[string]any
+	_ = json.Unmarshal(change.Snapshot, &snapshot)
+
+	return openapi.Change{
+		Seq:        change.Seq,
+		Collection: change.Collection,
+		Record:     change.Record,
+		Action:     change.Action,
+		Actor:      change.Actor,
+		Snapshot:   snapshot,
+		Created:    change.Created,
+	}
+}
+
+// Tail writes the changes after the cursor as newline delimited JSON. If
+// follow is set, it waits for new changes until the context is canceled.
+func Tail(ctx context.Context, queries *sqlc.Queries, w io.Writer, after int64, follow bool) error {
+	encoder := json.NewEncoder(w)
+
+	for {
+		changes, err := queries.ListChangesAfter(ctx, sqlc.ListChangesAfterParams{
+			After: after,
+			Limit: pageSize,
+		})
+		if err != nil {
+			if ctx.Err() != nil {
+				return nil
+			}
+
+			return fmt.Errorf("failed to list changes: %w", err)
+		}
+
+		for _, change := range changes {
+			if err := encoder.Encode(Map(change)); err != nil {
+				return err
+			}
+
+			after = change.Seq
+		}
+
+		if len(changes) == pageSize {
+			continue
+		}
+
+		if !follow {
+			return nil
+		}
+
+		select {
+		case <-ctx.Done():
+			return nil
+		case <-time.After(pollInterval):
+		}
+	}
+}
@@ -0,0 +1,143 @@
+package changes
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/SecurityBrewery/catalyst/app/auth/usercontext"
+	"github.com/SecurityBrewery/catalyst/app/data"
+	"github.com/SecurityBrewery/catalyst/app/database"
+	"github.com/SecurityBrewery/catalyst/app/database/sqlc"
+	"github.com/SecurityBrewery/catalyst/app/openapi"
+)
+
+func TestWrite(t *testing.T) {
+	t.Parallel()
+
+	queries := data.NewTestDB(t, t.TempDir())
+
+	ctx := usercontext.UserContext(t.Context(), &sqlc.User{ID: "u_bob_analyst"})
+
+	write := func(action, collection string, record any) {
+		t.Helper()
+
+		_, err := Write(ctx, queries, action, collection, func(*sqlc.Queries) (any, error) {
+			return record, nil
+		})
+		require.NoError(t, err)
+	}
+
+	write(database.CreateAction, "tickets", openapi.Ticket{Id: "test-ticket", Name: "old"})
+	write(database.UpdateAction, "tickets", openapi.Ticket{Id: "test-ticket", Name: "new"})
+	write(database.DeleteAction, "comments", openapi.ExtendedComment{Id: "c_test", Ticket: "test-ticket"})
+	write(database.DeleteAction, "user_groups", map[string]string{"id": "u_bob_analyst:analyst", "user": "u_bob_analyst", "group": "analyst"})
+
+	changes, err := queries.ListChangesAfter(t.Context(), sqlc.ListChangesAfterParams{After: 0, Limit: 10})
+	require.NoError(t, err)
+	require.Len(t, changes, 4)
+
+	assert.Equal(t, int64(1), changes[0].Seq)
+	assert.Equal(t, "create", changes[0].Action)
+	assert.Equal(t, "tickets", changes[0].Collection)
+	assert.Equal(t, "test-ticket", changes[0].Record)
+	assert.Equal(t, "u_bob_analyst", *changes[0].Actor)
+
+	assert.Equal(t, "update", changes[1].Action)
+	assert.Equal(t, "new", Map(changes[1]).Snapshot["name"])
+
+	assert.Equal(t, "delete", changes[2].Action)
+	assert.Equal(t, "c_test", changes[2].Record)
+
+	assert.Equal(t, "u_bob_analyst:analyst", changes[3].Record)
+	assert.Equal(t, "analyst", Map(changes[3]).Snapshot["group"])
+}
+
+func TestWrite_Atomic(t *testing.T) {
+	t.Parallel()
+
+	queries := data.NewTestDB(t, t.TempDir())
+
+	// a failed write records no change
+	_, err := Write(t.Context(), queries, database.CreateAction, "types", func(*sqlc.Queries) (openapi.Type, error) {
+		return openapi.Type{}, errors.New("write failed")
+	})
+	require.ErrorContains(t, err, "write failed")
+
+	// a change that cannot be recorded rolls back the write
+	_, err = Write(t.Context(), queries, database.CreateAction, "types", func(queries *sqlc.Queries) (any, error) {
+		_, err := queries.CreateType(t.Context(), sqlc.CreateTypeParams{
+			Singular: "Unrecorded",
+			Plural:   "Unrecorded",
+			Schema:   []byte(`{}`),
+		})
+
+		return make(chan int), err
+	})
+	require.ErrorContains(t, err, "failed to marshal change snapshot")
+
+	types, err := queries.ListTypes(t.Context(), sqlc.ListTypesParams{Offset: 0, Limit: 100})
+	require.NoError(t, err)
+
+	for _, typ := range types {
+		assert.NotEqual(t, "Unrecorded", typ.Singular)
+	}
+
+	changes, err := queries.ListChangesAfter(t.Context(), sqlc.ListChangesAfterParams{After: 0, Limit: 10})
+	require.NoError(t, err)
+	assert.Empty(t, changes)
+}
+
+func TestTail(t *testing.T) {
+	t.Parallel()
+
+	queries := data.NewTestDB(t, t.TempDir())
+
+	for range pageSize + 5 {
+		_, err := Write(t.Context(), queries, database.CreateAction, "types", func(*sqlc.Queries) (openapi.Type, error) {
+			return openapi.Type{Id: "alert"}, nil
+		})
+		require.NoError(t, err)
+	}
+
+	var buf bytes.Buffer
+
+	require.NoError(t, Tail(t.Context(), queries, &buf, 3, false))
+
+	lines := strings.Split(strings.TrimSpace(buf.String()), "\n")
+	require.Len(t, lines, pageSize+2)
+
+	var first, last openapi.Change
+
+	require.NoError(t, json.Unmarshal([]byte(lines[0]), &first))
+	require.NoError(t, json.Unmarshal([]byte(lines[len(lines)-1]), &last))
+
+	assert.Equal(t, int64(4), first.Seq)
+	assert.Equal(t, int64(pageSize+5), last.Seq)
+	assert.Equal(t, "alert", last.Record)
+}
+
+func TestAppendOnly(t *testing.T) {
+	t.Parallel()
+
+	queries := data.NewTestDB(t, t.TempDir())
+
+	_, err := queries.CreateChange(t.Context(), sqlc.CreateChangeParams{
+		Collection: "tickets",
+		Record:     "test-ticket",
+		Action:     "create",
+		Snapshot:   []byte(`{}`),
+	})
+	require.NoError(t, err)
+
+	_, err = queries.WriteDB.ExecContext(t.Context(), "UPDATE changes SET action = 'delete'")
+	require.ErrorContains(t, err, "changes are append-only")
+
+	_, err = queries.WriteDB.ExecContext(t.Context(), "DELETE FROM changes")
+	require.ErrorContains(t, err, "changes are append-only")
+}
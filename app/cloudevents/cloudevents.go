@@ -0,0 +1,138 @@
+// Package cloudevents encodes Catalyst events as CloudEvents 1.0 in the
+// structured or binary content mode of the HTTP protocol binding.
+package cloudevents
+
+import (
+	"crypto/rand"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"time"
+)
+
+// Formats of webhook payloads. FormatCatalyst is the plain webhook.Payload.
+const (
+	FormatCatalyst    = "catalyst"
+	FormatStructured  = "cloudevents"
+	FormatBinary      = "cloudevents-binary"
+	specVersion       = "1.0"
+	structuredType    = "application/cloudevents+json"
+	dataContentType   = "application/json"
+	defaultEventType  = "catalyst.event"
+	eventTypePrefix   = "catalyst."
+	randomIDByteCount = 16
+)
+
+// Event is a CloudEvent. Type and Subject are derived from the payload,
+// e.g. catalyst.tickets.create for the ticket with the ID in Subject.
+type Event struct {
+	SpecVersion     string          `json:"specversion"`
+	ID              string          `json:"id"`
+	Source          string          `json:"source"`
+	Type            string          `json:"type"`
+	Subject         string          `json:"subject,omitempty"`
+	Time            time.Time       `json:"time"`
+	DataContentType string          `json:"datacontenttype"`
+	Data            json.RawMessage `json:"data"`
+}
+
+// New returns an event for a webhook.Payload. Payloads without collection
+// and action get the type catalyst.event.
+func New(id, source string, t time.Time, payload json.RawMessage) *Event {
+	var p struct {
+		Action     string `json:"action"`
+		Collection string `json:"collection"`
+		Record     any    `json:"record"`
+	}
+
+	_ = json.Unmarshal(payload, &p)
+
+	eventType := defaultEventType
+	if p.Collection != "" && p.Action != "" {
+		eventType = eventTypePrefix + p.Collection + "." + p.Action
+	}
+
+	return &Event{
+		SpecVersion:     specVersion,
+		ID:              id,
+		Source:          source,
+		Type:            eventType,
+		Subject:         subject(p.Record),
+		Time:            t.UTC(),
+		DataContentType: dataContentType,
+		Data:            payload,
+	}
+}
+
+// NewID returns a random event ID.
+func NewID() (string, error) {
+	b := make([]byte, randomIDByteCount)
+	if _, err := rand.Read(b); err != nil {
+		return "", err
+	}
+
+	return hex.EncodeToString(b), nil
+}
+
+// subject returns the ID of the record. Delete events that were persisted
+// before records were published as snapshots only contain the ID.
+func subject(record any) string {
+	switch r := record.(type) {
+	case string:
+		return r
+	case map[string]any:
+		if id, ok := r["id"].(string); ok {
+			return id
+		}
+	}
+
+	return ""
+}
+
+// Encode returns the HTTP body of the event in the given format and sets
+// the headers of the format.
+func (e *Event) Encode(format string, header http.Header) ([]byte, error) {
+	switch format {
+	case FormatStructured:
+		if !json.Valid(e.Data) {
+			return nil, errors.New("cloudevents data must be JSON")
+		}
+
+		body, err := json.Marshal(e)
+		if err != nil {
+			return nil, err
+		}
+
+		header.Set("Content-Type", structuredType)
+
+		return body, nil
+	case FormatBinary:
+		header.Set("Content-Type", e.DataContentType)
+		header.Set("Ce-Specversion", e.SpecVersion)
+		header.Set("Ce-Id", e.ID)
+		header.Set("Ce-Source", e.Source)
+		header.Set("Ce-Type", e.Type)
+		header.Set("Ce-Time", e.Time.Format(time.RFC3339Nano))
+
+		if e.Subject != "" {
+			header.Set("Ce-Subject", e.Subject)
+		}
+
+		return e.Data, nil
+	default:
+		return nil, fmt.Errorf("unknown cloudevents format %q", format)
+	}
+}
+
+// ValidateFormat checks that the format is empty, catalyst or one of the
+// CloudEvents formats.
+func ValidateFormat(format string) error {
+	switch format {
+	case "", FormatCatalyst, FormatStructured, FormatBinary:
+		return nil
+	default:
+		return fmt.Errorf("invalid format %q, must be %s, %s or %s", format, FormatCatalyst, FormatStructured, FormatBinary)
+	}
+}
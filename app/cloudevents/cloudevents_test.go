@@ -0,0 +1,113 @@
+package cloudevents
+
+import (
+	"encoding/json"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNew(t *testing.T) {
+	t.Parallel()
+
+	now := time.Date(2025, 6, 21, 22, 21, 26, 0, time.UTC)
+
+	tests := []struct {
+		name        string
+		payload     string
+		wantType    string
+		wantSubject string
+	}{
+		{
+			name:        "create",
+			payload:     `{"action": "create", "collection": "tickets", "record": {"id": "test-ticket"}}`,
+			wantType:    "catalyst.tickets.create",
+			wantSubject: "test-ticket",
+		},
+		{
+			name:        "delete",
+			payload:     `{"action": "delete", "collection": "comments", "record": "c_test"}`,
+			wantType:    "catalyst.comments.delete",
+			wantSubject: "c_test",
+		},
+		{
+			name:        "other payload",
+			payload:     `{"foo": "bar"}`,
+			wantType:    "catalyst.event",
+			wantSubject: "",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			event := New("d_test", "https://catalyst.example.com", now, json.RawMessage(tt.payload))
+
+			assert.Equal(t, "1.0", event.SpecVersion)
+			assert.Equal(t, "d_test", event.ID)
+			assert.Equal(t, "https://catalyst.example.com", event.Source)
+			assert.Equal(t, tt.wantType, event.Type)
+			assert.Equal(t, tt.wantSubject, event.Subject)
+			assert.Equal(t, now, event.Time)
+		})
+	}
+}
+
+func TestEvent_Encode(t *testing.T) {
+	t.Parallel()
+
+	now := time.Date(2025, 6, 21, 22, 21, 26, 0, time.UTC)
+	event := New("d_test", "https://catalyst.example.com", now, json.RawMessage(`{"action":"update","collection":"tickets","record":{"id":"test-ticket"}}`))
+
+	header := http.Header{}
+
+	body, err := event.Encode(FormatStructured, header)
+	require.NoError(t, err)
+
+	assert.Equal(t, "application/cloudevents+json", header.Get("Content-Type"))
+	assert.JSONEq(t, `{
+		"specversion": "1.0",
+		"id": "d_test",
+		"source": "https://catalyst.example.com",
+		"type": "catalyst.tickets.update",
+		"subject": "test-ticket",
+		"time": "2025-06-21T22:21:26Z",
+		"datacontenttype": "application/json",
+		"data": {"action":"update","collection":"tickets","record":{"id":"test-ticket"}}
+	}`, string(body))
+
+	header = http.Header{}
+
+	body, err = event.Encode(FormatBinary, header)
+	require.NoError(t, err)
+
+	assert.JSONEq(t, `{"action":"update","collection":"tickets","record":{"id":"test-ticket"}}`, string(body))
+	assert.Equal(t, "application/json", header.Get("Content-Type"))
+	assert.Equal(t, "1.0", header.Get("Ce-Specversion"))
+	assert.Equal(t, "d_test", header.Get("Ce-Id"))
+	assert.Equal(t, "https://catalyst.example.com", header.Get("Ce-Source"))
+	assert.Equal(t, "catalyst.tickets.update", header.Get("Ce-Type"))
+	assert.Equal(t, "test-ticket", header.Get("Ce-Subject"))
+	assert.Equal(t, "2025-06-21T22:21:26Z", header.Get("Ce-Time"))
+
+	_, err = event.Encode(FormatCatalyst, http.Header{})
+	require.Error(t, err)
+
+	event.Data = json.RawMessage("text")
+
+	_, err = event.Encode(FormatStructured, http.Header{})
+	require.EqualError(t, err, "cloudevents data must be JSON")
+}
+
+func TestValidateFormat(t *testing.T) {
+	t.Parallel()
+
+	require.NoError(t, ValidateFormat(""))
+	require.NoError(t, ValidateFormat(FormatCatalyst))
+	require.NoError(t, ValidateFormat(FormatStructured))
+	require.NoError(t, ValidateFormat(FormatBinary))
+	require.EqualError(t, ValidateFormat("xml"), `invalid format "xml", must be catalyst, cloudevents or cloudevents-binary`)
+}
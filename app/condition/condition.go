@@ -0,0 +1,125 @@
+// Package condition evaluates CEL expressions on record events, e.g.
+// `record.type == "alert"`. It is used by hook reactions and outgoing
+// webhooks to filter the events they are triggered by.
+package condition
+
+import (
+	"encoding/json"
+	"fmt"
+	"sync"
+
+	"github.com/google/cel-go/cel"
+)
+
+var (
+	celEnv     *cel.Env
+	celEnvErr  error
+	celEnvOnce sync.Once
+
+	programs sync.Map // map[string]cel.Program
+)
+
+// env returns the CEL environment for conditions. Conditions can access
+// the record, the previous version of the record and the changed fields
+// (for updates), the user that triggered the event, the collection and the
+// event.
+func env() (*cel.Env, error) {
+	celEnvOnce.Do(func() {
+		celEnv, celEnvErr = cel.NewEnv(
+			cel.Variable("record", cel.DynType),
+			cel.Variable("old_record", cel.DynType),
+			cel.Variable("changes", cel.MapType(cel.StringType, cel.DynType)),
+			cel.Variable("auth", cel.DynType),
+			cel.Variable("collection", cel.StringType),
+			cel.Variable("event", cel.StringType),
+		)
+	})
+
+	return celEnv, celEnvErr
+}
+
+// Validate checks that a condition compiles and returns a bool.
+func Validate(condition string) error {
+	_, err := compile(condition)
+
+	return err
+}
+
+// compile parses and checks a condition. Compiled programs are cached, as
+// the same conditions are evaluated for every event.
+func compile(condition string) (cel.Program, error) {
+	if program, ok := programs.Load(condition); ok {
+		return program.(cel.Program), nil //nolint:forcetypeassert
+	}
+
+	env, err := env()
+	if err != nil {
+		return nil, fmt.Errorf("failed to create condition environment: %w", err)
+	}
+
+	ast, issues := env.Compile(condition)
+	if issues != nil && issues.Err() != nil {
+		return nil, fmt.Errorf("invalid condition: %w", issues.Err())
+	}
+
+	if ast.OutputType() != cel.BoolType && ast.OutputType() != cel.DynType {
+		return nil, fmt.Errorf("invalid condition: must return a bool, not %s", ast.OutputType())
+	}
+
+	program, err := env.Program(ast)
+	if err != nil {
+		return nil, fmt.Errorf("invalid condition: %w", err)
+	}
+
+	programs.Store(condition, program)
+
+	return program, nil
+}
+
+// Match reports whether the condition is true for the given variables. An
+// empty condition always matches.
+func Match(condition string, vars map[string]any) (bool, error) {
+	if condition == "" {
+		return true, nil
+	}
+
+	program, err := compile(condition)
+	if err != nil {
+		return false, err
+	}
+
+	out, _, err := program.Eval(vars)
+	if err != nil {
+		return false, fmt.Errorf("failed to evaluate condition: %w", err)
+	}
+
+	matched, ok := out.Value().(bool)
+	if !ok {
+		return false, fmt.Errorf("condition returned %T, not bool", out.Value())
+	}
+
+	return matched, nil
+}
+
+// Vars returns the condition variables for a webhook.Payload.
+func Vars(collection, event string, payload json.RawMessage) (map[string]any, error) {
+	var p struct {
+		Record    any            `json:"record"`
+		OldRecord any            `json:"old_record"`
+		Changes   map[string]any `json:"changes"`
+		Auth      any            `json:"auth"`
+	}
+
+	if err := json.Unmarshal(payload, &p); err != nil {
+		return nil, fmt.Errorf("failed to unmarshal payload: %w", err)
+	}
+
+	return map[string]any{
+		"record":     p.Record,
+		"old_record": p.OldRecord,
+		"changes":    p.Changes,
+		"auth":       p.Auth,
+		"collection": collection,
+		"event":      event,
+	}, nil
+}
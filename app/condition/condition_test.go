@@ -0,0 +1,36 @@
+package condition
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestValidate(t *testing.T) {
+	t.Parallel()
+
+	require.NoError(t, Validate(`record.type == "alert"`))
+	require.NoError(t, Validate(`"owner" in changes`))
+	require.ErrorContains(t, Validate(`collection`), "must return a bool")
+	require.ErrorContains(t, Validate(`unknown == 1`), "invalid condition")
+}
+
+func TestVars(t *testing.T) {
+	t.Parallel()
+
+	vars, err := Vars("tickets", "update", json.RawMessage(`{
+		"record": {"owner": "u_admin"},
+		"old_record": {"owner": "u_bob_analyst"},
+		"changes": {"owner": {"old": "u_bob_analyst", "new": "u_admin"}}
+	}`))
+	require.NoError(t, err)
+
+	matched, err := Match(`collection == "tickets" && event == "update" && old_record.owner != record.owner && "owner" in changes`, vars)
+	require.NoError(t, err)
+	assert.True(t, matched)
+
+	_, err = Vars("tickets", "update", json.RawMessage(`not json`))
+	assert.Error(t, err)
+}
@@ -275,6 +275,7 @@ func generateDemoReactions(ctx context.Context, queries *sqlc.Queries, ticketCou
 		Actiondata: marshal(map[string]any{
 			"requirements": "requests",
 			"script":       createTicketPy,
+			"permissions":  []any{"ticket:read", "ticket:write"},
 		}),
 		Created: created,
 		Updated: updated,
@@ -294,6 +295,7 @@ func generateDemoReactions(ctx context.Context, queries *sqlc.Queries, ticketCou
 		Actiondata: marshal(map[string]any{
 			"requirements": "requests",
 			"script":       alertIngestPy,
+			"permissions":  []any{"ticket:write"},
 		}),
 		Created: created,
 		Updated: updated,
@@ -313,6 +315,7 @@ func generateDemoReactions(ctx context.Context, queries *sqlc.Queries, ticketCou
 		Actiondata: marshal(map[string]any{
 			"requirements": "requests",
 			"script":       assignTicketsPy,
+			"permissions":  []any{"user:read", "ticket:write"},
 		}),
 		Created: created,
 		Updated: updated,
@@ -65,6 +65,10 @@ func DefaultTestData(t *testing.T, dir string, queries *sqlc.Queries) {
 		Name:        "Test Webhook",
 		Collection:  "tickets",
 		Destination: "https://example.com",
+		Events:      "[]",
+		Secret:      "whsec_test",
+		Headers:     "{}",
+		Format:      "catalyst",
 		Created:     parseTime("2025-06-21T22:21:26.271Z"),
 		Updated:     parseTime("2025-06-21T22:21:26.271Z"),
 	})
@@ -194,6 +198,20 @@ func DefaultTestData(t *testing.T, dir string, queries *sqlc.Queries) {
 	})
 	require.NoError(t, err, "failed to insert reaction")
 
+	// Insert reaction runs
+	_, err = queries.InsertReactionRun(ctx, sqlc.InsertReactionRunParams{
+		ID:       "x_test_run",
+		Reaction: "r-test-webhook",
+		Trigger:  "webhook",
+		Status:   "success",
+		Input:    json.RawMessage(`{"method":"GET","path":"/reaction/test"}`),
+		Output:   "Hello, World!\n",
+		ExitCode: pointer.Pointer(int64(0)),
+		Started:  parseTime("2025-06-21T22:21:26.271Z"),
+		Finished: pointer.Pointer(parseTime("2025-06-21T22:21:27.271Z")),
+	})
+	require.NoError(t, err, "failed to insert reaction run")
+
 	// Insert user_groups
 	err = queries.AssignGroupToUser(ctx, sqlc.AssignGroupToUserParams{
 		UserID:  "u_bob_analyst",
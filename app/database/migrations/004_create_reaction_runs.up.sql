@@ -0,0 +1,17 @@
+CREATE TABLE reaction_runs
+(
+    id        TEXT PRIMARY KEY DEFAULT ('x' || lower(hex(randomblob(7)))) NOT NULL,
+    reaction  TEXT                                                        NOT NULL,
+    trigger   TEXT                                                        NOT NULL,
+    status    TEXT                                                        NOT NULL, -- one of 'running', 'success', 'failed'
+    input     JSON                                                        NOT NULL,
+    output    TEXT                                                        NOT NULL DEFAULT '',
+    error     TEXT,
+    exit_code INTEGER,
+    started   DATETIME         DEFAULT CURRENT_TIMESTAMP                  NOT NULL,
+    finished  DATETIME,
+
+    FOREIGN KEY (reaction) REFERENCES reactions (id) ON DELETE CASCADE
+);
+
+CREATE INDEX reaction_runs_reaction_started ON reaction_runs (reaction, started);
@@ -0,0 +1,19 @@
+CREATE TABLE reaction_jobs
+(
+    id           TEXT PRIMARY KEY DEFAULT ('j' || lower(hex(randomblob(7)))) NOT NULL,
+    reaction     TEXT                                                        NOT NULL,
+    trigger      TEXT                                                        NOT NULL,
+    payload      JSON                                                        NOT NULL,
+    status       TEXT                                                        NOT NULL, -- one of 'pending', 'running', 'dead'
+    attempts     INTEGER          DEFAULT 0                                  NOT NULL,
+    max_attempts INTEGER          DEFAULT 5                                  NOT NULL,
+    last_error   TEXT,
+    run          TEXT, -- the run reported to the caller before the job is processed, e.g. for asynchronous webhooks
+    run_after    DATETIME                                                    NOT NULL,
+    created      DATETIME         DEFAULT CURRENT_TIMESTAMP                  NOT NULL,
+    updated      DATETIME         DEFAULT CURRENT_TIMESTAMP                  NOT NULL,
+
+    FOREIGN KEY (reaction) REFERENCES reactions (id) ON DELETE CASCADE
+);
+
+CREATE INDEX reaction_jobs_status_run_after ON reaction_jobs (status, run_after);
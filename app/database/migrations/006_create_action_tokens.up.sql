@@ -0,0 +1,9 @@
+-- Tokens that are issued to actions, e.g. Python scripts, for the duration
+-- of a single run. A token is only valid while its row exists, so it can be
+-- revoked when the run ends.
+CREATE TABLE action_tokens
+(
+    id      TEXT PRIMARY KEY DEFAULT ('k' || lower(hex(randomblob(7)))) NOT NULL,
+    expires DATETIME                                                    NOT NULL,
+    created DATETIME         DEFAULT CURRENT_TIMESTAMP                  NOT NULL
+);
@@ -0,0 +1,8 @@
+CREATE TABLE secrets
+(
+    id      TEXT PRIMARY KEY DEFAULT ('s' || lower(hex(randomblob(7)))) NOT NULL,
+    name    TEXT UNIQUE                                                 NOT NULL,
+    value   BLOB                                                        NOT NULL, -- encrypted with AES-256-GCM
+    created DATETIME         DEFAULT CURRENT_TIMESTAMP                  NOT NULL,
+    updated DATETIME         DEFAULT CURRENT_TIMESTAMP                  NOT NULL
+);
@@ -0,0 +1,5 @@
+ALTER TABLE webhooks
+    ADD COLUMN events TEXT DEFAULT '[]' NOT NULL; -- JSON array string like '["create","update"]', empty for all events
+
+ALTER TABLE webhooks
+    ADD COLUMN filter TEXT DEFAULT '' NOT NULL; -- CEL expression on the payload, empty for all records
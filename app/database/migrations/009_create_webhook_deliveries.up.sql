@@ -0,0 +1,22 @@
+CREATE TABLE webhook_deliveries
+(
+    id              TEXT PRIMARY KEY DEFAULT ('d' || lower(hex(randomblob(7)))) NOT NULL,
+    webhook         TEXT                                                        NOT NULL,
+    event           TEXT                                                        NOT NULL,
+    collection      TEXT                                                        NOT NULL,
+    payload         JSON                                                        NOT NULL,
+    status          TEXT                                                        NOT NULL, -- one of 'pending', 'running', 'delivered', 'failed'
+    attempts        INTEGER          DEFAULT 0                                  NOT NULL,
+    max_attempts    INTEGER          DEFAULT 12                                 NOT NULL,
+    response_status INTEGER,
+    response_body   TEXT,                                                                 -- truncated
+    last_error      TEXT,
+    next_attempt    DATETIME                                                    NOT NULL,
+    created         DATETIME         DEFAULT CURRENT_TIMESTAMP                  NOT NULL,
+    updated         DATETIME         DEFAULT CURRENT_TIMESTAMP                  NOT NULL,
+
+    FOREIGN KEY (webhook) REFERENCES webhooks (id) ON DELETE CASCADE
+);
+
+CREATE INDEX webhook_deliveries_status_next_attempt ON webhook_deliveries (status, next_attempt);
+CREATE INDEX webhook_deliveries_webhook_created ON webhook_deliveries (webhook, created);
@@ -0,0 +1,8 @@
+ALTER TABLE webhooks
+    ADD COLUMN secret TEXT DEFAULT '' NOT NULL; -- HMAC-SHA256 key to sign the payloads
+
+ALTER TABLE webhooks
+    ADD COLUMN headers TEXT DEFAULT '{}' NOT NULL; -- JSON object string like '{"Authorization":"Bearer ..."}'
+
+UPDATE webhooks
+SET secret = 'whsec_' || lower(hex(randomblob(32)));
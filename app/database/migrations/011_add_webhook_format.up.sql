@@ -0,0 +1,2 @@
+ALTER TABLE webhooks
+    ADD COLUMN format TEXT DEFAULT 'catalyst' NOT NULL; -- catalyst, cloudevents or cloudevents-binary
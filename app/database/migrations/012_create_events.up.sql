@@ -0,0 +1,12 @@
+CREATE TABLE events
+(
+    id         INTEGER PRIMARY KEY AUTOINCREMENT,          -- sequence for the Last-Event-ID of the event stream
+    action     TEXT                               NOT NULL, -- one of 'create', 'update', 'delete'
+    collection TEXT                               NOT NULL,
+    record     TEXT                               NOT NULL, -- ID of the record
+    ticket     TEXT,                                        -- ID of the ticket the record belongs to
+    payload    JSON                               NOT NULL,
+    created    DATETIME DEFAULT CURRENT_TIMESTAMP NOT NULL
+);
+
+CREATE INDEX events_created ON events (created);
@@ -0,0 +1,24 @@
+CREATE TABLE changes
+(
+    seq        INTEGER PRIMARY KEY AUTOINCREMENT,          -- monotonic cursor of the change feed
+    collection TEXT                               NOT NULL,
+    record     TEXT                               NOT NULL, -- ID of the record
+    action     TEXT                               NOT NULL, -- one of 'create', 'update', 'delete'
+    actor      TEXT,                                        -- ID of the user that made the change
+    snapshot   JSON                               NOT NULL, -- the record after the change, or before the deletion
+    created    DATETIME DEFAULT CURRENT_TIMESTAMP NOT NULL
+);
+
+CREATE TRIGGER changes_no_update
+    BEFORE UPDATE
+    ON changes
+BEGIN
+    SELECT RAISE(ABORT, 'changes are append-only');
+END;
+
+CREATE TRIGGER changes_no_delete
+    BEFORE DELETE
+    ON changes
+BEGIN
+    SELECT RAISE(ABORT, 'changes are append-only');
+END;
@@ -0,0 +1,19 @@
+-- Personal access tokens of users and service accounts. Only the SHA-256
+-- hash of a token is stored, the token itself is shown once on creation.
+CREATE TABLE api_tokens
+(
+    id           TEXT PRIMARY KEY DEFAULT ('a' || lower(hex(randomblob(7)))) NOT NULL,
+    user         TEXT                                                        NOT NULL,
+    name         TEXT                                                        NOT NULL,
+    token_hash   TEXT UNIQUE                                                 NOT NULL,
+    prefix       TEXT                                                        NOT NULL, -- first characters of the token to recognize it
+    scopes       TEXT                                                        NOT NULL, -- JSON array, a subset of the user's permissions
+    expires      DATETIME,
+    last_used    DATETIME,
+    last_used_ip TEXT,
+    created      DATETIME         DEFAULT CURRENT_TIMESTAMP                  NOT NULL,
+
+    FOREIGN KEY (user) REFERENCES users (id) ON DELETE CASCADE
+);
+
+CREATE INDEX api_tokens_user ON api_tokens (user);
@@ -0,0 +1,18 @@
+-- Sessions of the access tokens that are issued on login. The session ID is
+-- the jti of the token and a token is only valid while its session exists,
+-- so sessions can be revoked. Tokens issued before this migration have no
+-- session and are no longer accepted.
+CREATE TABLE sessions
+(
+    id         TEXT PRIMARY KEY DEFAULT ('e' || lower(hex(randomblob(7)))) NOT NULL,
+    user       TEXT                                                        NOT NULL,
+    user_agent TEXT                                                        NOT NULL,
+    ip         TEXT                                                        NOT NULL,
+    expires    DATETIME                                                    NOT NULL,
+    last_seen  DATETIME                                                    NOT NULL,
+    created    DATETIME         DEFAULT CURRENT_TIMESTAMP                  NOT NULL,
+
+    FOREIGN KEY (user) REFERENCES users (id) ON DELETE CASCADE
+);
+
+CREATE INDEX sessions_user ON sessions (user);
@@ -0,0 +1,16 @@
+-- Identities of SSO logins. A login is matched by the issuer and the
+-- subject of the ID token, which are stable, while the email of an account
+-- at the identity provider can change or be reassigned. The email is only
+-- used to link the first login to a user.
+CREATE TABLE oidc_identities
+(
+    issuer  TEXT                               NOT NULL,
+    subject TEXT                               NOT NULL,
+    user    TEXT                               NOT NULL,
+    created DATETIME DEFAULT CURRENT_TIMESTAMP NOT NULL,
+
+    PRIMARY KEY (issuer, subject),
+    FOREIGN KEY (user) REFERENCES users (id) ON DELETE CASCADE
+);
+
+CREATE INDEX oidc_identities_user ON oidc_identities (user);
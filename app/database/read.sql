@@ -12,6 +12,46 @@ FROM tickets
          LEFT JOIN types ON types.id = tickets.type
 WHERE tickets.id = @id;
 
+-- name: TicketChildCounts :one
+SELECT (SELECT COUNT(*) FROM comments WHERE comments.ticket = @id) as comments,
+       (SELECT COUNT(*) FROM tasks WHERE tasks.ticket = @id)       as tasks,
+       (SELECT COUNT(*) FROM files WHERE files.ticket = @id)       as files,
+       (SELECT COUNT(*) FROM links WHERE links.ticket = @id)       as links,
+       (SELECT COUNT(*) FROM timeline WHERE timeline.ticket = @id) as timeline;
+
+-- name: ListTicketComments :many
+SELECT comments.*, users.name as author_name
+FROM comments
+         LEFT JOIN users ON users.id = comments.author
+WHERE comments.ticket = @ticket
+ORDER BY comments.created;
+
+-- name: ListTicketTasks :many
+SELECT tasks.*, users.name as owner_name, tickets.name as ticket_name, tickets.type as ticket_type
+FROM tasks
+         LEFT JOIN users ON users.id = tasks.owner
+         LEFT JOIN tickets ON tickets.id = tasks.ticket
+WHERE tasks.ticket = @ticket
+ORDER BY tasks.created;
+
+-- name: ListTicketFiles :many
+SELECT *
+FROM files
+WHERE ticket = @ticket
+ORDER BY created;
+
+-- name: ListTicketLinks :many
+SELECT *
+FROM links
+WHERE ticket = @ticket
+ORDER BY created;
+
+-- name: ListTicketTimeline :many
+SELECT *
+FROM timeline
+WHERE ticket = @ticket
+ORDER BY created;
+
 -- name: ListTickets :many
 SELECT tickets.*,
        users.name       as owner_name,
@@ -104,6 +144,24 @@ WHERE trigger = @trigger
 ORDER BY reactions.created DESC
 LIMIT @limit OFFSET @offset;
 
+-- name: GetReactionRun :one
+SELECT *
+FROM reaction_runs
+WHERE id = @id
+  AND reaction = @reaction;
+
+-- name: ListReactionRuns :many
+SELECT reaction_runs.*, COUNT(*) OVER () as total_count
+FROM reaction_runs
+WHERE reaction = @reaction
+ORDER BY reaction_runs.started DESC
+LIMIT @limit OFFSET @offset;
+
+-- name: GetReactionJob :one
+SELECT *
+FROM reaction_jobs
+WHERE id = @id;
+
 ------------------------------------------------------------------
 
 -- name: GetTask :one
@@ -197,6 +255,26 @@ FROM webhooks
 ORDER BY created DESC
 LIMIT @limit OFFSET @offset;
 
+-- name: ListWebhooksByCollection :many
+SELECT webhooks.*, COUNT(*) OVER () as total_count
+FROM webhooks
+WHERE collection = @collection
+ORDER BY created DESC
+LIMIT @limit OFFSET @offset;
+
+-- name: GetWebhookDelivery :one
+SELECT *
+FROM webhook_deliveries
+WHERE id = @id
+  AND webhook = @webhook;
+
+-- name: ListWebhookDeliveries :many
+SELECT webhook_deliveries.*, COUNT(*) OVER () as total_count
+FROM webhook_deliveries
+WHERE webhook = @webhook
+ORDER BY created DESC
+LIMIT @limit OFFSET @offset;
+
 ------------------------------------------------------------------
 
 -- name: GetDashboardCounts :many
@@ -283,3 +361,92 @@ SELECT group_effective_permissions.permission
 FROM group_effective_permissions
 WHERE parent_group_id = @group_id
 ORDER BY permission;
+
+------------------------------------------------------------------
+
+-- name: GetActionToken :one
+SELECT *
+FROM action_tokens
+WHERE id = @id
+  AND expires > @now;
+
+------------------------------------------------------------------
+
+-- name: GetSecret :one
+SELECT *
+FROM secrets
+WHERE id = @id;
+
+-- name: GetSecretByName :one
+SELECT *
+FROM secrets
+WHERE name = @name;
+
+-- name: ListSecrets :many
+SELECT secrets.*, COUNT(*) OVER () as total_count
+FROM secrets
+ORDER BY name
+LIMIT @limit OFFSET @offset;
+
+------------------------------------------------------------------
+
+-- name: ListEventsAfter :many
+SELECT *
+FROM events
+WHERE id > @after
+ORDER BY id
+LIMIT @limit;
+
+------------------------------------------------------------------
+
+-- name: ListChangesAfter :many
+SELECT *
+FROM changes
+WHERE seq > @after
+ORDER BY seq
+LIMIT @limit;
+
+------------------------------------------------------------------
+
+-- name: GetAPITokenByHash :one
+SELECT *
+FROM api_tokens
+WHERE token_hash = @token_hash
+  AND (expires IS NULL OR expires > @now);
+
+-- name: ListAPITokens :many
+SELECT api_tokens.*, COUNT(*) OVER () as total_count
+FROM api_tokens
+WHERE user = @user
+ORDER BY created DESC
+LIMIT @limit OFFSET @offset;
+
+------------------------------------------------------------------
+
+-- name: GetSession :one
+SELECT *
+FROM sessions
+WHERE id = @id
+  AND expires > @now;
+
+-- name: ListSessions :many
+SELECT sessions.*, COUNT(*) OVER () as total_count
+FROM sessions
+WHERE user = @user
+  AND expires > @now
+ORDER BY last_seen DESC
+LIMIT @limit OFFSET @offset;
+
+------------------------------------------------------------------
+
+-- name: GetOIDCIdentity :one
+SELECT *
+FROM oidc_identities
+WHERE issuer = @issuer
+  AND subject = @subject;
+
+-- name: CountOIDCIdentities :one
+SELECT COUNT(*)
+FROM oidc_identities
+WHERE user = @user
+  AND issuer = @issuer;
@@ -36,3 +36,25 @@ func New(readDB, writeDB *sql.DB) *Queries {
 		WriteDB:      writeDB,
 	}
 }
+
+// WriteTx runs fn in a transaction on the write connection. The queries
+// passed to fn read and write through the transaction, so they see the
+// uncommitted writes. The transaction is committed if fn returns nil.
+func (q *Queries) WriteTx(ctx context.Context, fn func(*Queries) error) error {
+	tx, err := q.WriteDB.BeginTx(ctx, nil)
+	if err != nil {
+		return err
+	}
+	defer tx.Rollback()
+
+	if err := fn(&Queries{
+		ReadQueries:  &ReadQueries{db: tx},
+		WriteQueries: &WriteQueries{db: tx},
+		ReadDB:       q.ReadDB,
+		WriteDB:      q.WriteDB,
+	}); err != nil {
+		return err
+	}
+
+	return tx.Commit()
+}
@@ -8,6 +8,35 @@ import (
 	"time"
 )
 
+type ActionToken struct {
+	ID      string    `json:"id"`
+	Expires time.Time `json:"expires"`
+	Created time.Time `json:"created"`
+}
+
+type ApiToken struct {
+	ID         string     `json:"id"`
+	User       string     `json:"user"`
+	Name       string     `json:"name"`
+	TokenHash  string     `json:"token_hash"`
+	Prefix     string     `json:"prefix"`
+	Scopes     string     `json:"scopes"`
+	Expires    *time.Time `json:"expires"`
+	LastUsed   *time.Time `json:"last_used"`
+	LastUsedIp *string    `json:"last_used_ip"`
+	Created    time.Time  `json:"created"`
+}
+
+type Change struct {
+	Seq        int64     `json:"seq"`
+	Collection string    `json:"collection"`
+	Record     string    `json:"record"`
+	Action     string    `json:"action"`
+	Actor      *string   `json:"actor"`
+	Snapshot   []byte    `json:"snapshot"`
+	Created    time.Time `json:"created"`
+}
+
 type Comment struct {
 	ID      string    `json:"id"`
 	Ticket  string    `json:"ticket"`
@@ -22,6 +51,16 @@ type DashboardCount struct {
 	Count int64  `json:"count"`
 }
 
+type Event struct {
+	ID         int64     `json:"id"`
+	Action     string    `json:"action"`
+	Collection string    `json:"collection"`
+	Record     string    `json:"record"`
+	Ticket     *string   `json:"ticket"`
+	Payload    []byte    `json:"payload"`
+	Created    time.Time `json:"created"`
+}
+
 type Feature struct {
 	Key string `json:"key"`
 }
@@ -69,6 +108,13 @@ type Link struct {
 	Updated time.Time `json:"updated"`
 }
 
+type OidcIdentity struct {
+	Issuer  string    `json:"issuer"`
+	Subject string    `json:"subject"`
+	User    string    `json:"user"`
+	Created time.Time `json:"created"`
+}
+
 type Param struct {
 	Key   string `json:"key"`
 	Value []byte `json:"value"`
@@ -85,6 +131,52 @@ type Reaction struct {
 	Updated     time.Time `json:"updated"`
 }
 
+type ReactionJob struct {
+	ID          string    `json:"id"`
+	Reaction    string    `json:"reaction"`
+	Trigger     string    `json:"trigger"`
+	Payload     []byte    `json:"payload"`
+	Status      string    `json:"status"`
+	Attempts    int64     `json:"attempts"`
+	MaxAttempts int64     `json:"max_attempts"`
+	LastError   *string   `json:"last_error"`
+	Run         *string   `json:"run"`
+	RunAfter    time.Time `json:"run_after"`
+	Created     time.Time `json:"created"`
+	Updated     time.Time `json:"updated"`
+}
+
+type ReactionRun struct {
+	ID       string     `json:"id"`
+	Reaction string     `json:"reaction"`
+	Trigger  string     `json:"trigger"`
+	Status   string     `json:"status"`
+	Input    []byte     `json:"input"`
+	Output   string     `json:"output"`
+	Error    *string    `json:"error"`
+	ExitCode *int64     `json:"exit_code"`
+	Started  time.Time  `json:"started"`
+	Finished *time.Time `json:"finished"`
+}
+
+type Secret struct {
+	ID      string    `json:"id"`
+	Name    string    `json:"name"`
+	Value   []byte    `json:"value"`
+	Created time.Time `json:"created"`
+	Updated time.Time `json:"updated"`
+}
+
+type Session struct {
+	ID        string    `json:"id"`
+	User      string    `json:"user"`
+	UserAgent string    `json:"user_agent"`
+	Ip        string    `json:"ip"`
+	Expires   time.Time `json:"expires"`
+	LastSeen  time.Time `json:"last_seen"`
+	Created   time.Time `json:"created"`
+}
+
 type Sidebar struct {
 	ID       string  `json:"id"`
 	Singular string  `json:"singular"`
@@ -191,4 +283,26 @@ type Webhook struct {
 	Name        string    `json:"name"`
 	Created     time.Time `json:"created"`
 	Updated     time.Time `json:"updated"`
+	Events      string    `json:"events"`
+	Filter      string    `json:"filter"`
+	Secret      string    `json:"secret"`
+	Headers     string    `json:"headers"`
+	Format      string    `json:"format"`
+}
+
+type WebhookDelivery struct {
+	ID             string    `json:"id"`
+	Webhook        string    `json:"webhook"`
+	Event          string    `json:"event"`
+	Collection     string    `json:"collection"`
+	Payload        []byte    `json:"payload"`
+	Status         string    `json:"status"`
+	Attempts       int64     `json:"attempts"`
+	MaxAttempts    int64     `json:"max_attempts"`
+	ResponseStatus *int64    `json:"response_status"`
+	ResponseBody   *string   `json:"response_body"`
+	LastError      *string   `json:"last_error"`
+	NextAttempt    time.Time `json:"next_attempt"`
+	Created        time.Time `json:"created"`
+	Updated        time.Time `json:"updated"`
 }
@@ -10,6 +10,78 @@ import (
 	"time"
 )
 
+const countOIDCIdentities = `-- name: CountOIDCIdentities :one
+SELECT COUNT(*)
+FROM oidc_identities
+WHERE user = ?1
+  AND issuer = ?2
+`
+
+type CountOIDCIdentitiesParams struct {
+	User   string `json:"user"`
+	Issuer string `json:"issuer"`
+}
+
+func (q *ReadQueries) CountOIDCIdentities(ctx context.Context, arg CountOIDCIdentitiesParams) (int64, error) {
+	row := q.db.QueryRowContext(ctx, countOIDCIdentities, arg.User, arg.Issuer)
+	var count int64
+	err := row.Scan(&count)
+	return count, err
+}
+
+const getAPITokenByHash = `-- name: GetAPITokenByHash :one
+
+SELECT id, user, name, token_hash, prefix, scopes, expires, last_used, last_used_ip, created
+FROM api_tokens
+WHERE token_hash = ?1
+  AND (expires IS NULL OR expires > ?2)
+`
+
+type GetAPITokenByHashParams struct {
+	TokenHash string     `json:"token_hash"`
+	Now       *time.Time `json:"now"`
+}
+
+// ----------------------------------------------------------------
+func (q *ReadQueries) GetAPITokenByHash(ctx context.Context, arg GetAPITokenByHashParams) (ApiToken, error) {
+	row := q.db.QueryRowContext(ctx, getAPITokenByHash, arg.TokenHash, arg.Now)
+	var i ApiToken
+	err := row.Scan(
+		&i.ID,
+		&i.User,
+		&i.Name,
+		&i.TokenHash,
+		&i.Prefix,
+		&i.Scopes,
+		&i.Expires,
+		&i.LastUsed,
+		&i.LastUsedIp,
+		&i.Created,
+	)
+	return i, err
+}
+
+const getActionToken = `-- name: GetActionToken :one
+
+SELECT id, expires, created
+FROM action_tokens
+WHERE id = ?1
+  AND expires > ?2
+`
+
+type GetActionTokenParams struct {
+	ID  string    `json:"id"`
+	Now time.Time `json:"now"`
+}
+
+// ----------------------------------------------------------------
+func (q *ReadQueries) GetActionToken(ctx context.Context, arg GetActionTokenParams) (ActionToken, error) {
+	row := q.db.QueryRowContext(ctx, getActionToken, arg.ID, arg.Now)
+	var i ActionToken
+	err := row.Scan(&i.ID, &i.Expires, &i.Created)
+	return i, err
+}
+
 const getComment = `-- name: GetComment :one
 
 SELECT comments.id, comments.ticket, comments.author, comments.message, comments.created, comments.updated, users.name as author_name
@@ -154,6 +226,32 @@ func (q *ReadQueries) GetLink(ctx context.Context, id string) (Link, error) {
 	return i, err
 }
 
+const getOIDCIdentity = `-- name: GetOIDCIdentity :one
+
+SELECT issuer, subject, user, created
+FROM oidc_identities
+WHERE issuer = ?1
+  AND subject = ?2
+`
+
+type GetOIDCIdentityParams struct {
+	Issuer  string `json:"issuer"`
+	Subject string `json:"subject"`
+}
+
+// ----------------------------------------------------------------
+func (q *ReadQueries) GetOIDCIdentity(ctx context.Context, arg GetOIDCIdentityParams) (OidcIdentity, error) {
+	row := q.db.QueryRowContext(ctx, getOIDCIdentity, arg.Issuer, arg.Subject)
+	var i OidcIdentity
+	err := row.Scan(
+		&i.Issuer,
+		&i.Subject,
+		&i.User,
+		&i.Created,
+	)
+	return i, err
+}
+
 const getReaction = `-- name: GetReaction :one
 
 SELECT id, name, "action", actiondata, "trigger", triggerdata, created, updated
@@ -178,6 +276,131 @@ func (q *ReadQueries) GetReaction(ctx context.Context, id string) (Reaction, err
 	return i, err
 }
 
+const getReactionJob = `-- name: GetReactionJob :one
+SELECT id, reaction, "trigger", payload, status, attempts, max_attempts, last_error, run, run_after, created, updated
+FROM reaction_jobs
+WHERE id = ?1
+`
+
+func (q *ReadQueries) GetReactionJob(ctx context.Context, id string) (ReactionJob, error) {
+	row := q.db.QueryRowContext(ctx, getReactionJob, id)
+	var i ReactionJob
+	err := row.Scan(
+		&i.ID,
+		&i.Reaction,
+		&i.Trigger,
+		&i.Payload,
+		&i.Status,
+		&i.Attempts,
+		&i.MaxAttempts,
+		&i.LastError,
+		&i.Run,
+		&i.RunAfter,
+		&i.Created,
+		&i.Updated,
+	)
+	return i, err
+}
+
+const getReactionRun = `-- name: GetReactionRun :one
+SELECT id, reaction, "trigger", status, input, output, error, exit_code, started, finished
+FROM reaction_runs
+WHERE id = ?1
+  AND reaction = ?2
+`
+
+type GetReactionRunParams struct {
+	ID       string `json:"id"`
+	Reaction string `json:"reaction"`
+}
+
+func (q *ReadQueries) GetReactionRun(ctx context.Context, arg GetReactionRunParams) (ReactionRun, error) {
+	row := q.db.QueryRowContext(ctx, getReactionRun, arg.ID, arg.Reaction)
+	var i ReactionRun
+	err := row.Scan(
+		&i.ID,
+		&i.Reaction,
+		&i.Trigger,
+		&i.Status,
+		&i.Input,
+		&i.Output,
+		&i.Error,
+		&i.ExitCode,
+		&i.Started,
+		&i.Finished,
+	)
+	return i, err
+}
+
+const getSecret = `-- name: GetSecret :one
+
+SELECT id, name, value, created, updated
+FROM secrets
+WHERE id = ?1
+`
+
+// ----------------------------------------------------------------
+func (q *ReadQueries) GetSecret(ctx context.Context, id string) (Secret, error) {
+	row := q.db.QueryRowContext(ctx, getSecret, id)
+	var i Secret
+	err := row.Scan(
+		&i.ID,
+		&i.Name,
+		&i.Value,
+		&i.Created,
+		&i.Updated,
+	)
+	return i, err
+}
+
+const getSecretByName = `-- name: GetSecretByName :one
+SELECT id, name, value, created, updated
+FROM secrets
+WHERE name = ?1
+`
+
+func (q *ReadQueries) GetSecretByName(ctx context.Context, name string) (Secret, error) {
+	row := q.db.QueryRowContext(ctx, getSecretByName, name)
+	var i Secret
+	err := row.Scan(
+		&i.ID,
+		&i.Name,
+		&i.Value,
+		&i.Created,
+		&i.Updated,
+	)
+	return i, err
+}
+
+const getSession = `-- name: GetSession :one
+
+SELECT id, user, user_agent, ip, expires, last_seen, created
+FROM sessions
+WHERE id = ?1
+  AND expires > ?2
+`
+
+type GetSessionParams struct {
+	ID  string    `json:"id"`
+	Now time.Time `json:"now"`
+}
+
+// ----------------------------------------------------------------
+func (q *ReadQueries) GetSession(ctx context.Context, arg GetSessionParams) (Session, error) {
+	row := q.db.QueryRowContext(ctx, getSession, arg.ID, arg.Now)
+	var i Session
+	err := row.Scan(
+		&i.ID,
+		&i.User,
+		&i.UserAgent,
+		&i.Ip,
+		&i.Expires,
+		&i.LastSeen,
+		&i.Created,
+	)
+	return i, err
+}
+
 const getSidebar = `-- name: GetSidebar :many
 SELECT id, singular, plural, icon, count
 FROM sidebar
@@ -328,7 +551,7 @@ func (q *ReadQueries) GetUser(ctx context.Context, id string) (User, error) {
 
 const getWebhook = `-- name: GetWebhook :one
 
-SELECT id, collection, destination, name, created, updated
+SELECT id, collection, destination, name, created, updated, events, "filter", secret, headers, format
 FROM webhooks
 WHERE id = ?1
 `
@@ -344,10 +567,158 @@ func (q *ReadQueries) GetWebhook(ctx context.Context, id string) (Webhook, error
 		&i.Name,
 		&i.Created,
 		&i.Updated,
+		&i.Events,
+		&i.Filter,
+		&i.Secret,
+		&i.Headers,
+		&i.Format,
+	)
+	return i, err
+}
+
+const getWebhookDelivery = `-- name: GetWebhookDelivery :one
+SELECT id, webhook, event, collection, payload, status, attempts, max_attempts, response_status, response_body, last_error, next_attempt, created, updated
+FROM webhook_deliveries
+WHERE id = ?1
+  AND webhook = ?2
+`
+
+type GetWebhookDeliveryParams struct {
+	ID      string `json:"id"`
+	Webhook string `json:"webhook"`
+}
+
+func (q *ReadQueries) GetWebhookDelivery(ctx context.Context, arg GetWebhookDeliveryParams) (WebhookDelivery, error) {
+	row := q.db.QueryRowContext(ctx, getWebhookDelivery, arg.ID, arg.Webhook)
+	var i WebhookDelivery
+	err := row.Scan(
+		&i.ID,
+		&i.Webhook,
+		&i.Event,
+		&i.Collection,
+		&i.Payload,
+		&i.Status,
+		&i.Attempts,
+		&i.MaxAttempts,
+		&i.ResponseStatus,
+		&i.ResponseBody,
+		&i.LastError,
+		&i.NextAttempt,
+		&i.Created,
+		&i.Updated,
 	)
 	return i, err
 }
 
+const listAPITokens = `-- name: ListAPITokens :many
+SELECT api_tokens.id, api_tokens.user, api_tokens.name, api_tokens.token_hash, api_tokens.prefix, api_tokens.scopes, api_tokens.expires, api_tokens.last_used, api_tokens.last_used_ip, api_tokens.created, COUNT(*) OVER () as total_count
+FROM api_tokens
+WHERE user = ?1
+ORDER BY created DESC
+LIMIT ?3 OFFSET ?2
+`
+
+type ListAPITokensParams struct {
+	User   string `json:"user"`
+	Offset int64  `json:"offset"`
+	Limit  int64  `json:"limit"`
+}
+
+type ListAPITokensRow struct {
+	ID         string     `json:"id"`
+	User       string     `json:"user"`
+	Name       string     `json:"name"`
+	TokenHash  string     `json:"token_hash"`
+	Prefix     string     `json:"prefix"`
+	Scopes     string     `json:"scopes"`
+	Expires    *time.Time `json:"expires"`
+	LastUsed   *time.Time `json:"last_used"`
+	LastUsedIp *string    `json:"last_used_ip"`
+	Created    time.Time  `json:"created"`
+	TotalCount int64      `json:"total_count"`
+}
+
+func (q *ReadQueries) ListAPITokens(ctx context.Context, arg ListAPITokensParams) ([]ListAPITokensRow, error) {
+	rows, err := q.db.QueryContext(ctx, listAPITokens, arg.User, arg.Offset, arg.Limit)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []ListAPITokensRow
+	for rows.Next() {
+		var i ListAPITokensRow
+		if err := rows.Scan(
+			&i.ID,
+			&i.User,
+			&i.Name,
+			&i.TokenHash,
+			&i.Prefix,
+			&i.Scopes,
+			&i.Expires,
+			&i.LastUsed,
+			&i.LastUsedIp,
+			&i.Created,
+			&i.TotalCount,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const listChangesAfter = `-- name: ListChangesAfter :many
+
+SELECT seq, collection, record, "action", actor, snapshot, created
+FROM changes
+WHERE seq > ?1
+ORDER BY seq
+LIMIT ?2
+`
+
+type ListChangesAfterParams struct {
+	After int64 `json:"after"`
+	Limit int64 `json:"limit"`
+}
+
+// ----------------------------------------------------------------
+func (q *ReadQueries) ListChangesAfter(ctx context.Context, arg ListChangesAfterParams) ([]Change, error) {
+	rows, err := q.db.QueryContext(ctx, listChangesAfter, arg.After, arg.Limit)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []Change
+	for rows.Next() {
+		var i Change
+		if err := rows.Scan(
+			&i.Seq,
+			&i.Collection,
+			&i.Record,
+			&i.Action,
+			&i.Actor,
+			&i.Snapshot,
+			&i.Created,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
 const listChildGroups = `-- name: ListChildGroups :many
 SELECT g.id, g.name, g.permissions, g.created, g.updated, group_effective_groups.group_type
 FROM group_effective_groups
@@ -454,6 +825,52 @@ func (q *ReadQueries) ListComments(ctx context.Context, arg ListCommentsParams)
 	return items, nil
 }
 
+const listEventsAfter = `-- name: ListEventsAfter :many
+
+SELECT id, "action", collection, record, ticket, payload, created
+FROM events
+WHERE id > ?1
+ORDER BY id
+LIMIT ?2
+`
+
+type ListEventsAfterParams struct {
+	After int64 `json:"after"`
+	Limit int64 `json:"limit"`
+}
+
+// ----------------------------------------------------------------
+func (q *ReadQueries) ListEventsAfter(ctx context.Context, arg ListEventsAfterParams) ([]Event, error) {
+	rows, err := q.db.QueryContext(ctx, listEventsAfter, arg.After, arg.Limit)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []Event
+	for rows.Next() {
+		var i Event
+		if err := rows.Scan(
+			&i.ID,
+			&i.Action,
+			&i.Collection,
+			&i.Record,
+			&i.Ticket,
+			&i.Payload,
+			&i.Created,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
 const listFeatures = `-- name: ListFeatures :many
 SELECT features."key", COUNT(*) OVER () as total_count
 FROM features
@@ -797,6 +1214,69 @@ func (q *ReadQueries) ListParentPermissions(ctx context.Context, groupID string)
 	return items, nil
 }
 
+const listReactionRuns = `-- name: ListReactionRuns :many
+SELECT reaction_runs.id, reaction_runs.reaction, reaction_runs."trigger", reaction_runs.status, reaction_runs.input, reaction_runs.output, reaction_runs.error, reaction_runs.exit_code, reaction_runs.started, reaction_runs.finished, COUNT(*) OVER () as total_count
+FROM reaction_runs
+WHERE reaction = ?1
+ORDER BY reaction_runs.started DESC
+LIMIT ?3 OFFSET ?2
+`
+
+type ListReactionRunsParams struct {
+	Reaction string `json:"reaction"`
+	Offset   int64  `json:"offset"`
+	Limit    int64  `json:"limit"`
+}
+
+type ListReactionRunsRow struct {
+	ID         string     `json:"id"`
+	Reaction   string     `json:"reaction"`
+	Trigger    string     `json:"trigger"`
+	Status     string     `json:"status"`
+	Input      []byte     `json:"input"`
+	Output     string     `json:"output"`
+	Error      *string    `json:"error"`
+	ExitCode   *int64     `json:"exit_code"`
+	Started    time.Time  `json:"started"`
+	Finished   *time.Time `json:"finished"`
+	TotalCount int64      `json:"total_count"`
+}
+
+func (q *ReadQueries) ListReactionRuns(ctx context.Context, arg ListReactionRunsParams) ([]ListReactionRunsRow, error) {
+	rows, err := q.db.QueryContext(ctx, listReactionRuns, arg.Reaction, arg.Offset, arg.Limit)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []ListReactionRunsRow
+	for rows.Next() {
+		var i ListReactionRunsRow
+		if err := rows.Scan(
+			&i.ID,
+			&i.Reaction,
+			&i.Trigger,
+			&i.Status,
+			&i.Input,
+			&i.Output,
+			&i.Error,
+			&i.ExitCode,
+			&i.Started,
+			&i.Finished,
+			&i.TotalCount,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
 const listReactions = `-- name: ListReactions :many
 SELECT reactions.id, reactions.name, reactions."action", reactions.actiondata, reactions."trigger", reactions.triggerdata, reactions.created, reactions.updated, COUNT(*) OVER () as total_count
 FROM reactions
@@ -913,19 +1393,134 @@ func (q *ReadQueries) ListReactionsByTrigger(ctx context.Context, arg ListReacti
 	return items, nil
 }
 
-const listTasks = `-- name: ListTasks :many
-SELECT tasks.id, tasks.ticket, tasks.owner, tasks.name, tasks.open, tasks.created, tasks.updated,
-       users.name       as owner_name,
-       tickets.name     as ticket_name,
-       tickets.type     as ticket_type,
-       COUNT(*) OVER () as total_count
-FROM tasks
-         LEFT JOIN users ON users.id = tasks.owner
-         LEFT JOIN tickets ON tickets.id = tasks.ticket
-WHERE ticket = ?1
-   OR ?1 = ''
-ORDER BY tasks.created DESC
-LIMIT ?3 OFFSET ?2
+const listSecrets = `-- name: ListSecrets :many
+SELECT secrets.id, secrets.name, secrets.value, secrets.created, secrets.updated, COUNT(*) OVER () as total_count
+FROM secrets
+ORDER BY name
+LIMIT ?2 OFFSET ?1
+`
+
+type ListSecretsParams struct {
+	Offset int64 `json:"offset"`
+	Limit  int64 `json:"limit"`
+}
+
+type ListSecretsRow struct {
+	ID         string    `json:"id"`
+	Name       string    `json:"name"`
+	Value      []byte    `json:"value"`
+	Created    time.Time `json:"created"`
+	Updated    time.Time `json:"updated"`
+	TotalCount int64     `json:"total_count"`
+}
+
+func (q *ReadQueries) ListSecrets(ctx context.Context, arg ListSecretsParams) ([]ListSecretsRow, error) {
+	rows, err := q.db.QueryContext(ctx, listSecrets, arg.Offset, arg.Limit)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []ListSecretsRow
+	for rows.Next() {
+		var i ListSecretsRow
+		if err := rows.Scan(
+			&i.ID,
+			&i.Name,
+			&i.Value,
+			&i.Created,
+			&i.Updated,
+			&i.TotalCount,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const listSessions = `-- name: ListSessions :many
+SELECT sessions.id, sessions.user, sessions.user_agent, sessions.ip, sessions.expires, sessions.last_seen, sessions.created, COUNT(*) OVER () as total_count
+FROM sessions
+WHERE user = ?1
+  AND expires > ?2
+ORDER BY last_seen DESC
+LIMIT ?4 OFFSET ?3
+`
+
+type ListSessionsParams struct {
+	User   string    `json:"user"`
+	Now    time.Time `json:"now"`
+	Offset int64     `json:"offset"`
+	Limit  int64     `json:"limit"`
+}
+
+type ListSessionsRow struct {
+	ID         string    `json:"id"`
+	User       string    `json:"user"`
+	UserAgent  string    `json:"user_agent"`
+	Ip         string    `json:"ip"`
+	Expires    time.Time `json:"expires"`
+	LastSeen   time.Time `json:"last_seen"`
+	Created    time.Time `json:"created"`
+	TotalCount int64     `json:"total_count"`
+}
+
+func (q *ReadQueries) ListSessions(ctx context.Context, arg ListSessionsParams) ([]ListSessionsRow, error) {
+	rows, err := q.db.QueryContext(ctx, listSessions,
+		arg.User,
+		arg.Now,
+		arg.Offset,
+		arg.Limit,
+	)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []ListSessionsRow
+	for rows.Next() {
+		var i ListSessionsRow
+		if err := rows.Scan(
+			&i.ID,
+			&i.User,
+			&i.UserAgent,
+			&i.Ip,
+			&i.Expires,
+			&i.LastSeen,
+			&i.Created,
+			&i.TotalCount,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const listTasks = `-- name: ListTasks :many
+SELECT tasks.id, tasks.ticket, tasks.owner, tasks.name, tasks.open, tasks.created, tasks.updated,
+       users.name       as owner_name,
+       tickets.name     as ticket_name,
+       tickets.type     as ticket_type,
+       COUNT(*) OVER () as total_count
+FROM tasks
+         LEFT JOIN users ON users.id = tasks.owner
+         LEFT JOIN tickets ON tickets.id = tasks.ticket
+WHERE ticket = ?1
+   OR ?1 = ''
+ORDER BY tasks.created DESC
+LIMIT ?3 OFFSET ?2
 `
 
 type ListTasksParams struct {
@@ -983,6 +1578,223 @@ func (q *ReadQueries) ListTasks(ctx context.Context, arg ListTasksParams) ([]Lis
 	return items, nil
 }
 
+const listTicketComments = `-- name: ListTicketComments :many
+SELECT comments.id, comments.ticket, comments.author, comments.message, comments.created, comments.updated, users.name as author_name
+FROM comments
+         LEFT JOIN users ON users.id = comments.author
+WHERE comments.ticket = ?1
+ORDER BY comments.created
+`
+
+type ListTicketCommentsRow struct {
+	ID         string    `json:"id"`
+	Ticket     string    `json:"ticket"`
+	Author     string    `json:"author"`
+	Message    string    `json:"message"`
+	Created    time.Time `json:"created"`
+	Updated    time.Time `json:"updated"`
+	AuthorName *string   `json:"author_name"`
+}
+
+func (q *ReadQueries) ListTicketComments(ctx context.Context, ticket string) ([]ListTicketCommentsRow, error) {
+	rows, err := q.db.QueryContext(ctx, listTicketComments, ticket)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []ListTicketCommentsRow
+	for rows.Next() {
+		var i ListTicketCommentsRow
+		if err := rows.Scan(
+			&i.ID,
+			&i.Ticket,
+			&i.Author,
+			&i.Message,
+			&i.Created,
+			&i.Updated,
+			&i.AuthorName,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const listTicketFiles = `-- name: ListTicketFiles :many
+SELECT id, ticket, name, blob, size, created, updated
+FROM files
+WHERE ticket = ?1
+ORDER BY created
+`
+
+func (q *ReadQueries) ListTicketFiles(ctx context.Context, ticket string) ([]File, error) {
+	rows, err := q.db.QueryContext(ctx, listTicketFiles, ticket)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []File
+	for rows.Next() {
+		var i File
+		if err := rows.Scan(
+			&i.ID,
+			&i.Ticket,
+			&i.Name,
+			&i.Blob,
+			&i.Size,
+			&i.Created,
+			&i.Updated,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const listTicketLinks = `-- name: ListTicketLinks :many
+SELECT id, ticket, name, url, created, updated
+FROM links
+WHERE ticket = ?1
+ORDER BY created
+`
+
+func (q *ReadQueries) ListTicketLinks(ctx context.Context, ticket string) ([]Link, error) {
+	rows, err := q.db.QueryContext(ctx, listTicketLinks, ticket)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []Link
+	for rows.Next() {
+		var i Link
+		if err := rows.Scan(
+			&i.ID,
+			&i.Ticket,
+			&i.Name,
+			&i.Url,
+			&i.Created,
+			&i.Updated,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const listTicketTasks = `-- name: ListTicketTasks :many
+SELECT tasks.id, tasks.ticket, tasks.owner, tasks.name, tasks.open, tasks.created, tasks.updated, users.name as owner_name, tickets.name as ticket_name, tickets.type as ticket_type
+FROM tasks
+         LEFT JOIN users ON users.id = tasks.owner
+         LEFT JOIN tickets ON tickets.id = tasks.ticket
+WHERE tasks.ticket = ?1
+ORDER BY tasks.created
+`
+
+type ListTicketTasksRow struct {
+	ID         string    `json:"id"`
+	Ticket     string    `json:"ticket"`
+	Owner      *string   `json:"owner"`
+	Name       string    `json:"name"`
+	Open       bool      `json:"open"`
+	Created    time.Time `json:"created"`
+	Updated    time.Time `json:"updated"`
+	OwnerName  *string   `json:"owner_name"`
+	TicketName *string   `json:"ticket_name"`
+	TicketType *string   `json:"ticket_type"`
+}
+
+func (q *ReadQueries) ListTicketTasks(ctx context.Context, ticket string) ([]ListTicketTasksRow, error) {
+	rows, err := q.db.QueryContext(ctx, listTicketTasks, ticket)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []ListTicketTasksRow
+	for rows.Next() {
+		var i ListTicketTasksRow
+		if err := rows.Scan(
+			&i.ID,
+			&i.Ticket,
+			&i.Owner,
+			&i.Name,
+			&i.Open,
+			&i.Created,
+			&i.Updated,
+			&i.OwnerName,
+			&i.TicketName,
+			&i.TicketType,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const listTicketTimeline = `-- name: ListTicketTimeline :many
+SELECT id, ticket, message, time, created, updated
+FROM timeline
+WHERE ticket = ?1
+ORDER BY created
+`
+
+func (q *ReadQueries) ListTicketTimeline(ctx context.Context, ticket string) ([]Timeline, error) {
+	rows, err := q.db.QueryContext(ctx, listTicketTimeline, ticket)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []Timeline
+	for rows.Next() {
+		var i Timeline
+		if err := rows.Scan(
+			&i.ID,
+			&i.Ticket,
+			&i.Message,
+			&i.Time,
+			&i.Created,
+			&i.Updated,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
 const listTickets = `-- name: ListTickets :many
 SELECT tickets.id, tickets.type, tickets.owner, tickets.name, tickets.description, tickets.open, tickets.resolution, tickets.schema, tickets.state, tickets.created, tickets.updated,
        users.name       as owner_name,
@@ -1314,8 +2126,79 @@ func (q *ReadQueries) ListUsers(ctx context.Context, arg ListUsersParams) ([]Lis
 	return items, nil
 }
 
+const listWebhookDeliveries = `-- name: ListWebhookDeliveries :many
+SELECT webhook_deliveries.id, webhook_deliveries.webhook, webhook_deliveries.event, webhook_deliveries.collection, webhook_deliveries.payload, webhook_deliveries.status, webhook_deliveries.attempts, webhook_deliveries.max_attempts, webhook_deliveries.response_status, webhook_deliveries.response_body, webhook_deliveries.last_error, webhook_deliveries.next_attempt, webhook_deliveries.created, webhook_deliveries.updated, COUNT(*) OVER () as total_count
+FROM webhook_deliveries
+WHERE webhook = ?1
+ORDER BY created DESC
+LIMIT ?3 OFFSET ?2
+`
+
+type ListWebhookDeliveriesParams struct {
+	Webhook string `json:"webhook"`
+	Offset  int64  `json:"offset"`
+	Limit   int64  `json:"limit"`
+}
+
+type ListWebhookDeliveriesRow struct {
+	ID             string    `json:"id"`
+	Webhook        string    `json:"webhook"`
+	Event          string    `json:"event"`
+	Collection     string    `json:"collection"`
+	Payload        []byte    `json:"payload"`
+	Status         string    `json:"status"`
+	Attempts       int64     `json:"attempts"`
+	MaxAttempts    int64     `json:"max_attempts"`
+	ResponseStatus *int64    `json:"response_status"`
+	ResponseBody   *string   `json:"response_body"`
+	LastError      *string   `json:"last_error"`
+	NextAttempt    time.Time `json:"next_attempt"`
+	Created        time.Time `json:"created"`
+	Updated        time.Time `json:"updated"`
+	TotalCount     int64     `json:"total_count"`
+}
+
+func (q *ReadQueries) ListWebhookDeliveries(ctx context.Context, arg ListWebhookDeliveriesParams) ([]ListWebhookDeliveriesRow, error) {
+	rows, err := q.db.QueryContext(ctx, listWebhookDeliveries, arg.Webhook, arg.Offset, arg.Limit)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []ListWebhookDeliveriesRow
+	for rows.Next() {
+		var i ListWebhookDeliveriesRow
+		if err := rows.Scan(
+			&i.ID,
+			&i.Webhook,
+			&i.Event,
+			&i.Collection,
+			&i.Payload,
+			&i.Status,
+			&i.Attempts,
+			&i.MaxAttempts,
+			&i.ResponseStatus,
+			&i.ResponseBody,
+			&i.LastError,
+			&i.NextAttempt,
+			&i.Created,
+			&i.Updated,
+			&i.TotalCount,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
 const listWebhooks = `-- name: ListWebhooks :many
-SELECT webhooks.id, webhooks.collection, webhooks.destination, webhooks.name, webhooks.created, webhooks.updated, COUNT(*) OVER () as total_count
+SELECT webhooks.id, webhooks.collection, webhooks.destination, webhooks.name, webhooks.created, webhooks.updated, webhooks.events, webhooks."filter", webhooks.secret, webhooks.headers, webhooks.format, COUNT(*) OVER () as total_count
 FROM webhooks
 ORDER BY created DESC
 LIMIT ?2 OFFSET ?1
@@ -1333,6 +2216,11 @@ type ListWebhooksRow struct {
 	Name        string    `json:"name"`
 	Created     time.Time `json:"created"`
 	Updated     time.Time `json:"updated"`
+	Events      string    `json:"events"`
+	Filter      string    `json:"filter"`
+	Secret      string    `json:"secret"`
+	Headers     string    `json:"headers"`
+	Format      string    `json:"format"`
 	TotalCount  int64     `json:"total_count"`
 }
 
@@ -1352,6 +2240,76 @@ func (q *ReadQueries) ListWebhooks(ctx context.Context, arg ListWebhooksParams)
 			&i.Name,
 			&i.Created,
 			&i.Updated,
+			&i.Events,
+			&i.Filter,
+			&i.Secret,
+			&i.Headers,
+			&i.Format,
+			&i.TotalCount,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const listWebhooksByCollection = `-- name: ListWebhooksByCollection :many
+SELECT webhooks.id, webhooks.collection, webhooks.destination, webhooks.name, webhooks.created, webhooks.updated, webhooks.events, webhooks."filter", webhooks.secret, webhooks.headers, webhooks.format, COUNT(*) OVER () as total_count
+FROM webhooks
+WHERE collection = ?1
+ORDER BY created DESC
+LIMIT ?3 OFFSET ?2
+`
+
+type ListWebhooksByCollectionParams struct {
+	Collection string `json:"collection"`
+	Offset     int64  `json:"offset"`
+	Limit      int64  `json:"limit"`
+}
+
+type ListWebhooksByCollectionRow struct {
+	ID          string    `json:"id"`
+	Collection  string    `json:"collection"`
+	Destination string    `json:"destination"`
+	Name        string    `json:"name"`
+	Created     time.Time `json:"created"`
+	Updated     time.Time `json:"updated"`
+	Events      string    `json:"events"`
+	Filter      string    `json:"filter"`
+	Secret      string    `json:"secret"`
+	Headers     string    `json:"headers"`
+	Format      string    `json:"format"`
+	TotalCount  int64     `json:"total_count"`
+}
+
+func (q *ReadQueries) ListWebhooksByCollection(ctx context.Context, arg ListWebhooksByCollectionParams) ([]ListWebhooksByCollectionRow, error) {
+	rows, err := q.db.QueryContext(ctx, listWebhooksByCollection, arg.Collection, arg.Offset, arg.Limit)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []ListWebhooksByCollectionRow
+	for rows.Next() {
+		var i ListWebhooksByCollectionRow
+		if err := rows.Scan(
+			&i.ID,
+			&i.Collection,
+			&i.Destination,
+			&i.Name,
+			&i.Created,
+			&i.Updated,
+			&i.Events,
+			&i.Filter,
+			&i.Secret,
+			&i.Headers,
+			&i.Format,
 			&i.TotalCount,
 		); err != nil {
 			return nil, err
@@ -1539,6 +2497,35 @@ func (q *ReadQueries) Ticket(ctx context.Context, id string) (TicketRow, error)
 	return i, err
 }
 
+const ticketChildCounts = `-- name: TicketChildCounts :one
+SELECT (SELECT COUNT(*) FROM comments WHERE comments.ticket = ?1) as comments,
+       (SELECT COUNT(*) FROM tasks WHERE tasks.ticket = ?1)       as tasks,
+       (SELECT COUNT(*) FROM files WHERE files.ticket = ?1)       as files,
+       (SELECT COUNT(*) FROM links WHERE links.ticket = ?1)       as links,
+       (SELECT COUNT(*) FROM timeline WHERE timeline.ticket = ?1) as timeline
+`
+
+type TicketChildCountsRow struct {
+	Comments int64 `json:"comments"`
+	Tasks    int64 `json:"tasks"`
+	Files    int64 `json:"files"`
+	Links    int64 `json:"links"`
+	Timeline int64 `json:"timeline"`
+}
+
+func (q *ReadQueries) TicketChildCounts(ctx context.Context, id string) (TicketChildCountsRow, error) {
+	row := q.db.QueryRowContext(ctx, ticketChildCounts, id)
+	var i TicketChildCountsRow
+	err := row.Scan(
+		&i.Comments,
+		&i.Tasks,
+		&i.Files,
+		&i.Links,
+		&i.Timeline,
+	)
+	return i, err
+}
+
 const userByEmail = `-- name: UserByEmail :one
 SELECT id, username, passwordhash, tokenkey, active, name, email, avatar, lastresetsentat, lastverificationsentat, created, updated
 FROM users
@@ -40,6 +40,198 @@ func (q *WriteQueries) AssignParentGroup(ctx context.Context, arg AssignParentGr
 	return err
 }
 
+const buryReactionJob = `-- name: BuryReactionJob :exec
+UPDATE reaction_jobs
+SET status     = 'dead',
+    last_error = ?1,
+    updated    = ?2
+WHERE id = ?3
+`
+
+type BuryReactionJobParams struct {
+	LastError *string   `json:"last_error"`
+	Now       time.Time `json:"now"`
+	ID        string    `json:"id"`
+}
+
+func (q *WriteQueries) BuryReactionJob(ctx context.Context, arg BuryReactionJobParams) error {
+	_, err := q.db.ExecContext(ctx, buryReactionJob, arg.LastError, arg.Now, arg.ID)
+	return err
+}
+
+const claimReactionJob = `-- name: ClaimReactionJob :one
+UPDATE reaction_jobs
+SET status   = 'running',
+    attempts = attempts + 1,
+    updated  = ?1
+WHERE id = (SELECT id
+            FROM reaction_jobs
+            WHERE status = 'pending'
+              AND run_after <= ?1
+            ORDER BY run_after
+            LIMIT 1)
+RETURNING id, reaction, "trigger", payload, status, attempts, max_attempts, last_error, run, run_after, created, updated
+`
+
+func (q *WriteQueries) ClaimReactionJob(ctx context.Context, now time.Time) (ReactionJob, error) {
+	row := q.db.QueryRowContext(ctx, claimReactionJob, now)
+	var i ReactionJob
+	err := row.Scan(
+		&i.ID,
+		&i.Reaction,
+		&i.Trigger,
+		&i.Payload,
+		&i.Status,
+		&i.Attempts,
+		&i.MaxAttempts,
+		&i.LastError,
+		&i.Run,
+		&i.RunAfter,
+		&i.Created,
+		&i.Updated,
+	)
+	return i, err
+}
+
+const claimWebhookDelivery = `-- name: ClaimWebhookDelivery :one
+UPDATE webhook_deliveries
+SET status   = 'running',
+    attempts = attempts + 1,
+    updated  = ?1
+WHERE id = (SELECT id
+            FROM webhook_deliveries
+            WHERE status = 'pending'
+              AND next_attempt <= ?1
+            ORDER BY next_attempt
+            LIMIT 1)
+RETURNING id, webhook, event, collection, payload, status, attempts, max_attempts, response_status, response_body, last_error, next_attempt, created, updated
+`
+
+func (q *WriteQueries) ClaimWebhookDelivery(ctx context.Context, now time.Time) (WebhookDelivery, error) {
+	row := q.db.QueryRowContext(ctx, claimWebhookDelivery, now)
+	var i WebhookDelivery
+	err := row.Scan(
+		&i.ID,
+		&i.Webhook,
+		&i.Event,
+		&i.Collection,
+		&i.Payload,
+		&i.Status,
+		&i.Attempts,
+		&i.MaxAttempts,
+		&i.ResponseStatus,
+		&i.ResponseBody,
+		&i.LastError,
+		&i.NextAttempt,
+		&i.Created,
+		&i.Updated,
+	)
+	return i, err
+}
+
+const createAPIToken = `-- name: CreateAPIToken :one
+
+INSERT INTO api_tokens (user, name, token_hash, prefix, scopes, expires, created)
+VALUES (?1, ?2, ?3, ?4, ?5, ?6, ?7)
+RETURNING id, user, name, token_hash, prefix, scopes, expires, last_used, last_used_ip, created
+`
+
+type CreateAPITokenParams struct {
+	User      string     `json:"user"`
+	Name      string     `json:"name"`
+	TokenHash string     `json:"token_hash"`
+	Prefix    string     `json:"prefix"`
+	Scopes    string     `json:"scopes"`
+	Expires   *time.Time `json:"expires"`
+	Now       time.Time  `json:"now"`
+}
+
+// ----------------------------------------------------------------
+func (q *WriteQueries) CreateAPIToken(ctx context.Context, arg CreateAPITokenParams) (ApiToken, error) {
+	row := q.db.QueryRowContext(ctx, createAPIToken,
+		arg.User,
+		arg.Name,
+		arg.TokenHash,
+		arg.Prefix,
+		arg.Scopes,
+		arg.Expires,
+		arg.Now,
+	)
+	var i ApiToken
+	err := row.Scan(
+		&i.ID,
+		&i.User,
+		&i.Name,
+		&i.TokenHash,
+		&i.Prefix,
+		&i.Scopes,
+		&i.Expires,
+		&i.LastUsed,
+		&i.LastUsedIp,
+		&i.Created,
+	)
+	return i, err
+}
+
+const createActionToken = `-- name: CreateActionToken :one
+
+INSERT INTO action_tokens (expires, created)
+VALUES (?1, ?2)
+RETURNING id, expires, created
+`
+
+type CreateActionTokenParams struct {
+	Expires time.Time `json:"expires"`
+	Now     time.Time `json:"now"`
+}
+
+// ----------------------------------------------------------------
+func (q *WriteQueries) CreateActionToken(ctx context.Context, arg CreateActionTokenParams) (ActionToken, error) {
+	row := q.db.QueryRowContext(ctx, createActionToken, arg.Expires, arg.Now)
+	var i ActionToken
+	err := row.Scan(&i.ID, &i.Expires, &i.Created)
+	return i, err
+}
+
+const createChange = `-- name: CreateChange :one
+
+INSERT INTO changes (collection, record, action, actor, snapshot, created)
+VALUES (?1, ?2, ?3, ?4, ?5, ?6)
+RETURNING seq, collection, record, "action", actor, snapshot, created
+`
+
+type CreateChangeParams struct {
+	Collection string    `json:"collection"`
+	Record     string    `json:"record"`
+	Action     string    `json:"action"`
+	Actor      *string   `json:"actor"`
+	Snapshot   []byte    `json:"snapshot"`
+	Now        time.Time `json:"now"`
+}
+
+// ----------------------------------------------------------------
+func (q *WriteQueries) CreateChange(ctx context.Context, arg CreateChangeParams) (Change, error) {
+	row := q.db.QueryRowContext(ctx, createChange,
+		arg.Collection,
+		arg.Record,
+		arg.Action,
+		arg.Actor,
+		arg.Snapshot,
+		arg.Now,
+	)
+	var i Change
+	err := row.Scan(
+		&i.Seq,
+		&i.Collection,
+		&i.Record,
+		&i.Action,
+		&i.Actor,
+		&i.Snapshot,
+		&i.Created,
+	)
+	return i, err
+}
+
 const createComment = `-- name: CreateComment :one
 INSERT INTO comments (author, message, ticket)
 VALUES (?1, ?2, ?3)
@@ -66,6 +258,45 @@ func (q *WriteQueries) CreateComment(ctx context.Context, arg CreateCommentParam
 	return i, err
 }
 
+const createEvent = `-- name: CreateEvent :one
+
+INSERT INTO events (action, collection, record, ticket, payload, created)
+VALUES (?1, ?2, ?3, ?4, ?5, ?6)
+RETURNING id, "action", collection, record, ticket, payload, created
+`
+
+type CreateEventParams struct {
+	Action     string    `json:"action"`
+	Collection string    `json:"collection"`
+	Record     string    `json:"record"`
+	Ticket     *string   `json:"ticket"`
+	Payload    []byte    `json:"payload"`
+	Now        time.Time `json:"now"`
+}
+
+// ----------------------------------------------------------------
+func (q *WriteQueries) CreateEvent(ctx context.Context, arg CreateEventParams) (Event, error) {
+	row := q.db.QueryRowContext(ctx, createEvent,
+		arg.Action,
+		arg.Collection,
+		arg.Record,
+		arg.Ticket,
+		arg.Payload,
+		arg.Now,
+	)
+	var i Event
+	err := row.Scan(
+		&i.ID,
+		&i.Action,
+		&i.Collection,
+		&i.Record,
+		&i.Ticket,
+		&i.Payload,
+		&i.Created,
+	)
+	return i, err
+}
+
 const createFeature = `-- name: CreateFeature :one
 
 INSERT INTO features (key)
@@ -163,6 +394,30 @@ func (q *WriteQueries) CreateLink(ctx context.Context, arg CreateLinkParams) (Li
 	return i, err
 }
 
+const createOIDCIdentity = `-- name: CreateOIDCIdentity :exec
+
+INSERT INTO oidc_identities (issuer, subject, user, created)
+VALUES (?1, ?2, ?3, ?4)
+`
+
+type CreateOIDCIdentityParams struct {
+	Issuer  string    `json:"issuer"`
+	Subject string    `json:"subject"`
+	User    string    `json:"user"`
+	Now     time.Time `json:"now"`
+}
+
+// ----------------------------------------------------------------
+func (q *WriteQueries) CreateOIDCIdentity(ctx context.Context, arg CreateOIDCIdentityParams) error {
+	_, err := q.db.ExecContext(ctx, createOIDCIdentity,
+		arg.Issuer,
+		arg.Subject,
+		arg.User,
+		arg.Now,
+	)
+	return err
+}
+
 const createParam = `-- name: CreateParam :exec
 INSERT INTO _params (key, value)
 VALUES (?1, ?2)
@@ -215,6 +470,150 @@ func (q *WriteQueries) CreateReaction(ctx context.Context, arg CreateReactionPar
 	return i, err
 }
 
+const createReactionJob = `-- name: CreateReactionJob :one
+
+INSERT INTO reaction_jobs (reaction, trigger, payload, run, status, run_after, created, updated)
+VALUES (?1, ?2, ?3, ?4, 'pending', ?5, ?5, ?5)
+RETURNING id, reaction, "trigger", payload, status, attempts, max_attempts, last_error, run, run_after, created, updated
+`
+
+type CreateReactionJobParams struct {
+	Reaction string    `json:"reaction"`
+	Trigger  string    `json:"trigger"`
+	Payload  []byte    `json:"payload"`
+	Run      *string   `json:"run"`
+	Now      time.Time `json:"now"`
+}
+
+// ----------------------------------------------------------------
+func (q *WriteQueries) CreateReactionJob(ctx context.Context, arg CreateReactionJobParams) (ReactionJob, error) {
+	row := q.db.QueryRowContext(ctx, createReactionJob,
+		arg.Reaction,
+		arg.Trigger,
+		arg.Payload,
+		arg.Run,
+		arg.Now,
+	)
+	var i ReactionJob
+	err := row.Scan(
+		&i.ID,
+		&i.Reaction,
+		&i.Trigger,
+		&i.Payload,
+		&i.Status,
+		&i.Attempts,
+		&i.MaxAttempts,
+		&i.LastError,
+		&i.Run,
+		&i.RunAfter,
+		&i.Created,
+		&i.Updated,
+	)
+	return i, err
+}
+
+const createReactionRun = `-- name: CreateReactionRun :one
+INSERT INTO reaction_runs (reaction, trigger, status, input, started)
+VALUES (?1, ?2, ?3, ?4, ?5)
+RETURNING id, reaction, "trigger", status, input, output, error, exit_code, started, finished
+`
+
+type CreateReactionRunParams struct {
+	Reaction string    `json:"reaction"`
+	Trigger  string    `json:"trigger"`
+	Status   string    `json:"status"`
+	Input    []byte    `json:"input"`
+	Started  time.Time `json:"started"`
+}
+
+func (q *WriteQueries) CreateReactionRun(ctx context.Context, arg CreateReactionRunParams) (ReactionRun, error) {
+	row := q.db.QueryRowContext(ctx, createReactionRun,
+		arg.Reaction,
+		arg.Trigger,
+		arg.Status,
+		arg.Input,
+		arg.Started,
+	)
+	var i ReactionRun
+	err := row.Scan(
+		&i.ID,
+		&i.Reaction,
+		&i.Trigger,
+		&i.Status,
+		&i.Input,
+		&i.Output,
+		&i.Error,
+		&i.ExitCode,
+		&i.Started,
+		&i.Finished,
+	)
+	return i, err
+}
+
+const createSecret = `-- name: CreateSecret :one
+
+INSERT INTO secrets (name, value, created, updated)
+VALUES (?1, ?2, ?3, ?3)
+RETURNING id, name, value, created, updated
+`
+
+type CreateSecretParams struct {
+	Name  string    `json:"name"`
+	Value []byte    `json:"value"`
+	Now   time.Time `json:"now"`
+}
+
+// ----------------------------------------------------------------
+func (q *WriteQueries) CreateSecret(ctx context.Context, arg CreateSecretParams) (Secret, error) {
+	row := q.db.QueryRowContext(ctx, createSecret, arg.Name, arg.Value, arg.Now)
+	var i Secret
+	err := row.Scan(
+		&i.ID,
+		&i.Name,
+		&i.Value,
+		&i.Created,
+		&i.Updated,
+	)
+	return i, err
+}
+
+const createSession = `-- name: CreateSession :one
+
+INSERT INTO sessions (user, user_agent, ip, expires, last_seen, created)
+VALUES (?1, ?2, ?3, ?4, ?5, ?5)
+RETURNING id, user, user_agent, ip, expires, last_seen, created
+`
+
+type CreateSessionParams struct {
+	User      string    `json:"user"`
+	UserAgent string    `json:"user_agent"`
+	Ip        string    `json:"ip"`
+	Expires   time.Time `json:"expires"`
+	Now       time.Time `json:"now"`
+}
+
+// ----------------------------------------------------------------
+func (q *WriteQueries) CreateSession(ctx context.Context, arg CreateSessionParams) (Session, error) {
+	row := q.db.QueryRowContext(ctx, createSession,
+		arg.User,
+		arg.UserAgent,
+		arg.Ip,
+		arg.Expires,
+		arg.Now,
+	)
+	var i Session
+	err := row.Scan(
+		&i.ID,
+		&i.User,
+		&i.UserAgent,
+		&i.Ip,
+		&i.Expires,
+		&i.LastSeen,
+		&i.Created,
+	)
+	return i, err
+}
+
 const createTask = `-- name: CreateTask :one
 INSERT INTO tasks (name, open, owner, ticket)
 VALUES (?1, ?2, ?3, ?4)
@@ -397,19 +796,33 @@ func (q *WriteQueries) CreateUser(ctx context.Context, arg CreateUserParams) (Us
 }
 
 const createWebhook = `-- name: CreateWebhook :one
-INSERT INTO webhooks (name, collection, destination)
-VALUES (?1, ?2, ?3)
-RETURNING id, collection, destination, name, created, updated
+INSERT INTO webhooks (name, collection, destination, events, filter, secret, headers, format)
+VALUES (?1, ?2, ?3, ?4, ?5, ?6, ?7, ?8)
+RETURNING id, collection, destination, name, created, updated, events, "filter", secret, headers, format
 `
 
 type CreateWebhookParams struct {
 	Name        string `json:"name"`
 	Collection  string `json:"collection"`
 	Destination string `json:"destination"`
+	Events      string `json:"events"`
+	Filter      string `json:"filter"`
+	Secret      string `json:"secret"`
+	Headers     string `json:"headers"`
+	Format      string `json:"format"`
 }
 
 func (q *WriteQueries) CreateWebhook(ctx context.Context, arg CreateWebhookParams) (Webhook, error) {
-	row := q.db.QueryRowContext(ctx, createWebhook, arg.Name, arg.Collection, arg.Destination)
+	row := q.db.QueryRowContext(ctx, createWebhook,
+		arg.Name,
+		arg.Collection,
+		arg.Destination,
+		arg.Events,
+		arg.Filter,
+		arg.Secret,
+		arg.Headers,
+		arg.Format,
+	)
 	var i Webhook
 	err := row.Scan(
 		&i.ID,
@@ -418,10 +831,90 @@ func (q *WriteQueries) CreateWebhook(ctx context.Context, arg CreateWebhookParam
 		&i.Name,
 		&i.Created,
 		&i.Updated,
+		&i.Events,
+		&i.Filter,
+		&i.Secret,
+		&i.Headers,
+		&i.Format,
 	)
 	return i, err
 }
 
+const createWebhookDelivery = `-- name: CreateWebhookDelivery :one
+
+INSERT INTO webhook_deliveries (webhook, event, collection, payload, status, next_attempt, created, updated)
+VALUES (?1, ?2, ?3, ?4, 'pending', ?5, ?5, ?5)
+RETURNING id, webhook, event, collection, payload, status, attempts, max_attempts, response_status, response_body, last_error, next_attempt, created, updated
+`
+
+type CreateWebhookDeliveryParams struct {
+	Webhook    string    `json:"webhook"`
+	Event      string    `json:"event"`
+	Collection string    `json:"collection"`
+	Payload    []byte    `json:"payload"`
+	Now        time.Time `json:"now"`
+}
+
+// ----------------------------------------------------------------
+func (q *WriteQueries) CreateWebhookDelivery(ctx context.Context, arg CreateWebhookDeliveryParams) (WebhookDelivery, error) {
+	row := q.db.QueryRowContext(ctx, createWebhookDelivery,
+		arg.Webhook,
+		arg.Event,
+		arg.Collection,
+		arg.Payload,
+		arg.Now,
+	)
+	var i WebhookDelivery
+	err := row.Scan(
+		&i.ID,
+		&i.Webhook,
+		&i.Event,
+		&i.Collection,
+		&i.Payload,
+		&i.Status,
+		&i.Attempts,
+		&i.MaxAttempts,
+		&i.ResponseStatus,
+		&i.ResponseBody,
+		&i.LastError,
+		&i.NextAttempt,
+		&i.Created,
+		&i.Updated,
+	)
+	return i, err
+}
+
+const deleteAPIToken = `-- name: DeleteAPIToken :one
+DELETE
+FROM api_tokens
+WHERE id = ?1
+  AND user = ?2
+RETURNING id
+`
+
+type DeleteAPITokenParams struct {
+	ID   string `json:"id"`
+	User string `json:"user"`
+}
+
+func (q *WriteQueries) DeleteAPIToken(ctx context.Context, arg DeleteAPITokenParams) (string, error) {
+	row := q.db.QueryRowContext(ctx, deleteAPIToken, arg.ID, arg.User)
+	var id string
+	err := row.Scan(&id)
+	return id, err
+}
+
+const deleteActionToken = `-- name: DeleteActionToken :exec
+DELETE
+FROM action_tokens
+WHERE id = ?1
+`
+
+func (q *WriteQueries) DeleteActionToken(ctx context.Context, id string) error {
+	_, err := q.db.ExecContext(ctx, deleteActionToken, id)
+	return err
+}
+
 const deleteComment = `-- name: DeleteComment :exec
 DELETE
 FROM comments
@@ -433,6 +926,51 @@ func (q *WriteQueries) DeleteComment(ctx context.Context, id string) error {
 	return err
 }
 
+const deleteDeadReactionJobs = `-- name: DeleteDeadReactionJobs :exec
+DELETE
+FROM reaction_jobs
+WHERE status = 'dead'
+  AND updated < ?1
+`
+
+func (q *WriteQueries) DeleteDeadReactionJobs(ctx context.Context, before time.Time) error {
+	_, err := q.db.ExecContext(ctx, deleteDeadReactionJobs, before)
+	return err
+}
+
+const deleteEventsBefore = `-- name: DeleteEventsBefore :exec
+DELETE
+FROM events
+WHERE created < ?1
+`
+
+func (q *WriteQueries) DeleteEventsBefore(ctx context.Context, before time.Time) error {
+	_, err := q.db.ExecContext(ctx, deleteEventsBefore, before)
+	return err
+}
+
+const deleteExpiredActionTokens = `-- name: DeleteExpiredActionTokens :exec
+DELETE
+FROM action_tokens
+WHERE expires < ?1
+`
+
+func (q *WriteQueries) DeleteExpiredActionTokens(ctx context.Context, now time.Time) error {
+	_, err := q.db.ExecContext(ctx, deleteExpiredActionTokens, now)
+	return err
+}
+
+const deleteExpiredSessions = `-- name: DeleteExpiredSessions :exec
+DELETE
+FROM sessions
+WHERE expires < ?1
+`
+
+func (q *WriteQueries) DeleteExpiredSessions(ctx context.Context, now time.Time) error {
+	_, err := q.db.ExecContext(ctx, deleteExpiredSessions, now)
+	return err
+}
+
 const deleteFeature = `-- name: DeleteFeature :exec
 DELETE
 FROM features
@@ -455,6 +993,18 @@ func (q *WriteQueries) DeleteFile(ctx context.Context, id string) error {
 	return err
 }
 
+const deleteFinishedWebhookDeliveries = `-- name: DeleteFinishedWebhookDeliveries :exec
+DELETE
+FROM webhook_deliveries
+WHERE status IN ('delivered', 'failed')
+  AND updated < ?1
+`
+
+func (q *WriteQueries) DeleteFinishedWebhookDeliveries(ctx context.Context, before time.Time) error {
+	_, err := q.db.ExecContext(ctx, deleteFinishedWebhookDeliveries, before)
+	return err
+}
+
 const deleteGroup = `-- name: DeleteGroup :exec
 DELETE
 FROM groups
@@ -488,6 +1038,48 @@ func (q *WriteQueries) DeleteReaction(ctx context.Context, id string) error {
 	return err
 }
 
+const deleteReactionJob = `-- name: DeleteReactionJob :exec
+DELETE
+FROM reaction_jobs
+WHERE id = ?1
+`
+
+func (q *WriteQueries) DeleteReactionJob(ctx context.Context, id string) error {
+	_, err := q.db.ExecContext(ctx, deleteReactionJob, id)
+	return err
+}
+
+const deleteSecret = `-- name: DeleteSecret :exec
+DELETE
+FROM secrets
+WHERE id = ?1
+`
+
+func (q *WriteQueries) DeleteSecret(ctx context.Context, id string) error {
+	_, err := q.db.ExecContext(ctx, deleteSecret, id)
+	return err
+}
+
+const deleteSession = `-- name: DeleteSession :one
+DELETE
+FROM sessions
+WHERE id = ?1
+  AND user = ?2
+RETURNING id
+`
+
+type DeleteSessionParams struct {
+	ID   string `json:"id"`
+	User string `json:"user"`
+}
+
+func (q *WriteQueries) DeleteSession(ctx context.Context, arg DeleteSessionParams) (string, error) {
+	row := q.db.QueryRowContext(ctx, deleteSession, arg.ID, arg.User)
+	var id string
+	err := row.Scan(&id)
+	return id, err
+}
+
 const deleteTask = `-- name: DeleteTask :exec
 DELETE
 FROM tasks
@@ -544,6 +1136,17 @@ func (q *WriteQueries) DeleteUser(ctx context.Context, id string) error {
 	return err
 }
 
+const deleteUserSessions = `-- name: DeleteUserSessions :exec
+DELETE
+FROM sessions
+WHERE user = ?1
+`
+
+func (q *WriteQueries) DeleteUserSessions(ctx context.Context, user string) error {
+	_, err := q.db.ExecContext(ctx, deleteUserSessions, user)
+	return err
+}
+
 const deleteWebhook = `-- name: DeleteWebhook :exec
 DELETE
 FROM webhooks
@@ -555,6 +1158,107 @@ func (q *WriteQueries) DeleteWebhook(ctx context.Context, id string) error {
 	return err
 }
 
+const failOrphanedReactionRuns = `-- name: FailOrphanedReactionRuns :exec
+UPDATE reaction_runs
+SET status   = 'failed',
+    error    = ?1,
+    finished = ?2
+WHERE status = 'running'
+  AND id NOT IN (SELECT run
+                 FROM reaction_jobs
+                 WHERE run IS NOT NULL
+                   AND status != 'dead')
+`
+
+type FailOrphanedReactionRunsParams struct {
+	Error *string    `json:"error"`
+	Now   *time.Time `json:"now"`
+}
+
+func (q *WriteQueries) FailOrphanedReactionRuns(ctx context.Context, arg FailOrphanedReactionRunsParams) error {
+	_, err := q.db.ExecContext(ctx, failOrphanedReactionRuns, arg.Error, arg.Now)
+	return err
+}
+
+const finishReactionRun = `-- name: FinishReactionRun :one
+UPDATE reaction_runs
+SET status    = ?1,
+    output    = ?2,
+    error     = ?3,
+    exit_code = ?4,
+    finished  = ?5
+WHERE id = ?6
+RETURNING id, reaction, "trigger", status, input, output, error, exit_code, started, finished
+`
+
+type FinishReactionRunParams struct {
+	Status   string     `json:"status"`
+	Output   string     `json:"output"`
+	Error    *string    `json:"error"`
+	ExitCode *int64     `json:"exit_code"`
+	Finished *time.Time `json:"finished"`
+	ID       string     `json:"id"`
+}
+
+func (q *WriteQueries) FinishReactionRun(ctx context.Context, arg FinishReactionRunParams) (ReactionRun, error) {
+	row := q.db.QueryRowContext(ctx, finishReactionRun,
+		arg.Status,
+		arg.Output,
+		arg.Error,
+		arg.ExitCode,
+		arg.Finished,
+		arg.ID,
+	)
+	var i ReactionRun
+	err := row.Scan(
+		&i.ID,
+		&i.Reaction,
+		&i.Trigger,
+		&i.Status,
+		&i.Input,
+		&i.Output,
+		&i.Error,
+		&i.ExitCode,
+		&i.Started,
+		&i.Finished,
+	)
+	return i, err
+}
+
+const finishWebhookDelivery = `-- name: FinishWebhookDelivery :exec
+UPDATE webhook_deliveries
+SET status          = ?1,
+    response_status = ?2,
+    response_body   = ?3,
+    last_error      = ?4,
+    next_attempt    = ?5,
+    updated         = ?6
+WHERE id = ?7
+`
+
+type FinishWebhookDeliveryParams struct {
+	Status         string    `json:"status"`
+	ResponseStatus *int64    `json:"response_status"`
+	ResponseBody   *string   `json:"response_body"`
+	LastError      *string   `json:"last_error"`
+	NextAttempt    time.Time `json:"next_attempt"`
+	Now            time.Time `json:"now"`
+	ID             string    `json:"id"`
+}
+
+func (q *WriteQueries) FinishWebhookDelivery(ctx context.Context, arg FinishWebhookDeliveryParams) error {
+	_, err := q.db.ExecContext(ctx, finishWebhookDelivery,
+		arg.Status,
+		arg.ResponseStatus,
+		arg.ResponseBody,
+		arg.LastError,
+		arg.NextAttempt,
+		arg.Now,
+		arg.ID,
+	)
+	return err
+}
+
 const insertComment = `-- name: InsertComment :one
 
 INSERT INTO comments (id, author, message, ticket, created, updated)
@@ -751,6 +1455,54 @@ func (q *WriteQueries) InsertReaction(ctx context.Context, arg InsertReactionPar
 	return i, err
 }
 
+const insertReactionRun = `-- name: InsertReactionRun :one
+INSERT INTO reaction_runs (id, reaction, trigger, status, input, output, error, exit_code, started, finished)
+VALUES (?1, ?2, ?3, ?4, ?5, ?6, ?7, ?8, ?9, ?10)
+RETURNING id, reaction, "trigger", status, input, output, error, exit_code, started, finished
+`
+
+type InsertReactionRunParams struct {
+	ID       string     `json:"id"`
+	Reaction string     `json:"reaction"`
+	Trigger  string     `json:"trigger"`
+	Status   string     `json:"status"`
+	Input    []byte     `json:"input"`
+	Output   string     `json:"output"`
+	Error    *string    `json:"error"`
+	ExitCode *int64     `json:"exit_code"`
+	Started  time.Time  `json:"started"`
+	Finished *time.Time `json:"finished"`
+}
+
+func (q *WriteQueries) InsertReactionRun(ctx context.Context, arg InsertReactionRunParams) (ReactionRun, error) {
+	row := q.db.QueryRowContext(ctx, insertReactionRun,
+		arg.ID,
+		arg.Reaction,
+		arg.Trigger,
+		arg.Status,
+		arg.Input,
+		arg.Output,
+		arg.Error,
+		arg.ExitCode,
+		arg.Started,
+		arg.Finished,
+	)
+	var i ReactionRun
+	err := row.Scan(
+		&i.ID,
+		&i.Reaction,
+		&i.Trigger,
+		&i.Status,
+		&i.Input,
+		&i.Output,
+		&i.Error,
+		&i.ExitCode,
+		&i.Started,
+		&i.Finished,
+	)
+	return i, err
+}
+
 const insertTask = `-- name: InsertTask :one
 
 INSERT INTO tasks (id, name, open, owner, ticket, created, updated)
@@ -978,9 +1730,9 @@ func (q *WriteQueries) InsertUser(ctx context.Context, arg InsertUserParams) (Us
 
 const insertWebhook = `-- name: InsertWebhook :one
 
-INSERT INTO webhooks (id, name, collection, destination, created, updated)
-VALUES (?1, ?2, ?3, ?4, ?5, ?6)
-RETURNING id, collection, destination, name, created, updated
+INSERT INTO webhooks (id, name, collection, destination, events, filter, secret, headers, format, created, updated)
+VALUES (?1, ?2, ?3, ?4, ?5, ?6, ?7, ?8, ?9, ?10, ?11)
+RETURNING id, collection, destination, name, created, updated, events, "filter", secret, headers, format
 `
 
 type InsertWebhookParams struct {
@@ -988,6 +1740,11 @@ type InsertWebhookParams struct {
 	Name        string    `json:"name"`
 	Collection  string    `json:"collection"`
 	Destination string    `json:"destination"`
+	Events      string    `json:"events"`
+	Filter      string    `json:"filter"`
+	Secret      string    `json:"secret"`
+	Headers     string    `json:"headers"`
+	Format      string    `json:"format"`
 	Created     time.Time `json:"created"`
 	Updated     time.Time `json:"updated"`
 }
@@ -999,6 +1756,11 @@ func (q *WriteQueries) InsertWebhook(ctx context.Context, arg InsertWebhookParam
 		arg.Name,
 		arg.Collection,
 		arg.Destination,
+		arg.Events,
+		arg.Filter,
+		arg.Secret,
+		arg.Headers,
+		arg.Format,
 		arg.Created,
 		arg.Updated,
 	)
@@ -1010,6 +1772,48 @@ func (q *WriteQueries) InsertWebhook(ctx context.Context, arg InsertWebhookParam
 		&i.Name,
 		&i.Created,
 		&i.Updated,
+		&i.Events,
+		&i.Filter,
+		&i.Secret,
+		&i.Headers,
+		&i.Format,
+	)
+	return i, err
+}
+
+const redeliverWebhookDelivery = `-- name: RedeliverWebhookDelivery :one
+INSERT INTO webhook_deliveries (webhook, event, collection, payload, status, next_attempt, created, updated)
+SELECT d.webhook, d.event, d.collection, d.payload, 'pending', ?1, ?1, ?1
+FROM webhook_deliveries AS d
+WHERE d.id = ?2
+  AND d.webhook = ?3
+RETURNING id, webhook, event, collection, payload, status, attempts, max_attempts, response_status, response_body, last_error, next_attempt, created, updated
+`
+
+type RedeliverWebhookDeliveryParams struct {
+	Now     time.Time `json:"now"`
+	ID      string    `json:"id"`
+	Webhook string    `json:"webhook"`
+}
+
+func (q *WriteQueries) RedeliverWebhookDelivery(ctx context.Context, arg RedeliverWebhookDeliveryParams) (WebhookDelivery, error) {
+	row := q.db.QueryRowContext(ctx, redeliverWebhookDelivery, arg.Now, arg.ID, arg.Webhook)
+	var i WebhookDelivery
+	err := row.Scan(
+		&i.ID,
+		&i.Webhook,
+		&i.Event,
+		&i.Collection,
+		&i.Payload,
+		&i.Status,
+		&i.Attempts,
+		&i.MaxAttempts,
+		&i.ResponseStatus,
+		&i.ResponseBody,
+		&i.LastError,
+		&i.NextAttempt,
+		&i.Created,
+		&i.Updated,
 	)
 	return i, err
 }
@@ -1048,6 +1852,92 @@ func (q *WriteQueries) RemoveParentGroup(ctx context.Context, arg RemoveParentGr
 	return err
 }
 
+const requeueRunningReactionJobs = `-- name: RequeueRunningReactionJobs :exec
+UPDATE reaction_jobs
+SET status  = 'pending',
+    updated = ?1
+WHERE status = 'running'
+`
+
+func (q *WriteQueries) RequeueRunningReactionJobs(ctx context.Context, now time.Time) error {
+	_, err := q.db.ExecContext(ctx, requeueRunningReactionJobs, now)
+	return err
+}
+
+const requeueRunningWebhookDeliveries = `-- name: RequeueRunningWebhookDeliveries :exec
+UPDATE webhook_deliveries
+SET status  = 'pending',
+    updated = ?1
+WHERE status = 'running'
+`
+
+func (q *WriteQueries) RequeueRunningWebhookDeliveries(ctx context.Context, now time.Time) error {
+	_, err := q.db.ExecContext(ctx, requeueRunningWebhookDeliveries, now)
+	return err
+}
+
+const retryReactionJob = `-- name: RetryReactionJob :exec
+UPDATE reaction_jobs
+SET status     = 'pending',
+    last_error = ?1,
+    run_after  = ?2,
+    updated    = ?3
+WHERE id = ?4
+`
+
+type RetryReactionJobParams struct {
+	LastError *string   `json:"last_error"`
+	RunAfter  time.Time `json:"run_after"`
+	Now       time.Time `json:"now"`
+	ID        string    `json:"id"`
+}
+
+func (q *WriteQueries) RetryReactionJob(ctx context.Context, arg RetryReactionJobParams) error {
+	_, err := q.db.ExecContext(ctx, retryReactionJob,
+		arg.LastError,
+		arg.RunAfter,
+		arg.Now,
+		arg.ID,
+	)
+	return err
+}
+
+const setReactionJobRun = `-- name: SetReactionJobRun :exec
+UPDATE reaction_jobs
+SET run     = ?1,
+    updated = ?2
+WHERE id = ?3
+`
+
+type SetReactionJobRunParams struct {
+	Run *string   `json:"run"`
+	Now time.Time `json:"now"`
+	ID  string    `json:"id"`
+}
+
+func (q *WriteQueries) SetReactionJobRun(ctx context.Context, arg SetReactionJobRunParams) error {
+	_, err := q.db.ExecContext(ctx, setReactionJobRun, arg.Run, arg.Now, arg.ID)
+	return err
+}
+
+const updateAPITokenUsage = `-- name: UpdateAPITokenUsage :exec
+UPDATE api_tokens
+SET last_used    = ?1,
+    last_used_ip = ?2
+WHERE id = ?3
+`
+
+type UpdateAPITokenUsageParams struct {
+	Now *time.Time `json:"now"`
+	Ip  *string    `json:"ip"`
+	ID  string     `json:"id"`
+}
+
+func (q *WriteQueries) UpdateAPITokenUsage(ctx context.Context, arg UpdateAPITokenUsageParams) error {
+	_, err := q.db.ExecContext(ctx, updateAPITokenUsage, arg.Now, arg.Ip, arg.ID)
+	return err
+}
+
 const updateComment = `-- name: UpdateComment :one
 UPDATE comments
 SET message = coalesce(?1, message)
@@ -1225,6 +2115,56 @@ func (q *WriteQueries) UpdateReaction(ctx context.Context, arg UpdateReactionPar
 	return i, err
 }
 
+const updateSecret = `-- name: UpdateSecret :one
+UPDATE secrets
+SET name    = coalesce(?1, name),
+    value   = coalesce(?2, value),
+    updated = ?3
+WHERE id = ?4
+RETURNING id, name, value, created, updated
+`
+
+type UpdateSecretParams struct {
+	Name  *string   `json:"name"`
+	Value []byte    `json:"value"`
+	Now   time.Time `json:"now"`
+	ID    string    `json:"id"`
+}
+
+func (q *WriteQueries) UpdateSecret(ctx context.Context, arg UpdateSecretParams) (Secret, error) {
+	row := q.db.QueryRowContext(ctx, updateSecret,
+		arg.Name,
+		arg.Value,
+		arg.Now,
+		arg.ID,
+	)
+	var i Secret
+	err := row.Scan(
+		&i.ID,
+		&i.Name,
+		&i.Value,
+		&i.Created,
+		&i.Updated,
+	)
+	return i, err
+}
+
+const updateSessionLastSeen = `-- name: UpdateSessionLastSeen :exec
+UPDATE sessions
+SET last_seen = ?1
+WHERE id = ?2
+`
+
+type UpdateSessionLastSeenParams struct {
+	Now time.Time `json:"now"`
+	ID  string    `json:"id"`
+}
+
+func (q *WriteQueries) UpdateSessionLastSeen(ctx context.Context, arg UpdateSessionLastSeenParams) error {
+	_, err := q.db.ExecContext(ctx, updateSessionLastSeen, arg.Now, arg.ID)
+	return err
+}
+
 const updateTask = `-- name: UpdateTask :one
 UPDATE tasks
 SET name  = coalesce(?1, name),
@@ -1447,15 +2387,25 @@ const updateWebhook = `-- name: UpdateWebhook :one
 UPDATE webhooks
 SET name        = coalesce(?1, name),
     collection  = coalesce(?2, collection),
-    destination = coalesce(?3, destination)
-WHERE id = ?4
-RETURNING id, collection, destination, name, created, updated
+    destination = coalesce(?3, destination),
+    events      = coalesce(?4, events),
+    filter      = coalesce(?5, filter),
+    secret      = coalesce(?6, secret),
+    headers     = coalesce(?7, headers),
+    format      = coalesce(?8, format)
+WHERE id = ?9
+RETURNING id, collection, destination, name, created, updated, events, "filter", secret, headers, format
 `
 
 type UpdateWebhookParams struct {
 	Name        *string `json:"name"`
 	Collection  *string `json:"collection"`
 	Destination *string `json:"destination"`
+	Events      *string `json:"events"`
+	Filter      *string `json:"filter"`
+	Secret      *string `json:"secret"`
+	Headers     *string `json:"headers"`
+	Format      *string `json:"format"`
 	ID          string  `json:"id"`
 }
 
@@ -1464,6 +2414,11 @@ func (q *WriteQueries) UpdateWebhook(ctx context.Context, arg UpdateWebhookParam
 		arg.Name,
 		arg.Collection,
 		arg.Destination,
+		arg.Events,
+		arg.Filter,
+		arg.Secret,
+		arg.Headers,
+		arg.Format,
 		arg.ID,
 	)
 	var i Webhook
@@ -1474,6 +2429,11 @@ func (q *WriteQueries) UpdateWebhook(ctx context.Context, arg UpdateWebhookParam
 		&i.Name,
 		&i.Created,
 		&i.Updated,
+		&i.Events,
+		&i.Filter,
+		&i.Secret,
+		&i.Headers,
+		&i.Format,
 	)
 	return i, err
 }
@@ -18,14 +18,16 @@ var (
 	ReactionsTable = Table{ID: "reactions", Name: "Reactions"}
 	WebhooksTable  = Table{ID: "webhooks", Name: "Webhooks"}
 
-	DashboardCountsTable = Table{ID: "dashboard_counts", Name: "Dashboard Counts"}
-	SidebarTable         = Table{ID: "sidebar", Name: "Sidebar"}
-	UserPermissionTable  = Table{ID: "user_permissions", Name: "User Permissions"}
-	UserGroupTable       = Table{ID: "user_groups", Name: "User Groups"}
-	GroupUserTable       = Table{ID: "group_users", Name: "Group Users"}
-	GroupPermissionTable = Table{ID: "group_permissions", Name: "Group Permissions"}
-	GroupParentTable     = Table{ID: "group_parents", Name: "Group Parents"}
-	GroupChildTable      = Table{ID: "group_children", Name: "Group Children"}
+	DashboardCountsTable   = Table{ID: "dashboard_counts", Name: "Dashboard Counts"}
+	SidebarTable           = Table{ID: "sidebar", Name: "Sidebar"}
+	UserPermissionTable    = Table{ID: "user_permissions", Name: "User Permissions"}
+	UserGroupTable         = Table{ID: "user_groups", Name: "User Groups"}
+	GroupUserTable         = Table{ID: "group_users", Name: "Group Users"}
+	GroupPermissionTable   = Table{ID: "group_permissions", Name: "Group Permissions"}
+	GroupParentTable       = Table{ID: "group_parents", Name: "Group Parents"}
+	GroupChildTable        = Table{ID: "group_children", Name: "Group Children"}
+	ReactionRunsTable      = Table{ID: "reaction_runs", Name: "Reaction Runs"}
+	WebhookDeliveriesTable = Table{ID: "webhook_deliveries", Name: "Webhook Deliveries"}
 
 	CreateAction = "create"
 	UpdateAction = "update"
@@ -150,6 +150,95 @@ DELETE
 FROM reactions
 WHERE id = @id;
 
+-- name: InsertReactionRun :one
+INSERT INTO reaction_runs (id, reaction, trigger, status, input, output, error, exit_code, started, finished)
+VALUES (@id, @reaction, @trigger, @status, @input, @output, @error, @exit_code, @started, @finished)
+RETURNING *;
+
+-- name: CreateReactionRun :one
+INSERT INTO reaction_runs (reaction, trigger, status, input, started)
+VALUES (@reaction, @trigger, @status, @input, @started)
+RETURNING *;
+
+-- name: FinishReactionRun :one
+UPDATE reaction_runs
+SET status    = @status,
+    output    = @output,
+    error     = @error,
+    exit_code = @exit_code,
+    finished  = @finished
+WHERE id = @id
+RETURNING *;
+
+------------------------------------------------------------------
+
+-- name: CreateReactionJob :one
+INSERT INTO reaction_jobs (reaction, trigger, payload, run, status, run_after, created, updated)
+VALUES (@reaction, @trigger, @payload, @run, 'pending', @now, @now, @now)
+RETURNING *;
+
+-- name: ClaimReactionJob :one
+UPDATE reaction_jobs
+SET status   = 'running',
+    attempts = attempts + 1,
+    updated  = @now
+WHERE id = (SELECT id
+            FROM reaction_jobs
+            WHERE status = 'pending'
+              AND run_after <= @now
+            ORDER BY run_after
+            LIMIT 1)
+RETURNING *;
+
+-- name: SetReactionJobRun :exec
+UPDATE reaction_jobs
+SET run     = @run,
+    updated = @now
+WHERE id = @id;
+
+-- name: RetryReactionJob :exec
+UPDATE reaction_jobs
+SET status     = 'pending',
+    last_error = @last_error,
+    run_after  = @run_after,
+    updated    = @now
+WHERE id = @id;
+
+-- name: BuryReactionJob :exec
+UPDATE reaction_jobs
+SET status     = 'dead',
+    last_error = @last_error,
+    updated    = @now
+WHERE id = @id;
+
+-- name: RequeueRunningReactionJobs :exec
+UPDATE reaction_jobs
+SET status  = 'pending',
+    updated = @now
+WHERE status = 'running';
+
+-- name: FailOrphanedReactionRuns :exec
+UPDATE reaction_runs
+SET status   = 'failed',
+    error    = @error,
+    finished = @now
+WHERE status = 'running'
+  AND id NOT IN (SELECT run
+                 FROM reaction_jobs
+                 WHERE run IS NOT NULL
+                   AND status != 'dead');
+
+-- name: DeleteReactionJob :exec
+DELETE
+FROM reaction_jobs
+WHERE id = @id;
+
+-- name: DeleteDeadReactionJobs :exec
+DELETE
+FROM reaction_jobs
+WHERE status = 'dead'
+  AND updated < @before;
+
 ------------------------------------------------------------------
 
 -- name: InsertTask :one
@@ -261,20 +350,25 @@ WHERE id = @id
 ------------------------------------------------------------------
 
 -- name: InsertWebhook :one
-INSERT INTO webhooks (id, name, collection, destination, created, updated)
-VALUES (@id, @name, @collection, @destination, @created, @updated)
+INSERT INTO webhooks (id, name, collection, destination, events, filter, secret, headers, format, created, updated)
+VALUES (@id, @name, @collection, @destination, @events, @filter, @secret, @headers, @format, @created, @updated)
 RETURNING *;
 
 -- name: CreateWebhook :one
-INSERT INTO webhooks (name, collection, destination)
-VALUES (@name, @collection, @destination)
+INSERT INTO webhooks (name, collection, destination, events, filter, secret, headers, format)
+VALUES (@name, @collection, @destination, @events, @filter, @secret, @headers, @format)
 RETURNING *;
 
 -- name: UpdateWebhook :one
 UPDATE webhooks
 SET name        = coalesce(sqlc.narg('name'), name),
     collection  = coalesce(sqlc.narg('collection'), collection),
-    destination = coalesce(sqlc.narg('destination'), destination)
+    destination = coalesce(sqlc.narg('destination'), destination),
+    events      = coalesce(sqlc.narg('events'), events),
+    filter      = coalesce(sqlc.narg('filter'), filter),
+    secret      = coalesce(sqlc.narg('secret'), secret),
+    headers     = coalesce(sqlc.narg('headers'), headers),
+    format      = coalesce(sqlc.narg('format'), format)
 WHERE id = @id
 RETURNING *;
 
@@ -285,6 +379,56 @@ WHERE id = @id;
 
 ------------------------------------------------------------------
 
+-- name: CreateWebhookDelivery :one
+INSERT INTO webhook_deliveries (webhook, event, collection, payload, status, next_attempt, created, updated)
+VALUES (@webhook, @event, @collection, @payload, 'pending', @now, @now, @now)
+RETURNING *;
+
+-- name: RedeliverWebhookDelivery :one
+INSERT INTO webhook_deliveries (webhook, event, collection, payload, status, next_attempt, created, updated)
+SELECT d.webhook, d.event, d.collection, d.payload, 'pending', @now, @now, @now
+FROM webhook_deliveries AS d
+WHERE d.id = @id
+  AND d.webhook = @webhook
+RETURNING *;
+
+-- name: ClaimWebhookDelivery :one
+UPDATE webhook_deliveries
+SET status   = 'running',
+    attempts = attempts + 1,
+    updated  = @now
+WHERE id = (SELECT id
+            FROM webhook_deliveries
+            WHERE status = 'pending'
+              AND next_attempt <= @now
+            ORDER BY next_attempt
+            LIMIT 1)
+RETURNING *;
+
+-- name: FinishWebhookDelivery :exec
+UPDATE webhook_deliveries
+SET status          = @status,
+    response_status = @response_status,
+    response_body   = @response_body,
+    last_error      = @last_error,
+    next_attempt    = @next_attempt,
+    updated         = @now
+WHERE id = @id;
+
+-- name: RequeueRunningWebhookDeliveries :exec
+UPDATE webhook_deliveries
+SET status  = 'pending',
+    updated = @now
+WHERE status = 'running';
+
+-- name: DeleteFinishedWebhookDeliveries :exec
+DELETE
+FROM webhook_deliveries
+WHERE status IN ('delivered', 'failed')
+  AND updated < @before;
+
+------------------------------------------------------------------
+
 -- name: InsertGroup :one
 INSERT INTO groups (id, name, permissions, created, updated)
 VALUES (@id, @name, @permissions, @created, @updated)
@@ -326,3 +470,114 @@ DELETE
 FROM group_inheritance
 WHERE parent_group_id = @parent_group_id
   AND child_group_id = @child_group_id;
+
+------------------------------------------------------------------
+
+-- name: CreateActionToken :one
+INSERT INTO action_tokens (expires, created)
+VALUES (@expires, @now)
+RETURNING *;
+
+-- name: DeleteActionToken :exec
+DELETE
+FROM action_tokens
+WHERE id = @id;
+
+-- name: DeleteExpiredActionTokens :exec
+DELETE
+FROM action_tokens
+WHERE expires < @now;
+
+------------------------------------------------------------------
+
+-- name: CreateSecret :one
+INSERT INTO secrets (name, value, created, updated)
+VALUES (@name, @value, @now, @now)
+RETURNING *;
+
+-- name: UpdateSecret :one
+UPDATE secrets
+SET name    = coalesce(sqlc.narg('name'), name),
+    value   = coalesce(sqlc.narg('value'), value),
+    updated = @now
+WHERE id = @id
+RETURNING *;
+
+-- name: DeleteSecret :exec
+DELETE
+FROM secrets
+WHERE id = @id;
+
+------------------------------------------------------------------
+
+-- name: CreateEvent :one
+INSERT INTO events (action, collection, record, ticket, payload, created)
+VALUES (@action, @collection, @record, @ticket, @payload, @now)
+RETURNING *;
+
+-- name: DeleteEventsBefore :exec
+DELETE
+FROM events
+WHERE created < @before;
+
+------------------------------------------------------------------
+
+-- name: CreateChange :one
+INSERT INTO changes (collection, record, action, actor, snapshot, created)
+VALUES (@collection, @record, @action, @actor, @snapshot, @now)
+RETURNING *;
+
+------------------------------------------------------------------
+
+-- name: CreateAPIToken :one
+INSERT INTO api_tokens (user, name, token_hash, prefix, scopes, expires, created)
+VALUES (@user, @name, @token_hash, @prefix, @scopes, @expires, @now)
+RETURNING *;
+
+-- name: UpdateAPITokenUsage :exec
+UPDATE api_tokens
+SET last_used    = @now,
+    last_used_ip = @ip
+WHERE id = @id;
+
+-- name: DeleteAPIToken :one
+DELETE
+FROM api_tokens
+WHERE id = @id
+  AND user = @user
+RETURNING id;
+
+------------------------------------------------------------------
+
+-- name: CreateSession :one
+INSERT INTO sessions (user, user_agent, ip, expires, last_seen, created)
+VALUES (@user, @user_agent, @ip, @expires, @now, @now)
+RETURNING *;
+
+-- name: UpdateSessionLastSeen :exec
+UPDATE sessions
+SET last_seen = @now
+WHERE id = @id;
+
+-- name: DeleteSession :one
+DELETE
+FROM sessions
+WHERE id = @id
+  AND user = @user
+RETURNING id;
+
+-- name: DeleteUserSessions :exec
+DELETE
+FROM sessions
+WHERE user = @user;
+
+-- name: DeleteExpiredSessions :exec
+DELETE
+FROM sessions
+WHERE expires < @now;
+
+------------------------------------------------------------------
+
+-- name: CreateOIDCIdentity :exec
+INSERT INTO oidc_identities (issuer, subject, user, created)
+VALUES (@issuer, @subject, @user, @now);
@@ -1,9 +1,13 @@
 package hook
 
-import "context"
+import (
+	"context"
+	"net/http"
+)
 
 type Hook struct {
-	subscribers []func(ctx context.Context, table string, record any)
+	subscribers      []func(ctx context.Context, table string, record any)
+	errorSubscribers []func(ctx context.Context, table string, record any) error
 }
 
 func (h *Hook) Publish(ctx context.Context, table string, record any) {
@@ -15,3 +19,53 @@ func (h *Hook) Publish(ctx context.Context, table string, record any) {
 func (h *Hook) Subscribe(fn func(ctx context.Context, table string, record any)) {
 	h.subscribers = append(h.subscribers, fn)
 }
+
+// PublishWithError calls the subscribers that can return an error and then
+// all other subscribers. The first error is returned and aborts the
+// publishing, e.g. to reject a record in a before hook.
+func (h *Hook) PublishWithError(ctx context.Context, table string, record any) error {
+	for _, subscriber := range h.errorSubscribers {
+		if err := subscriber(ctx, table, record); err != nil {
+			return err
+		}
+	}
+
+	h.Publish(ctx, table, record)
+
+	return nil
+}
+
+// SubscribeWithError adds a subscriber that can abort PublishWithError by
+// returning an error. Publish ignores these subscribers.
+func (h *Hook) SubscribeWithError(fn func(ctx context.Context, table string, record any) error) {
+	h.errorSubscribers = append(h.errorSubscribers, fn)
+}
+
+// RejectedError is returned by a subscriber to reject a request with a
+// client error status.
+type RejectedError struct {
+	Status  int
+	Message string
+}
+
+// Reject returns a RejectedError. If the status is not a 4xx status,
+// 400 Bad Request is used.
+func Reject(status int, message string) *RejectedError {
+	if status < http.StatusBadRequest || status >= http.StatusInternalServerError {
+		status = http.StatusBadRequest
+	}
+
+	return &RejectedError{Status: status, Message: message}
+}
+
+func (e *RejectedError) Error() string {
+	return e.Message
+}
+
+// Update is published by the update hooks, so subscribers can see what
+// changed. Old is the record before the update. New is the request body in
+// the before hooks and the updated record in the after hooks.
+type Update struct {
+	Old any
+	New any
+}
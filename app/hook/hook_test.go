@@ -2,6 +2,9 @@ package hook
 
 import (
 	"context"
+	"errors"
+	"net/http"
+	"slices"
 	"testing"
 )
 
@@ -124,3 +127,64 @@ func TestHook_Subscribe(t *testing.T) {
 		})
 	}
 }
+
+func TestHook_PublishWithError(t *testing.T) {
+	t.Parallel()
+
+	var called []string
+
+	h := &Hook{}
+	h.Subscribe(func(_ context.Context, _ string, _ any) {
+		called = append(called, "subscriber")
+	})
+	h.SubscribeWithError(func(_ context.Context, _ string, record any) error {
+		called = append(called, "validator")
+
+		if record == "invalid" {
+			return Reject(http.StatusUnprocessableEntity, "invalid record")
+		}
+
+		return nil
+	})
+
+	if err := h.PublishWithError(t.Context(), "test_table", "valid"); err != nil {
+		t.Fatalf("Hook.PublishWithError() error = %v", err)
+	}
+
+	if !slices.Equal(called, []string{"validator", "subscriber"}) {
+		t.Errorf("Hook.PublishWithError() called = %v", called)
+	}
+
+	called = nil
+
+	err := h.PublishWithError(t.Context(), "test_table", "invalid")
+
+	var rejected *RejectedError
+	if !errors.As(err, &rejected) || rejected.Status != http.StatusUnprocessableEntity {
+		t.Errorf("Hook.PublishWithError() error = %v, want RejectedError with status 422", err)
+	}
+
+	if !slices.Equal(called, []string{"validator"}) {
+		t.Errorf("Hook.PublishWithError() called = %v, subscribers must not be called after an error", called)
+	}
+
+	called = nil
+
+	h.Publish(t.Context(), "test_table", "invalid")
+
+	if !slices.Equal(called, []string{"subscriber"}) {
+		t.Errorf("Hook.Publish() called = %v", called)
+	}
+}
+
+func TestReject(t *testing.T) {
+	t.Parallel()
+
+	if got := Reject(http.StatusForbidden, "forbidden").Status; got != http.StatusForbidden {
+		t.Errorf("Reject() status = %d, want 403", got)
+	}
+
+	if got := Reject(http.StatusInternalServerError, "error").Status; got != http.StatusBadRequest {
+		t.Errorf("Reject() status = %d, want 400", got)
+	}
+}
@@ -23,6 +23,11 @@ func New(queries *sqlc.Queries) *Mailer {
 }
 
 func (m *Mailer) Send(ctx context.Context, to, subject, plainTextBody, htmlBody string) error {
+	return m.SendMany(ctx, []string{to}, subject, plainTextBody, htmlBody)
+}
+
+// SendMany sends a single mail to multiple recipients.
+func (m *Mailer) SendMany(ctx context.Context, to []string, subject, plainTextBody, htmlBody string) error {
 	settings, err := settings.Load(ctx, m.queries)
 	if err != nil {
 		return fmt.Errorf("failed to load settings: %w", err)
@@ -55,14 +60,14 @@ func (m *Mailer) Send(ctx context.Context, to, subject, plainTextBody, htmlBody
 	return nil
 }
 
-func createMessage(settings *settings.Settings, to string, subject string, plainTextBody, htmlBody string) (*mail.Msg, error) {
+func createMessage(settings *settings.Settings, to []string, subject string, plainTextBody, htmlBody string) (*mail.Msg, error) {
 	message := mail.NewMsg()
 
 	if err := message.FromFormat(settings.Meta.SenderName, settings.Meta.SenderAddress); err != nil {
 		return nil, fmt.Errorf("failed to set FROM address: %w", err)
 	}
 
-	if err := message.To(to); err != nil {
+	if err := message.To(to...); err != nil {
 		return nil, fmt.Errorf("failed to set TO address: %w", err)
 	}
 
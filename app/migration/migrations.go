@@ -8,6 +8,19 @@ var migrationGenerators = []func() (migration, error){
 	newFilesMigration(),
 	newSQLMigration("002_create_defaultdata"),
 	newSQLMigration("003_create_groups"),
+	newSQLMigration("004_create_reaction_runs"),
+	newSQLMigration("005_create_reaction_jobs"),
+	newSQLMigration("006_create_action_tokens"),
+	newSQLMigration("007_create_secrets"),
+	newSQLMigration("008_add_webhook_filters"),
+	newSQLMigration("009_create_webhook_deliveries"),
+	newSQLMigration("010_add_webhook_secrets"),
+	newSQLMigration("011_add_webhook_format"),
+	newSQLMigration("012_create_events"),
+	newSQLMigration("013_create_changes"),
+	newSQLMigration("014_create_api_tokens"),
+	newSQLMigration("015_create_sessions"),
+	newSQLMigration("016_create_oidc_identities"),
 }
 
 func migrations(version int) ([]migration, error) {
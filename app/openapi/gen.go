@@ -20,6 +20,35 @@ const (
 	OAuth2Scopes = "OAuth2.Scopes"
 )
 
+// ApiToken defines model for ApiToken.
+type ApiToken struct {
+	Created    time.Time  `json:"created"`
+	Expires    *time.Time `json:"expires,omitempty"`
+	Id         string     `json:"id"`
+	LastUsed   *time.Time `json:"last_used,omitempty"`
+	LastUsedIp *string    `json:"last_used_ip,omitempty"`
+	Name       string     `json:"name"`
+
+	// Prefix The first characters of the token
+	Prefix string   `json:"prefix"`
+	Scopes []string `json:"scopes"`
+
+	// Token Only returned when the token is created
+	Token *string `json:"token,omitempty"`
+	User  string  `json:"user"`
+}
+
+// Change defines model for Change.
+type Change struct {
+	Action     string                 `json:"action"`
+	Actor      *string                `json:"actor,omitempty"`
+	Collection string                 `json:"collection"`
+	Created    time.Time              `json:"created"`
+	Record     string                 `json:"record"`
+	Seq        int64                  `json:"seq"`
+	Snapshot   map[string]interface{} `json:"snapshot"`
+}
+
 // Comment defines model for Comment.
 type Comment struct {
 	Author  string    `json:"author"`
@@ -179,6 +208,16 @@ type LinkUpdate struct {
 	Url  *string `json:"url,omitempty"`
 }
 
+// NewApiToken defines model for NewApiToken.
+type NewApiToken struct {
+	// Expires The token does not expire if not set
+	Expires *time.Time `json:"expires,omitempty"`
+	Name    string     `json:"name"`
+
+	// Scopes A subset of the permissions of the user
+	Scopes []string `json:"scopes"`
+}
+
 // NewComment defines model for NewComment.
 type NewComment struct {
 	Author  string `json:"author"`
@@ -211,6 +250,11 @@ type NewLink struct {
 	Url    string `json:"url"`
 }
 
+// NewPythonEnvironment defines model for NewPythonEnvironment.
+type NewPythonEnvironment struct {
+	Requirements string `json:"requirements"`
+}
+
 // NewReaction defines model for NewReaction.
 type NewReaction struct {
 	Action      string                 `json:"action"`
@@ -220,6 +264,15 @@ type NewReaction struct {
 	Triggerdata map[string]interface{} `json:"triggerdata"`
 }
 
+// NewSecret defines model for NewSecret.
+type NewSecret struct {
+	// Name Name of the environment variable in reactions
+	Name string `json:"name"`
+
+	// Value The value is encrypted and never returned
+	Value string `json:"value"`
+}
+
 // NewTask defines model for NewTask.
 type NewTask struct {
 	Name   string  `json:"name"`
@@ -268,7 +321,31 @@ type NewUser struct {
 type NewWebhook struct {
 	Collection  string `json:"collection"`
 	Destination string `json:"destination"`
-	Name        string `json:"name"`
+
+	// Events create, update or delete, empty for all events
+	Events *[]string `json:"events,omitempty"`
+
+	// Filter CEL expression, e.g. record.type == "alert"
+	Filter *string `json:"filter,omitempty"`
+
+	// Format Payload format: catalyst (default), cloudevents (structured mode) or cloudevents-binary
+	Format *string `json:"format,omitempty"`
+
+	// Headers Static headers sent with every request, e.g. an API key
+	Headers *map[string]string `json:"headers,omitempty"`
+	Name    string             `json:"name"`
+}
+
+// PythonEnvironment defines model for PythonEnvironment.
+type PythonEnvironment struct {
+	Created       time.Time `json:"created"`
+	Id            string    `json:"id"`
+	LastUsed      time.Time `json:"last_used"`
+	PythonVersion string    `json:"python_version"`
+	Requirements  string    `json:"requirements"`
+
+	// Size Size of the environment in bytes
+	Size int `json:"size"`
 }
 
 // Reaction defines model for Reaction.
@@ -283,6 +360,32 @@ type Reaction struct {
 	Updated     time.Time              `json:"updated"`
 }
 
+// ReactionRun defines model for ReactionRun.
+type ReactionRun struct {
+	// Duration Duration of the run in milliseconds
+	Duration *int                   `json:"duration,omitempty"`
+	Error    *string                `json:"error,omitempty"`
+	ExitCode *int                   `json:"exit_code,omitempty"`
+	Finished *time.Time             `json:"finished,omitempty"`
+	Id       string                 `json:"id"`
+	Input    map[string]interface{} `json:"input"`
+	Output   string                 `json:"output"`
+	Reaction string                 `json:"reaction"`
+	Started  time.Time              `json:"started"`
+	Status   string                 `json:"status"`
+	Trigger  string                 `json:"trigger"`
+}
+
+// ReactionRunResult defines model for ReactionRunResult.
+type ReactionRunResult struct {
+	DryRun bool    `json:"dry_run"`
+	Error  *string `json:"error,omitempty"`
+	Output string  `json:"output"`
+
+	// Run ID of the recorded run, empty for dry runs
+	Run *string `json:"run,omitempty"`
+}
+
 // ReactionUpdate defines model for ReactionUpdate.
 type ReactionUpdate struct {
 	Action      *string                 `json:"action,omitempty"`
@@ -292,10 +395,41 @@ type ReactionUpdate struct {
 	Triggerdata *map[string]interface{} `json:"triggerdata,omitempty"`
 }
 
+// Secret defines model for Secret.
+type Secret struct {
+	Created time.Time `json:"created"`
+	Id      string    `json:"id"`
+	Name    string    `json:"name"`
+	Updated time.Time `json:"updated"`
+}
+
+// SecretUpdate defines model for SecretUpdate.
+type SecretUpdate struct {
+	Name *string `json:"name,omitempty"`
+
+	// Value The value is encrypted and never returned
+	Value *string `json:"value,omitempty"`
+}
+
+// Session defines model for Session.
+type Session struct {
+	Created time.Time `json:"created"`
+
+	// Current Whether the session belongs to the token of the request
+	Current   bool      `json:"current"`
+	Expires   time.Time `json:"expires"`
+	Id        string    `json:"id"`
+	Ip        string    `json:"ip"`
+	LastSeen  time.Time `json:"last_seen"`
+	User      string    `json:"user"`
+	UserAgent string    `json:"user_agent"`
+}
+
 // Settings defines model for Settings.
 type Settings struct {
-	Meta SettingsMeta `json:"meta"`
-	Smtp SettingsSmtp `json:"smtp"`
+	Meta SettingsMeta  `json:"meta"`
+	Oidc *SettingsOidc `json:"oidc,omitempty"`
+	Smtp SettingsSmtp  `json:"smtp"`
 }
 
 // SettingsMeta defines model for SettingsMeta.
@@ -307,6 +441,24 @@ type SettingsMeta struct {
 	SenderName            string        `json:"sender_name"`
 }
 
+// SettingsOidc defines model for SettingsOidc.
+type SettingsOidc struct {
+	ClientId     string `json:"client_id"`
+	ClientSecret string `json:"client_secret"`
+	EmailClaim   string `json:"email_claim"`
+	Enabled      bool   `json:"enabled"`
+
+	// GroupMapping Maps the IdP groups of the groups claim to Catalyst group IDs
+	GroupMapping map[string]string `json:"group_mapping"`
+	GroupsClaim  string            `json:"groups_claim"`
+	Issuer       string            `json:"issuer"`
+
+	// LinkExistingUsers Allows SSO logins to sign in to existing users with the same verified email
+	LinkExistingUsers *bool    `json:"link_existing_users,omitempty"`
+	NameClaim         string   `json:"name_claim"`
+	Scopes            []string `json:"scopes"`
+}
+
 // SettingsSmtp defines model for SettingsSmtp.
 type SettingsSmtp struct {
 	AuthMethod string `json:"auth_method"`
@@ -466,16 +618,56 @@ type Webhook struct {
 	Collection  string    `json:"collection"`
 	Created     time.Time `json:"created"`
 	Destination string    `json:"destination"`
-	Id          string    `json:"id"`
-	Name        string    `json:"name"`
-	Updated     time.Time `json:"updated"`
+	Events      []string  `json:"events"`
+	Filter      string    `json:"filter"`
+	Format      string    `json:"format"`
+
+	// Headers Static headers, the values are redacted
+	Headers map[string]string `json:"headers"`
+	Id      string            `json:"id"`
+	Name    string            `json:"name"`
+
+	// Secret Key to verify the X-Catalyst-Signature header, only returned on creation and rotation
+	Secret  *string   `json:"secret,omitempty"`
+	Updated time.Time `json:"updated"`
+}
+
+// WebhookDelivery defines model for WebhookDelivery.
+type WebhookDelivery struct {
+	Attempts    int                    `json:"attempts"`
+	Collection  string                 `json:"collection"`
+	Created     time.Time              `json:"created"`
+	Error       *string                `json:"error,omitempty"`
+	Event       string                 `json:"event"`
+	Id          string                 `json:"id"`
+	NextAttempt time.Time              `json:"next_attempt"`
+	Payload     map[string]interface{} `json:"payload"`
+
+	// ResponseBody The first KiB of the response body
+	ResponseBody   *string `json:"response_body,omitempty"`
+	ResponseStatus *int    `json:"response_status,omitempty"`
+
+	// Status pending, running, delivered or failed
+	Status  string    `json:"status"`
+	Updated time.Time `json:"updated"`
+	Webhook string    `json:"webhook"`
 }
 
 // WebhookUpdate defines model for WebhookUpdate.
 type WebhookUpdate struct {
-	Collection  *string `json:"collection,omitempty"`
-	Destination *string `json:"destination,omitempty"`
-	Name        *string `json:"name,omitempty"`
+	Collection  *string            `json:"collection,omitempty"`
+	Destination *string            `json:"destination,omitempty"`
+	Events      *[]string          `json:"events,omitempty"`
+	Filter      *string            `json:"filter,omitempty"`
+	Format      *string            `json:"format,omitempty"`
+	Headers     *map[string]string `json:"headers,omitempty"`
+	Name        *string            `json:"name,omitempty"`
+}
+
+// ListChangesParams defines parameters for ListChanges.
+type ListChangesParams struct {
+	After *int64 `form:"after,omitempty" json:"after,omitempty"`
+	Limit *int   `form:"limit,omitempty" json:"limit,omitempty"`
 }
 
 // ListCommentsParams defines parameters for ListComments.
@@ -511,6 +703,33 @@ type ListReactionsParams struct {
 	Limit  *int `form:"limit,omitempty" json:"limit,omitempty"`
 }
 
+// RunReactionJSONBody defines parameters for RunReaction.
+type RunReactionJSONBody = map[string]interface{}
+
+// RunReactionParams defines parameters for RunReaction.
+type RunReactionParams struct {
+	// DryRun Only validate the action and trigger data without running the action
+	DryRun *bool `form:"dry_run,omitempty" json:"dry_run,omitempty"`
+}
+
+// ListReactionRunsParams defines parameters for ListReactionRuns.
+type ListReactionRunsParams struct {
+	Offset *int `form:"offset,omitempty" json:"offset,omitempty"`
+	Limit  *int `form:"limit,omitempty" json:"limit,omitempty"`
+}
+
+// ListSecretsParams defines parameters for ListSecrets.
+type ListSecretsParams struct {
+	Offset *int `form:"offset,omitempty" json:"offset,omitempty"`
+	Limit  *int `form:"limit,omitempty" json:"limit,omitempty"`
+}
+
+// ListSessionsParams defines parameters for ListSessions.
+type ListSessionsParams struct {
+	Offset *int `form:"offset,omitempty" json:"offset,omitempty"`
+	Limit  *int `form:"limit,omitempty" json:"limit,omitempty"`
+}
+
 // ListTasksParams defines parameters for ListTasks.
 type ListTasksParams struct {
 	Ticket *string `form:"ticket,omitempty" json:"ticket,omitempty"`
@@ -540,6 +759,12 @@ type ListTimelineParams struct {
 	Limit  *int    `form:"limit,omitempty" json:"limit,omitempty"`
 }
 
+// ListApiTokensParams defines parameters for ListApiTokens.
+type ListApiTokensParams struct {
+	Offset *int `form:"offset,omitempty" json:"offset,omitempty"`
+	Limit  *int `form:"limit,omitempty" json:"limit,omitempty"`
+}
+
 // ListTypesParams defines parameters for ListTypes.
 type ListTypesParams struct {
 	Offset *int `form:"offset,omitempty" json:"offset,omitempty"`
@@ -552,12 +777,30 @@ type ListUsersParams struct {
 	Limit  *int `form:"limit,omitempty" json:"limit,omitempty"`
 }
 
+// ListUserSessionsParams defines parameters for ListUserSessions.
+type ListUserSessionsParams struct {
+	Offset *int `form:"offset,omitempty" json:"offset,omitempty"`
+	Limit  *int `form:"limit,omitempty" json:"limit,omitempty"`
+}
+
+// ListUserApiTokensParams defines parameters for ListUserApiTokens.
+type ListUserApiTokensParams struct {
+	Offset *int `form:"offset,omitempty" json:"offset,omitempty"`
+	Limit  *int `form:"limit,omitempty" json:"limit,omitempty"`
+}
+
 // ListWebhooksParams defines parameters for ListWebhooks.
 type ListWebhooksParams struct {
 	Offset *int `form:"offset,omitempty" json:"offset,omitempty"`
 	Limit  *int `form:"limit,omitempty" json:"limit,omitempty"`
 }
 
+// ListWebhookDeliveriesParams defines parameters for ListWebhookDeliveries.
+type ListWebhookDeliveriesParams struct {
+	Offset *int `form:"offset,omitempty" json:"offset,omitempty"`
+	Limit  *int `form:"limit,omitempty" json:"limit,omitempty"`
+}
+
 // CreateCommentJSONRequestBody defines body for CreateComment for application/json ContentType.
 type CreateCommentJSONRequestBody = NewComment
 
@@ -582,12 +825,24 @@ type CreateLinkJSONRequestBody = NewLink
 // UpdateLinkJSONRequestBody defines body for UpdateLink for application/json ContentType.
 type UpdateLinkJSONRequestBody = LinkUpdate
 
+// PrewarmPythonEnvironmentJSONRequestBody defines body for PrewarmPythonEnvironment for application/json ContentType.
+type PrewarmPythonEnvironmentJSONRequestBody = NewPythonEnvironment
+
 // CreateReactionJSONRequestBody defines body for CreateReaction for application/json ContentType.
 type CreateReactionJSONRequestBody = NewReaction
 
 // UpdateReactionJSONRequestBody defines body for UpdateReaction for application/json ContentType.
 type UpdateReactionJSONRequestBody = ReactionUpdate
 
+// RunReactionJSONRequestBody defines body for RunReaction for application/json ContentType.
+type RunReactionJSONRequestBody = RunReactionJSONBody
+
+// CreateSecretJSONRequestBody defines body for CreateSecret for application/json ContentType.
+type CreateSecretJSONRequestBody = NewSecret
+
+// UpdateSecretJSONRequestBody defines body for UpdateSecret for application/json ContentType.
+type UpdateSecretJSONRequestBody = SecretUpdate
+
 // UpdateSettingsJSONRequestBody defines body for UpdateSettings for application/json ContentType.
 type UpdateSettingsJSONRequestBody = Settings
 
@@ -609,6 +864,9 @@ type CreateTimelineJSONRequestBody = NewTimelineEntry
 // UpdateTimelineJSONRequestBody defines body for UpdateTimeline for application/json ContentType.
 type UpdateTimelineJSONRequestBody = TimelineEntryUpdate
 
+// CreateApiTokenJSONRequestBody defines body for CreateApiToken for application/json ContentType.
+type CreateApiTokenJSONRequestBody = NewApiToken
+
 // CreateTypeJSONRequestBody defines body for CreateType for application/json ContentType.
 type CreateTypeJSONRequestBody = NewType
 
@@ -624,6 +882,9 @@ type UpdateUserJSONRequestBody = UserUpdate
 // AddUserGroupJSONRequestBody defines body for AddUserGroup for application/json ContentType.
 type AddUserGroupJSONRequestBody = GroupRelation
 
+// CreateUserApiTokenJSONRequestBody defines body for CreateUserApiToken for application/json ContentType.
+type CreateUserApiTokenJSONRequestBody = NewApiToken
+
 // CreateWebhookJSONRequestBody defines body for CreateWebhook for application/json ContentType.
 type CreateWebhookJSONRequestBody = NewWebhook
 
@@ -632,6 +893,9 @@ type UpdateWebhookJSONRequestBody = WebhookUpdate
 
 // ServerInterface represents all server handlers.
 type ServerInterface interface {
+	// List the changes of all collections after a sequence number
+	// (GET /changes)
+	ListChanges(w http.ResponseWriter, r *http.Request, params ListChangesParams)
 	// List all comments
 	// (GET /comments)
 	ListComments(w http.ResponseWriter, r *http.Request, params ListCommentsParams)
@@ -716,6 +980,18 @@ type ServerInterface interface {
 	// Update a link by ID
 	// (PATCH /links/{id})
 	UpdateLink(w http.ResponseWriter, r *http.Request, id string)
+	// Remove all cached Python environments
+	// (DELETE /python_environments)
+	PurgePythonEnvironments(w http.ResponseWriter, r *http.Request)
+	// List all cached Python environments
+	// (GET /python_environments)
+	ListPythonEnvironments(w http.ResponseWriter, r *http.Request)
+	// Create a Python environment for the given requirements in advance
+	// (POST /python_environments)
+	PrewarmPythonEnvironment(w http.ResponseWriter, r *http.Request)
+	// Remove a cached Python environment by ID
+	// (DELETE /python_environments/{id})
+	DeletePythonEnvironment(w http.ResponseWriter, r *http.Request, id string)
 	// List all reactions
 	// (GET /reactions)
 	ListReactions(w http.ResponseWriter, r *http.Request, params ListReactionsParams)
@@ -731,6 +1007,36 @@ type ServerInterface interface {
 	// Update a reaction by ID
 	// (PATCH /reactions/{id})
 	UpdateReaction(w http.ResponseWriter, r *http.Request, id string)
+	// Run a reaction with the given payload
+	// (POST /reactions/{id}/run)
+	RunReaction(w http.ResponseWriter, r *http.Request, id string, params RunReactionParams)
+	// List all runs of a reaction
+	// (GET /reactions/{id}/runs)
+	ListReactionRuns(w http.ResponseWriter, r *http.Request, id string, params ListReactionRunsParams)
+	// Get a single run of a reaction
+	// (GET /reactions/{id}/runs/{runId})
+	GetReactionRun(w http.ResponseWriter, r *http.Request, id string, runId string)
+	// List all secrets without their values
+	// (GET /secrets)
+	ListSecrets(w http.ResponseWriter, r *http.Request, params ListSecretsParams)
+	// Create a new secret
+	// (POST /secrets)
+	CreateSecret(w http.ResponseWriter, r *http.Request)
+	// Delete a secret by ID
+	// (DELETE /secrets/{id})
+	DeleteSecret(w http.ResponseWriter, r *http.Request, id string)
+	// Get a single secret by ID without its value
+	// (GET /secrets/{id})
+	GetSecret(w http.ResponseWriter, r *http.Request, id string)
+	// Update a secret by ID
+	// (PATCH /secrets/{id})
+	UpdateSecret(w http.ResponseWriter, r *http.Request, id string)
+	// List the login sessions of the current user
+	// (GET /sessions)
+	ListSessions(w http.ResponseWriter, r *http.Request, params ListSessionsParams)
+	// Revoke a login session of the current user
+	// (DELETE /sessions/{id})
+	DeleteSession(w http.ResponseWriter, r *http.Request, id string)
 	// Get system settings
 	// (GET /settings)
 	GetSettings(w http.ResponseWriter, r *http.Request)
@@ -788,6 +1094,15 @@ type ServerInterface interface {
 	// Update a timeline item by ID
 	// (PATCH /timeline/{id})
 	UpdateTimeline(w http.ResponseWriter, r *http.Request, id string)
+	// List the API tokens of the current user
+	// (GET /tokens)
+	ListApiTokens(w http.ResponseWriter, r *http.Request, params ListApiTokensParams)
+	// Create an API token for the current user
+	// (POST /tokens)
+	CreateApiToken(w http.ResponseWriter, r *http.Request)
+	// Revoke an API token of the current user
+	// (DELETE /tokens/{id})
+	DeleteApiToken(w http.ResponseWriter, r *http.Request, id string)
 	// List all types
 	// (GET /types)
 	ListTypes(w http.ResponseWriter, r *http.Request, params ListTypesParams)
@@ -830,6 +1145,24 @@ type ServerInterface interface {
 	// List all permissions for a user
 	// (GET /users/{id}/permissions)
 	ListUserPermissions(w http.ResponseWriter, r *http.Request, id string)
+	// Revoke all login sessions of a user
+	// (DELETE /users/{id}/sessions)
+	DeleteUserSessions(w http.ResponseWriter, r *http.Request, id string)
+	// List the login sessions of a user
+	// (GET /users/{id}/sessions)
+	ListUserSessions(w http.ResponseWriter, r *http.Request, id string, params ListUserSessionsParams)
+	// Revoke a login session of a user
+	// (DELETE /users/{id}/sessions/{sessionId})
+	DeleteUserSession(w http.ResponseWriter, r *http.Request, id string, sessionId string)
+	// List the API tokens of a user
+	// (GET /users/{id}/tokens)
+	ListUserApiTokens(w http.ResponseWriter, r *http.Request, id string, params ListUserApiTokensParams)
+	// Create an API token for a user
+	// (POST /users/{id}/tokens)
+	CreateUserApiToken(w http.ResponseWriter, r *http.Request, id string)
+	// Revoke an API token of a user
+	// (DELETE /users/{id}/tokens/{tokenId})
+	DeleteUserApiToken(w http.ResponseWriter, r *http.Request, id string, tokenId string)
 	// List all webhooks
 	// (GET /webhooks)
 	ListWebhooks(w http.ResponseWriter, r *http.Request, params ListWebhooksParams)
@@ -845,12 +1178,30 @@ type ServerInterface interface {
 	// Update a webhook by ID
 	// (PATCH /webhooks/{id})
 	UpdateWebhook(w http.ResponseWriter, r *http.Request, id string)
+	// List all deliveries of a webhook
+	// (GET /webhooks/{id}/deliveries)
+	ListWebhookDeliveries(w http.ResponseWriter, r *http.Request, id string, params ListWebhookDeliveriesParams)
+	// Get a single delivery of a webhook
+	// (GET /webhooks/{id}/deliveries/{deliveryId})
+	GetWebhookDelivery(w http.ResponseWriter, r *http.Request, id string, deliveryId string)
+	// Send the payload of a delivery again
+	// (POST /webhooks/{id}/deliveries/{deliveryId}/redeliver)
+	RedeliverWebhookDelivery(w http.ResponseWriter, r *http.Request, id string, deliveryId string)
+	// Replace the signing secret of a webhook
+	// (POST /webhooks/{id}/rotate_secret)
+	RotateWebhookSecret(w http.ResponseWriter, r *http.Request, id string)
 }
 
 // Unimplemented server implementation that returns http.StatusNotImplemented for each endpoint.
 
 type Unimplemented struct{}
 
+// List the changes of all collections after a sequence number
+// (GET /changes)
+func (_ Unimplemented) ListChanges(w http.ResponseWriter, r *http.Request, params ListChangesParams) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
 // List all comments
 // (GET /comments)
 func (_ Unimplemented) ListComments(w http.ResponseWriter, r *http.Request, params ListCommentsParams) {
@@ -1019,6 +1370,30 @@ func (_ Unimplemented) UpdateLink(w http.ResponseWriter, r *http.Request, id str
 	w.WriteHeader(http.StatusNotImplemented)
 }
 
+// Remove all cached Python environments
+// (DELETE /python_environments)
+func (_ Unimplemented) PurgePythonEnvironments(w http.ResponseWriter, r *http.Request) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// List all cached Python environments
+// (GET /python_environments)
+func (_ Unimplemented) ListPythonEnvironments(w http.ResponseWriter, r *http.Request) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Create a Python environment for the given requirements in advance
+// (POST /python_environments)
+func (_ Unimplemented) PrewarmPythonEnvironment(w http.ResponseWriter, r *http.Request) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Remove a cached Python environment by ID
+// (DELETE /python_environments/{id})
+func (_ Unimplemented) DeletePythonEnvironment(w http.ResponseWriter, r *http.Request, id string) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
 // List all reactions
 // (GET /reactions)
 func (_ Unimplemented) ListReactions(w http.ResponseWriter, r *http.Request, params ListReactionsParams) {
@@ -1049,6 +1424,66 @@ func (_ Unimplemented) UpdateReaction(w http.ResponseWriter, r *http.Request, id
 	w.WriteHeader(http.StatusNotImplemented)
 }
 
+// Run a reaction with the given payload
+// (POST /reactions/{id}/run)
+func (_ Unimplemented) RunReaction(w http.ResponseWriter, r *http.Request, id string, params RunReactionParams) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// List all runs of a reaction
+// (GET /reactions/{id}/runs)
+func (_ Unimplemented) ListReactionRuns(w http.ResponseWriter, r *http.Request, id string, params ListReactionRunsParams) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Get a single run of a reaction
+// (GET /reactions/{id}/runs/{runId})
+func (_ Unimplemented) GetReactionRun(w http.ResponseWriter, r *http.Request, id string, runId string) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// List all secrets without their values
+// (GET /secrets)
+func (_ Unimplemented) ListSecrets(w http.ResponseWriter, r *http.Request, params ListSecretsParams) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Create a new secret
+// (POST /secrets)
+func (_ Unimplemented) CreateSecret(w http.ResponseWriter, r *http.Request) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Delete a secret by ID
+// (DELETE /secrets/{id})
+func (_ Unimplemented) DeleteSecret(w http.ResponseWriter, r *http.Request, id string) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Get a single secret by ID without its value
+// (GET /secrets/{id})
+func (_ Unimplemented) GetSecret(w http.ResponseWriter, r *http.Request, id string) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Update a secret by ID
+// (PATCH /secrets/{id})
+func (_ Unimplemented) UpdateSecret(w http.ResponseWriter, r *http.Request, id string) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// List the login sessions of the current user
+// (GET /sessions)
+func (_ Unimplemented) ListSessions(w http.ResponseWriter, r *http.Request, params ListSessionsParams) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Revoke a login session of the current user
+// (DELETE /sessions/{id})
+func (_ Unimplemented) DeleteSession(w http.ResponseWriter, r *http.Request, id string) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
 // Get system settings
 // (GET /settings)
 func (_ Unimplemented) GetSettings(w http.ResponseWriter, r *http.Request) {
@@ -1163,6 +1598,24 @@ func (_ Unimplemented) UpdateTimeline(w http.ResponseWriter, r *http.Request, id
 	w.WriteHeader(http.StatusNotImplemented)
 }
 
+// List the API tokens of the current user
+// (GET /tokens)
+func (_ Unimplemented) ListApiTokens(w http.ResponseWriter, r *http.Request, params ListApiTokensParams) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Create an API token for the current user
+// (POST /tokens)
+func (_ Unimplemented) CreateApiToken(w http.ResponseWriter, r *http.Request) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Revoke an API token of the current user
+// (DELETE /tokens/{id})
+func (_ Unimplemented) DeleteApiToken(w http.ResponseWriter, r *http.Request, id string) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
 // List all types
 // (GET /types)
 func (_ Unimplemented) ListTypes(w http.ResponseWriter, r *http.Request, params ListTypesParams) {
@@ -1247,6 +1700,42 @@ func (_ Unimplemented) ListUserPermissions(w http.ResponseWriter, r *http.Reques
 	w.WriteHeader(http.StatusNotImplemented)
 }
 
+// Revoke all login sessions of a user
+// (DELETE /users/{id}/sessions)
+func (_ Unimplemented) DeleteUserSessions(w http.ResponseWriter, r *http.Request, id string) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// List the login sessions of a user
+// (GET /users/{id}/sessions)
+func (_ Unimplemented) ListUserSessions(w http.ResponseWriter, r *http.Request, id string, params ListUserSessionsParams) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Revoke a login session of a user
+// (DELETE /users/{id}/sessions/{sessionId})
+func (_ Unimplemented) DeleteUserSession(w http.ResponseWriter, r *http.Request, id string, sessionId string) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// List the API tokens of a user
+// (GET /users/{id}/tokens)
+func (_ Unimplemented) ListUserApiTokens(w http.ResponseWriter, r *http.Request, id string, params ListUserApiTokensParams) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Create an API token for a user
+// (POST /users/{id}/tokens)
+func (_ Unimplemented) CreateUserApiToken(w http.ResponseWriter, r *http.Request, id string) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Revoke an API token of a user
+// (DELETE /users/{id}/tokens/{tokenId})
+func (_ Unimplemented) DeleteUserApiToken(w http.ResponseWriter, r *http.Request, id string, tokenId string) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
 // List all webhooks
 // (GET /webhooks)
 func (_ Unimplemented) ListWebhooks(w http.ResponseWriter, r *http.Request, params ListWebhooksParams) {
@@ -1277,6 +1766,30 @@ func (_ Unimplemented) UpdateWebhook(w http.ResponseWriter, r *http.Request, id
 	w.WriteHeader(http.StatusNotImplemented)
 }
 
+// List all deliveries of a webhook
+// (GET /webhooks/{id}/deliveries)
+func (_ Unimplemented) ListWebhookDeliveries(w http.ResponseWriter, r *http.Request, id string, params ListWebhookDeliveriesParams) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Get a single delivery of a webhook
+// (GET /webhooks/{id}/deliveries/{deliveryId})
+func (_ Unimplemented) GetWebhookDelivery(w http.ResponseWriter, r *http.Request, id string, deliveryId string) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Send the payload of a delivery again
+// (POST /webhooks/{id}/deliveries/{deliveryId}/redeliver)
+func (_ Unimplemented) RedeliverWebhookDelivery(w http.ResponseWriter, r *http.Request, id string, deliveryId string) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Replace the signing secret of a webhook
+// (POST /webhooks/{id}/rotate_secret)
+func (_ Unimplemented) RotateWebhookSecret(w http.ResponseWriter, r *http.Request, id string) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
 // ServerInterfaceWrapper converts contexts to parameters.
 type ServerInterfaceWrapper struct {
 	Handler            ServerInterface
@@ -1286,6 +1799,47 @@ type ServerInterfaceWrapper struct {
 
 type MiddlewareFunc func(http.Handler) http.Handler
 
+// ListChanges operation middleware
+func (siw *ServerInterfaceWrapper) ListChanges(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, OAuth2Scopes, []string{"changes:read"})
+
+	r = r.WithContext(ctx)
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params ListChangesParams
+
+	// ------------- Optional query parameter "after" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "after", r.URL.Query(), &params.After)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "after", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "limit" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "limit", r.URL.Query(), &params.Limit)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "limit", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.ListChanges(w, r, params)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
 // ListComments operation middleware
 func (siw *ServerInterfaceWrapper) ListComments(w http.ResponseWriter, r *http.Request) {
 
@@ -2155,38 +2709,37 @@ func (siw *ServerInterfaceWrapper) UpdateLink(w http.ResponseWriter, r *http.Req
 	handler.ServeHTTP(w, r)
 }
 
-// ListReactions operation middleware
-func (siw *ServerInterfaceWrapper) ListReactions(w http.ResponseWriter, r *http.Request) {
-
-	var err error
+// PurgePythonEnvironments operation middleware
+func (siw *ServerInterfaceWrapper) PurgePythonEnvironments(w http.ResponseWriter, r *http.Request) {
 
 	ctx := r.Context()
 
-	ctx = context.WithValue(ctx, OAuth2Scopes, []string{"reaction:read"})
+	ctx = context.WithValue(ctx, OAuth2Scopes, []string{"settings:write"})
 
 	r = r.WithContext(ctx)
 
-	// Parameter object where we will unmarshal all parameters from the context
-	var params ListReactionsParams
-
-	// ------------- Optional query parameter "offset" -------------
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.PurgePythonEnvironments(w, r)
+	}))
 
-	err = runtime.BindQueryParameter("form", true, false, "offset", r.URL.Query(), &params.Offset)
-	if err != nil {
-		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "offset", Err: err})
-		return
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
 	}
 
-	// ------------- Optional query parameter "limit" -------------
+	handler.ServeHTTP(w, r)
+}
 
-	err = runtime.BindQueryParameter("form", true, false, "limit", r.URL.Query(), &params.Limit)
-	if err != nil {
-		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "limit", Err: err})
-		return
-	}
+// ListPythonEnvironments operation middleware
+func (siw *ServerInterfaceWrapper) ListPythonEnvironments(w http.ResponseWriter, r *http.Request) {
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, OAuth2Scopes, []string{"settings:read"})
+
+	r = r.WithContext(ctx)
 
 	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.ListReactions(w, r, params)
+		siw.Handler.ListPythonEnvironments(w, r)
 	}))
 
 	for _, middleware := range siw.HandlerMiddlewares {
@@ -2196,17 +2749,17 @@ func (siw *ServerInterfaceWrapper) ListReactions(w http.ResponseWriter, r *http.
 	handler.ServeHTTP(w, r)
 }
 
-// CreateReaction operation middleware
-func (siw *ServerInterfaceWrapper) CreateReaction(w http.ResponseWriter, r *http.Request) {
+// PrewarmPythonEnvironment operation middleware
+func (siw *ServerInterfaceWrapper) PrewarmPythonEnvironment(w http.ResponseWriter, r *http.Request) {
 
 	ctx := r.Context()
 
-	ctx = context.WithValue(ctx, OAuth2Scopes, []string{"reaction:write"})
+	ctx = context.WithValue(ctx, OAuth2Scopes, []string{"settings:write"})
 
 	r = r.WithContext(ctx)
 
 	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.CreateReaction(w, r)
+		siw.Handler.PrewarmPythonEnvironment(w, r)
 	}))
 
 	for _, middleware := range siw.HandlerMiddlewares {
@@ -2216,8 +2769,8 @@ func (siw *ServerInterfaceWrapper) CreateReaction(w http.ResponseWriter, r *http
 	handler.ServeHTTP(w, r)
 }
 
-// DeleteReaction operation middleware
-func (siw *ServerInterfaceWrapper) DeleteReaction(w http.ResponseWriter, r *http.Request) {
+// DeletePythonEnvironment operation middleware
+func (siw *ServerInterfaceWrapper) DeletePythonEnvironment(w http.ResponseWriter, r *http.Request) {
 
 	var err error
 
@@ -2232,12 +2785,12 @@ func (siw *ServerInterfaceWrapper) DeleteReaction(w http.ResponseWriter, r *http
 
 	ctx := r.Context()
 
-	ctx = context.WithValue(ctx, OAuth2Scopes, []string{"reaction:write"})
+	ctx = context.WithValue(ctx, OAuth2Scopes, []string{"settings:write"})
 
 	r = r.WithContext(ctx)
 
 	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.DeleteReaction(w, r, id)
+		siw.Handler.DeletePythonEnvironment(w, r, id)
 	}))
 
 	for _, middleware := range siw.HandlerMiddlewares {
@@ -2247,59 +2800,38 @@ func (siw *ServerInterfaceWrapper) DeleteReaction(w http.ResponseWriter, r *http
 	handler.ServeHTTP(w, r)
 }
 
-// GetReaction operation middleware
-func (siw *ServerInterfaceWrapper) GetReaction(w http.ResponseWriter, r *http.Request) {
+// ListReactions operation middleware
+func (siw *ServerInterfaceWrapper) ListReactions(w http.ResponseWriter, r *http.Request) {
 
 	var err error
 
-	// ------------- Path parameter "id" -------------
-	var id string
-
-	err = runtime.BindStyledParameterWithOptions("simple", "id", chi.URLParam(r, "id"), &id, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
-	if err != nil {
-		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "id", Err: err})
-		return
-	}
-
 	ctx := r.Context()
 
 	ctx = context.WithValue(ctx, OAuth2Scopes, []string{"reaction:read"})
 
 	r = r.WithContext(ctx)
 
-	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.GetReaction(w, r, id)
-	}))
-
-	for _, middleware := range siw.HandlerMiddlewares {
-		handler = middleware(handler)
-	}
-
-	handler.ServeHTTP(w, r)
-}
-
-// UpdateReaction operation middleware
-func (siw *ServerInterfaceWrapper) UpdateReaction(w http.ResponseWriter, r *http.Request) {
-
-	var err error
+	// Parameter object where we will unmarshal all parameters from the context
+	var params ListReactionsParams
 
-	// ------------- Path parameter "id" -------------
-	var id string
+	// ------------- Optional query parameter "offset" -------------
 
-	err = runtime.BindStyledParameterWithOptions("simple", "id", chi.URLParam(r, "id"), &id, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	err = runtime.BindQueryParameter("form", true, false, "offset", r.URL.Query(), &params.Offset)
 	if err != nil {
-		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "id", Err: err})
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "offset", Err: err})
 		return
 	}
 
-	ctx := r.Context()
-
-	ctx = context.WithValue(ctx, OAuth2Scopes, []string{"reaction:write"})
+	// ------------- Optional query parameter "limit" -------------
 
-	r = r.WithContext(ctx)
+	err = runtime.BindQueryParameter("form", true, false, "limit", r.URL.Query(), &params.Limit)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "limit", Err: err})
+		return
+	}
 
 	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.UpdateReaction(w, r, id)
+		siw.Handler.ListReactions(w, r, params)
 	}))
 
 	for _, middleware := range siw.HandlerMiddlewares {
@@ -2309,17 +2841,17 @@ func (siw *ServerInterfaceWrapper) UpdateReaction(w http.ResponseWriter, r *http
 	handler.ServeHTTP(w, r)
 }
 
-// GetSettings operation middleware
-func (siw *ServerInterfaceWrapper) GetSettings(w http.ResponseWriter, r *http.Request) {
+// CreateReaction operation middleware
+func (siw *ServerInterfaceWrapper) CreateReaction(w http.ResponseWriter, r *http.Request) {
 
 	ctx := r.Context()
 
-	ctx = context.WithValue(ctx, OAuth2Scopes, []string{"settings:read"})
+	ctx = context.WithValue(ctx, OAuth2Scopes, []string{"reaction:write"})
 
 	r = r.WithContext(ctx)
 
 	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.GetSettings(w, r)
+		siw.Handler.CreateReaction(w, r)
 	}))
 
 	for _, middleware := range siw.HandlerMiddlewares {
@@ -2329,37 +2861,28 @@ func (siw *ServerInterfaceWrapper) GetSettings(w http.ResponseWriter, r *http.Re
 	handler.ServeHTTP(w, r)
 }
 
-// UpdateSettings operation middleware
-func (siw *ServerInterfaceWrapper) UpdateSettings(w http.ResponseWriter, r *http.Request) {
-
-	ctx := r.Context()
-
-	ctx = context.WithValue(ctx, OAuth2Scopes, []string{"settings:write"})
+// DeleteReaction operation middleware
+func (siw *ServerInterfaceWrapper) DeleteReaction(w http.ResponseWriter, r *http.Request) {
 
-	r = r.WithContext(ctx)
+	var err error
 
-	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.UpdateSettings(w, r)
-	}))
+	// ------------- Path parameter "id" -------------
+	var id string
 
-	for _, middleware := range siw.HandlerMiddlewares {
-		handler = middleware(handler)
+	err = runtime.BindStyledParameterWithOptions("simple", "id", chi.URLParam(r, "id"), &id, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "id", Err: err})
+		return
 	}
 
-	handler.ServeHTTP(w, r)
-}
-
-// GetSidebar operation middleware
-func (siw *ServerInterfaceWrapper) GetSidebar(w http.ResponseWriter, r *http.Request) {
-
 	ctx := r.Context()
 
-	ctx = context.WithValue(ctx, OAuth2Scopes, []string{"ticket:read"})
+	ctx = context.WithValue(ctx, OAuth2Scopes, []string{"reaction:write"})
 
 	r = r.WithContext(ctx)
 
 	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.GetSidebar(w, r)
+		siw.Handler.DeleteReaction(w, r, id)
 	}))
 
 	for _, middleware := range siw.HandlerMiddlewares {
@@ -2369,66 +2892,28 @@ func (siw *ServerInterfaceWrapper) GetSidebar(w http.ResponseWriter, r *http.Req
 	handler.ServeHTTP(w, r)
 }
 
-// ListTasks operation middleware
-func (siw *ServerInterfaceWrapper) ListTasks(w http.ResponseWriter, r *http.Request) {
+// GetReaction operation middleware
+func (siw *ServerInterfaceWrapper) GetReaction(w http.ResponseWriter, r *http.Request) {
 
 	var err error
 
-	ctx := r.Context()
-
-	ctx = context.WithValue(ctx, OAuth2Scopes, []string{"ticket:read"})
-
-	r = r.WithContext(ctx)
-
-	// Parameter object where we will unmarshal all parameters from the context
-	var params ListTasksParams
-
-	// ------------- Optional query parameter "ticket" -------------
-
-	err = runtime.BindQueryParameter("form", true, false, "ticket", r.URL.Query(), &params.Ticket)
-	if err != nil {
-		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "ticket", Err: err})
-		return
-	}
-
-	// ------------- Optional query parameter "offset" -------------
-
-	err = runtime.BindQueryParameter("form", true, false, "offset", r.URL.Query(), &params.Offset)
-	if err != nil {
-		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "offset", Err: err})
-		return
-	}
-
-	// ------------- Optional query parameter "limit" -------------
+	// ------------- Path parameter "id" -------------
+	var id string
 
-	err = runtime.BindQueryParameter("form", true, false, "limit", r.URL.Query(), &params.Limit)
+	err = runtime.BindStyledParameterWithOptions("simple", "id", chi.URLParam(r, "id"), &id, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
 	if err != nil {
-		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "limit", Err: err})
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "id", Err: err})
 		return
 	}
 
-	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.ListTasks(w, r, params)
-	}))
-
-	for _, middleware := range siw.HandlerMiddlewares {
-		handler = middleware(handler)
-	}
-
-	handler.ServeHTTP(w, r)
-}
-
-// CreateTask operation middleware
-func (siw *ServerInterfaceWrapper) CreateTask(w http.ResponseWriter, r *http.Request) {
-
 	ctx := r.Context()
 
-	ctx = context.WithValue(ctx, OAuth2Scopes, []string{"ticket:write"})
+	ctx = context.WithValue(ctx, OAuth2Scopes, []string{"reaction:read"})
 
 	r = r.WithContext(ctx)
 
 	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.CreateTask(w, r)
+		siw.Handler.GetReaction(w, r, id)
 	}))
 
 	for _, middleware := range siw.HandlerMiddlewares {
@@ -2438,8 +2923,8 @@ func (siw *ServerInterfaceWrapper) CreateTask(w http.ResponseWriter, r *http.Req
 	handler.ServeHTTP(w, r)
 }
 
-// DeleteTask operation middleware
-func (siw *ServerInterfaceWrapper) DeleteTask(w http.ResponseWriter, r *http.Request) {
+// UpdateReaction operation middleware
+func (siw *ServerInterfaceWrapper) UpdateReaction(w http.ResponseWriter, r *http.Request) {
 
 	var err error
 
@@ -2454,12 +2939,12 @@ func (siw *ServerInterfaceWrapper) DeleteTask(w http.ResponseWriter, r *http.Req
 
 	ctx := r.Context()
 
-	ctx = context.WithValue(ctx, OAuth2Scopes, []string{"ticket:write"})
+	ctx = context.WithValue(ctx, OAuth2Scopes, []string{"reaction:write"})
 
 	r = r.WithContext(ctx)
 
 	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.DeleteTask(w, r, id)
+		siw.Handler.UpdateReaction(w, r, id)
 	}))
 
 	for _, middleware := range siw.HandlerMiddlewares {
@@ -2469,8 +2954,8 @@ func (siw *ServerInterfaceWrapper) DeleteTask(w http.ResponseWriter, r *http.Req
 	handler.ServeHTTP(w, r)
 }
 
-// GetTask operation middleware
-func (siw *ServerInterfaceWrapper) GetTask(w http.ResponseWriter, r *http.Request) {
+// RunReaction operation middleware
+func (siw *ServerInterfaceWrapper) RunReaction(w http.ResponseWriter, r *http.Request) {
 
 	var err error
 
@@ -2485,12 +2970,23 @@ func (siw *ServerInterfaceWrapper) GetTask(w http.ResponseWriter, r *http.Reques
 
 	ctx := r.Context()
 
-	ctx = context.WithValue(ctx, OAuth2Scopes, []string{"ticket:read"})
+	ctx = context.WithValue(ctx, OAuth2Scopes, []string{"reaction:write"})
 
 	r = r.WithContext(ctx)
 
+	// Parameter object where we will unmarshal all parameters from the context
+	var params RunReactionParams
+
+	// ------------- Optional query parameter "dry_run" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "dry_run", r.URL.Query(), &params.DryRun)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "dry_run", Err: err})
+		return
+	}
+
 	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.GetTask(w, r, id)
+		siw.Handler.RunReaction(w, r, id, params)
 	}))
 
 	for _, middleware := range siw.HandlerMiddlewares {
@@ -2500,8 +2996,8 @@ func (siw *ServerInterfaceWrapper) GetTask(w http.ResponseWriter, r *http.Reques
 	handler.ServeHTTP(w, r)
 }
 
-// UpdateTask operation middleware
-func (siw *ServerInterfaceWrapper) UpdateTask(w http.ResponseWriter, r *http.Request) {
+// ListReactionRuns operation middleware
+func (siw *ServerInterfaceWrapper) ListReactionRuns(w http.ResponseWriter, r *http.Request) {
 
 	var err error
 
@@ -2516,12 +3012,31 @@ func (siw *ServerInterfaceWrapper) UpdateTask(w http.ResponseWriter, r *http.Req
 
 	ctx := r.Context()
 
-	ctx = context.WithValue(ctx, OAuth2Scopes, []string{"ticket:write"})
+	ctx = context.WithValue(ctx, OAuth2Scopes, []string{"reaction:read"})
 
 	r = r.WithContext(ctx)
 
+	// Parameter object where we will unmarshal all parameters from the context
+	var params ListReactionRunsParams
+
+	// ------------- Optional query parameter "offset" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "offset", r.URL.Query(), &params.Offset)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "offset", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "limit" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "limit", r.URL.Query(), &params.Limit)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "limit", Err: err})
+		return
+	}
+
 	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.UpdateTask(w, r, id)
+		siw.Handler.ListReactionRuns(w, r, id, params)
 	}))
 
 	for _, middleware := range siw.HandlerMiddlewares {
@@ -2531,62 +3046,37 @@ func (siw *ServerInterfaceWrapper) UpdateTask(w http.ResponseWriter, r *http.Req
 	handler.ServeHTTP(w, r)
 }
 
-// SearchTickets operation middleware
-func (siw *ServerInterfaceWrapper) SearchTickets(w http.ResponseWriter, r *http.Request) {
+// GetReactionRun operation middleware
+func (siw *ServerInterfaceWrapper) GetReactionRun(w http.ResponseWriter, r *http.Request) {
 
 	var err error
 
-	ctx := r.Context()
-
-	ctx = context.WithValue(ctx, OAuth2Scopes, []string{"ticket:read"})
-
-	r = r.WithContext(ctx)
-
-	// Parameter object where we will unmarshal all parameters from the context
-	var params SearchTicketsParams
-
-	// ------------- Optional query parameter "query" -------------
-
-	err = runtime.BindQueryParameter("form", true, false, "query", r.URL.Query(), &params.Query)
-	if err != nil {
-		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "query", Err: err})
-		return
-	}
-
-	// ------------- Optional query parameter "type" -------------
+	// ------------- Path parameter "id" -------------
+	var id string
 
-	err = runtime.BindQueryParameter("form", true, false, "type", r.URL.Query(), &params.Type)
+	err = runtime.BindStyledParameterWithOptions("simple", "id", chi.URLParam(r, "id"), &id, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
 	if err != nil {
-		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "type", Err: err})
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "id", Err: err})
 		return
 	}
 
-	// ------------- Optional query parameter "open" -------------
+	// ------------- Path parameter "runId" -------------
+	var runId string
 
-	err = runtime.BindQueryParameter("form", true, false, "open", r.URL.Query(), &params.Open)
+	err = runtime.BindStyledParameterWithOptions("simple", "runId", chi.URLParam(r, "runId"), &runId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
 	if err != nil {
-		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "open", Err: err})
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "runId", Err: err})
 		return
 	}
 
-	// ------------- Optional query parameter "offset" -------------
-
-	err = runtime.BindQueryParameter("form", true, false, "offset", r.URL.Query(), &params.Offset)
-	if err != nil {
-		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "offset", Err: err})
-		return
-	}
+	ctx := r.Context()
 
-	// ------------- Optional query parameter "limit" -------------
+	ctx = context.WithValue(ctx, OAuth2Scopes, []string{"reaction:read"})
 
-	err = runtime.BindQueryParameter("form", true, false, "limit", r.URL.Query(), &params.Limit)
-	if err != nil {
-		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "limit", Err: err})
-		return
-	}
+	r = r.WithContext(ctx)
 
 	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.SearchTickets(w, r, params)
+		siw.Handler.GetReactionRun(w, r, id, runId)
 	}))
 
 	for _, middleware := range siw.HandlerMiddlewares {
@@ -2596,19 +3086,19 @@ func (siw *ServerInterfaceWrapper) SearchTickets(w http.ResponseWriter, r *http.
 	handler.ServeHTTP(w, r)
 }
 
-// ListTickets operation middleware
-func (siw *ServerInterfaceWrapper) ListTickets(w http.ResponseWriter, r *http.Request) {
+// ListSecrets operation middleware
+func (siw *ServerInterfaceWrapper) ListSecrets(w http.ResponseWriter, r *http.Request) {
 
 	var err error
 
 	ctx := r.Context()
 
-	ctx = context.WithValue(ctx, OAuth2Scopes, []string{"ticket:read"})
+	ctx = context.WithValue(ctx, OAuth2Scopes, []string{"settings:read"})
 
 	r = r.WithContext(ctx)
 
 	// Parameter object where we will unmarshal all parameters from the context
-	var params ListTicketsParams
+	var params ListSecretsParams
 
 	// ------------- Optional query parameter "offset" -------------
 
@@ -2627,7 +3117,7 @@ func (siw *ServerInterfaceWrapper) ListTickets(w http.ResponseWriter, r *http.Re
 	}
 
 	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.ListTickets(w, r, params)
+		siw.Handler.ListSecrets(w, r, params)
 	}))
 
 	for _, middleware := range siw.HandlerMiddlewares {
@@ -2637,17 +3127,17 @@ func (siw *ServerInterfaceWrapper) ListTickets(w http.ResponseWriter, r *http.Re
 	handler.ServeHTTP(w, r)
 }
 
-// CreateTicket operation middleware
-func (siw *ServerInterfaceWrapper) CreateTicket(w http.ResponseWriter, r *http.Request) {
+// CreateSecret operation middleware
+func (siw *ServerInterfaceWrapper) CreateSecret(w http.ResponseWriter, r *http.Request) {
 
 	ctx := r.Context()
 
-	ctx = context.WithValue(ctx, OAuth2Scopes, []string{"ticket:write"})
+	ctx = context.WithValue(ctx, OAuth2Scopes, []string{"settings:write"})
 
 	r = r.WithContext(ctx)
 
 	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.CreateTicket(w, r)
+		siw.Handler.CreateSecret(w, r)
 	}))
 
 	for _, middleware := range siw.HandlerMiddlewares {
@@ -2657,8 +3147,8 @@ func (siw *ServerInterfaceWrapper) CreateTicket(w http.ResponseWriter, r *http.R
 	handler.ServeHTTP(w, r)
 }
 
-// DeleteTicket operation middleware
-func (siw *ServerInterfaceWrapper) DeleteTicket(w http.ResponseWriter, r *http.Request) {
+// DeleteSecret operation middleware
+func (siw *ServerInterfaceWrapper) DeleteSecret(w http.ResponseWriter, r *http.Request) {
 
 	var err error
 
@@ -2673,12 +3163,12 @@ func (siw *ServerInterfaceWrapper) DeleteTicket(w http.ResponseWriter, r *http.R
 
 	ctx := r.Context()
 
-	ctx = context.WithValue(ctx, OAuth2Scopes, []string{"ticket:write"})
+	ctx = context.WithValue(ctx, OAuth2Scopes, []string{"settings:write"})
 
 	r = r.WithContext(ctx)
 
 	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.DeleteTicket(w, r, id)
+		siw.Handler.DeleteSecret(w, r, id)
 	}))
 
 	for _, middleware := range siw.HandlerMiddlewares {
@@ -2688,8 +3178,8 @@ func (siw *ServerInterfaceWrapper) DeleteTicket(w http.ResponseWriter, r *http.R
 	handler.ServeHTTP(w, r)
 }
 
-// GetTicket operation middleware
-func (siw *ServerInterfaceWrapper) GetTicket(w http.ResponseWriter, r *http.Request) {
+// GetSecret operation middleware
+func (siw *ServerInterfaceWrapper) GetSecret(w http.ResponseWriter, r *http.Request) {
 
 	var err error
 
@@ -2704,12 +3194,12 @@ func (siw *ServerInterfaceWrapper) GetTicket(w http.ResponseWriter, r *http.Requ
 
 	ctx := r.Context()
 
-	ctx = context.WithValue(ctx, OAuth2Scopes, []string{"ticket:read"})
+	ctx = context.WithValue(ctx, OAuth2Scopes, []string{"settings:read"})
 
 	r = r.WithContext(ctx)
 
 	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.GetTicket(w, r, id)
+		siw.Handler.GetSecret(w, r, id)
 	}))
 
 	for _, middleware := range siw.HandlerMiddlewares {
@@ -2719,8 +3209,8 @@ func (siw *ServerInterfaceWrapper) GetTicket(w http.ResponseWriter, r *http.Requ
 	handler.ServeHTTP(w, r)
 }
 
-// UpdateTicket operation middleware
-func (siw *ServerInterfaceWrapper) UpdateTicket(w http.ResponseWriter, r *http.Request) {
+// UpdateSecret operation middleware
+func (siw *ServerInterfaceWrapper) UpdateSecret(w http.ResponseWriter, r *http.Request) {
 
 	var err error
 
@@ -2735,12 +3225,12 @@ func (siw *ServerInterfaceWrapper) UpdateTicket(w http.ResponseWriter, r *http.R
 
 	ctx := r.Context()
 
-	ctx = context.WithValue(ctx, OAuth2Scopes, []string{"ticket:write"})
+	ctx = context.WithValue(ctx, OAuth2Scopes, []string{"settings:write"})
 
 	r = r.WithContext(ctx)
 
 	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.UpdateTicket(w, r, id)
+		siw.Handler.UpdateSecret(w, r, id)
 	}))
 
 	for _, middleware := range siw.HandlerMiddlewares {
@@ -2750,27 +3240,19 @@ func (siw *ServerInterfaceWrapper) UpdateTicket(w http.ResponseWriter, r *http.R
 	handler.ServeHTTP(w, r)
 }
 
-// ListTimeline operation middleware
-func (siw *ServerInterfaceWrapper) ListTimeline(w http.ResponseWriter, r *http.Request) {
+// ListSessions operation middleware
+func (siw *ServerInterfaceWrapper) ListSessions(w http.ResponseWriter, r *http.Request) {
 
 	var err error
 
 	ctx := r.Context()
 
-	ctx = context.WithValue(ctx, OAuth2Scopes, []string{"ticket:read"})
+	ctx = context.WithValue(ctx, OAuth2Scopes, []string{})
 
 	r = r.WithContext(ctx)
 
 	// Parameter object where we will unmarshal all parameters from the context
-	var params ListTimelineParams
-
-	// ------------- Optional query parameter "ticket" -------------
-
-	err = runtime.BindQueryParameter("form", true, false, "ticket", r.URL.Query(), &params.Ticket)
-	if err != nil {
-		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "ticket", Err: err})
-		return
-	}
+	var params ListSessionsParams
 
 	// ------------- Optional query parameter "offset" -------------
 
@@ -2789,7 +3271,7 @@ func (siw *ServerInterfaceWrapper) ListTimeline(w http.ResponseWriter, r *http.R
 	}
 
 	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.ListTimeline(w, r, params)
+		siw.Handler.ListSessions(w, r, params)
 	}))
 
 	for _, middleware := range siw.HandlerMiddlewares {
@@ -2799,17 +3281,28 @@ func (siw *ServerInterfaceWrapper) ListTimeline(w http.ResponseWriter, r *http.R
 	handler.ServeHTTP(w, r)
 }
 
-// CreateTimeline operation middleware
-func (siw *ServerInterfaceWrapper) CreateTimeline(w http.ResponseWriter, r *http.Request) {
+// DeleteSession operation middleware
+func (siw *ServerInterfaceWrapper) DeleteSession(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "id" -------------
+	var id string
+
+	err = runtime.BindStyledParameterWithOptions("simple", "id", chi.URLParam(r, "id"), &id, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "id", Err: err})
+		return
+	}
 
 	ctx := r.Context()
 
-	ctx = context.WithValue(ctx, OAuth2Scopes, []string{"ticket:write"})
+	ctx = context.WithValue(ctx, OAuth2Scopes, []string{})
 
 	r = r.WithContext(ctx)
 
 	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.CreateTimeline(w, r)
+		siw.Handler.DeleteSession(w, r, id)
 	}))
 
 	for _, middleware := range siw.HandlerMiddlewares {
@@ -2819,28 +3312,17 @@ func (siw *ServerInterfaceWrapper) CreateTimeline(w http.ResponseWriter, r *http
 	handler.ServeHTTP(w, r)
 }
 
-// DeleteTimeline operation middleware
-func (siw *ServerInterfaceWrapper) DeleteTimeline(w http.ResponseWriter, r *http.Request) {
-
-	var err error
-
-	// ------------- Path parameter "id" -------------
-	var id string
-
-	err = runtime.BindStyledParameterWithOptions("simple", "id", chi.URLParam(r, "id"), &id, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
-	if err != nil {
-		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "id", Err: err})
-		return
-	}
+// GetSettings operation middleware
+func (siw *ServerInterfaceWrapper) GetSettings(w http.ResponseWriter, r *http.Request) {
 
 	ctx := r.Context()
 
-	ctx = context.WithValue(ctx, OAuth2Scopes, []string{"ticket:write"})
+	ctx = context.WithValue(ctx, OAuth2Scopes, []string{"settings:read"})
 
 	r = r.WithContext(ctx)
 
 	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.DeleteTimeline(w, r, id)
+		siw.Handler.GetSettings(w, r)
 	}))
 
 	for _, middleware := range siw.HandlerMiddlewares {
@@ -2850,28 +3332,17 @@ func (siw *ServerInterfaceWrapper) DeleteTimeline(w http.ResponseWriter, r *http
 	handler.ServeHTTP(w, r)
 }
 
-// GetTimeline operation middleware
-func (siw *ServerInterfaceWrapper) GetTimeline(w http.ResponseWriter, r *http.Request) {
-
-	var err error
-
-	// ------------- Path parameter "id" -------------
-	var id string
-
-	err = runtime.BindStyledParameterWithOptions("simple", "id", chi.URLParam(r, "id"), &id, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
-	if err != nil {
-		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "id", Err: err})
-		return
-	}
+// UpdateSettings operation middleware
+func (siw *ServerInterfaceWrapper) UpdateSettings(w http.ResponseWriter, r *http.Request) {
 
 	ctx := r.Context()
 
-	ctx = context.WithValue(ctx, OAuth2Scopes, []string{"ticket:read"})
+	ctx = context.WithValue(ctx, OAuth2Scopes, []string{"settings:write"})
 
 	r = r.WithContext(ctx)
 
 	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.GetTimeline(w, r, id)
+		siw.Handler.UpdateSettings(w, r)
 	}))
 
 	for _, middleware := range siw.HandlerMiddlewares {
@@ -2881,28 +3352,17 @@ func (siw *ServerInterfaceWrapper) GetTimeline(w http.ResponseWriter, r *http.Re
 	handler.ServeHTTP(w, r)
 }
 
-// UpdateTimeline operation middleware
-func (siw *ServerInterfaceWrapper) UpdateTimeline(w http.ResponseWriter, r *http.Request) {
-
-	var err error
-
-	// ------------- Path parameter "id" -------------
-	var id string
-
-	err = runtime.BindStyledParameterWithOptions("simple", "id", chi.URLParam(r, "id"), &id, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
-	if err != nil {
-		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "id", Err: err})
-		return
-	}
+// GetSidebar operation middleware
+func (siw *ServerInterfaceWrapper) GetSidebar(w http.ResponseWriter, r *http.Request) {
 
 	ctx := r.Context()
 
-	ctx = context.WithValue(ctx, OAuth2Scopes, []string{"ticket:write"})
+	ctx = context.WithValue(ctx, OAuth2Scopes, []string{"ticket:read"})
 
 	r = r.WithContext(ctx)
 
 	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.UpdateTimeline(w, r, id)
+		siw.Handler.GetSidebar(w, r)
 	}))
 
 	for _, middleware := range siw.HandlerMiddlewares {
@@ -2912,19 +3372,27 @@ func (siw *ServerInterfaceWrapper) UpdateTimeline(w http.ResponseWriter, r *http
 	handler.ServeHTTP(w, r)
 }
 
-// ListTypes operation middleware
-func (siw *ServerInterfaceWrapper) ListTypes(w http.ResponseWriter, r *http.Request) {
+// ListTasks operation middleware
+func (siw *ServerInterfaceWrapper) ListTasks(w http.ResponseWriter, r *http.Request) {
 
 	var err error
 
 	ctx := r.Context()
 
-	ctx = context.WithValue(ctx, OAuth2Scopes, []string{"type:read"})
+	ctx = context.WithValue(ctx, OAuth2Scopes, []string{"ticket:read"})
 
 	r = r.WithContext(ctx)
 
 	// Parameter object where we will unmarshal all parameters from the context
-	var params ListTypesParams
+	var params ListTasksParams
+
+	// ------------- Optional query parameter "ticket" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "ticket", r.URL.Query(), &params.Ticket)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "ticket", Err: err})
+		return
+	}
 
 	// ------------- Optional query parameter "offset" -------------
 
@@ -2943,7 +3411,7 @@ func (siw *ServerInterfaceWrapper) ListTypes(w http.ResponseWriter, r *http.Requ
 	}
 
 	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.ListTypes(w, r, params)
+		siw.Handler.ListTasks(w, r, params)
 	}))
 
 	for _, middleware := range siw.HandlerMiddlewares {
@@ -2953,17 +3421,17 @@ func (siw *ServerInterfaceWrapper) ListTypes(w http.ResponseWriter, r *http.Requ
 	handler.ServeHTTP(w, r)
 }
 
-// CreateType operation middleware
-func (siw *ServerInterfaceWrapper) CreateType(w http.ResponseWriter, r *http.Request) {
+// CreateTask operation middleware
+func (siw *ServerInterfaceWrapper) CreateTask(w http.ResponseWriter, r *http.Request) {
 
 	ctx := r.Context()
 
-	ctx = context.WithValue(ctx, OAuth2Scopes, []string{"type:write"})
+	ctx = context.WithValue(ctx, OAuth2Scopes, []string{"ticket:write"})
 
 	r = r.WithContext(ctx)
 
 	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.CreateType(w, r)
+		siw.Handler.CreateTask(w, r)
 	}))
 
 	for _, middleware := range siw.HandlerMiddlewares {
@@ -2973,8 +3441,8 @@ func (siw *ServerInterfaceWrapper) CreateType(w http.ResponseWriter, r *http.Req
 	handler.ServeHTTP(w, r)
 }
 
-// DeleteType operation middleware
-func (siw *ServerInterfaceWrapper) DeleteType(w http.ResponseWriter, r *http.Request) {
+// DeleteTask operation middleware
+func (siw *ServerInterfaceWrapper) DeleteTask(w http.ResponseWriter, r *http.Request) {
 
 	var err error
 
@@ -2989,12 +3457,12 @@ func (siw *ServerInterfaceWrapper) DeleteType(w http.ResponseWriter, r *http.Req
 
 	ctx := r.Context()
 
-	ctx = context.WithValue(ctx, OAuth2Scopes, []string{"type:write"})
+	ctx = context.WithValue(ctx, OAuth2Scopes, []string{"ticket:write"})
 
 	r = r.WithContext(ctx)
 
 	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.DeleteType(w, r, id)
+		siw.Handler.DeleteTask(w, r, id)
 	}))
 
 	for _, middleware := range siw.HandlerMiddlewares {
@@ -3004,8 +3472,8 @@ func (siw *ServerInterfaceWrapper) DeleteType(w http.ResponseWriter, r *http.Req
 	handler.ServeHTTP(w, r)
 }
 
-// GetType operation middleware
-func (siw *ServerInterfaceWrapper) GetType(w http.ResponseWriter, r *http.Request) {
+// GetTask operation middleware
+func (siw *ServerInterfaceWrapper) GetTask(w http.ResponseWriter, r *http.Request) {
 
 	var err error
 
@@ -3020,12 +3488,12 @@ func (siw *ServerInterfaceWrapper) GetType(w http.ResponseWriter, r *http.Reques
 
 	ctx := r.Context()
 
-	ctx = context.WithValue(ctx, OAuth2Scopes, []string{"type:read"})
+	ctx = context.WithValue(ctx, OAuth2Scopes, []string{"ticket:read"})
 
 	r = r.WithContext(ctx)
 
 	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.GetType(w, r, id)
+		siw.Handler.GetTask(w, r, id)
 	}))
 
 	for _, middleware := range siw.HandlerMiddlewares {
@@ -3035,8 +3503,8 @@ func (siw *ServerInterfaceWrapper) GetType(w http.ResponseWriter, r *http.Reques
 	handler.ServeHTTP(w, r)
 }
 
-// UpdateType operation middleware
-func (siw *ServerInterfaceWrapper) UpdateType(w http.ResponseWriter, r *http.Request) {
+// UpdateTask operation middleware
+func (siw *ServerInterfaceWrapper) UpdateTask(w http.ResponseWriter, r *http.Request) {
 
 	var err error
 
@@ -3051,12 +3519,12 @@ func (siw *ServerInterfaceWrapper) UpdateType(w http.ResponseWriter, r *http.Req
 
 	ctx := r.Context()
 
-	ctx = context.WithValue(ctx, OAuth2Scopes, []string{"type:write"})
+	ctx = context.WithValue(ctx, OAuth2Scopes, []string{"ticket:write"})
 
 	r = r.WithContext(ctx)
 
 	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.UpdateType(w, r, id)
+		siw.Handler.UpdateTask(w, r, id)
 	}))
 
 	for _, middleware := range siw.HandlerMiddlewares {
@@ -3066,19 +3534,43 @@ func (siw *ServerInterfaceWrapper) UpdateType(w http.ResponseWriter, r *http.Req
 	handler.ServeHTTP(w, r)
 }
 
-// ListUsers operation middleware
-func (siw *ServerInterfaceWrapper) ListUsers(w http.ResponseWriter, r *http.Request) {
+// SearchTickets operation middleware
+func (siw *ServerInterfaceWrapper) SearchTickets(w http.ResponseWriter, r *http.Request) {
 
 	var err error
 
 	ctx := r.Context()
 
-	ctx = context.WithValue(ctx, OAuth2Scopes, []string{"user:read"})
+	ctx = context.WithValue(ctx, OAuth2Scopes, []string{"ticket:read"})
 
 	r = r.WithContext(ctx)
 
 	// Parameter object where we will unmarshal all parameters from the context
-	var params ListUsersParams
+	var params SearchTicketsParams
+
+	// ------------- Optional query parameter "query" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "query", r.URL.Query(), &params.Query)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "query", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "type" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "type", r.URL.Query(), &params.Type)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "type", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "open" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "open", r.URL.Query(), &params.Open)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "open", Err: err})
+		return
+	}
 
 	// ------------- Optional query parameter "offset" -------------
 
@@ -3097,7 +3589,7 @@ func (siw *ServerInterfaceWrapper) ListUsers(w http.ResponseWriter, r *http.Requ
 	}
 
 	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.ListUsers(w, r, params)
+		siw.Handler.SearchTickets(w, r, params)
 	}))
 
 	for _, middleware := range siw.HandlerMiddlewares {
@@ -3107,48 +3599,38 @@ func (siw *ServerInterfaceWrapper) ListUsers(w http.ResponseWriter, r *http.Requ
 	handler.ServeHTTP(w, r)
 }
 
-// CreateUser operation middleware
-func (siw *ServerInterfaceWrapper) CreateUser(w http.ResponseWriter, r *http.Request) {
+// ListTickets operation middleware
+func (siw *ServerInterfaceWrapper) ListTickets(w http.ResponseWriter, r *http.Request) {
+
+	var err error
 
 	ctx := r.Context()
 
-	ctx = context.WithValue(ctx, OAuth2Scopes, []string{"user:write"})
+	ctx = context.WithValue(ctx, OAuth2Scopes, []string{"ticket:read"})
 
 	r = r.WithContext(ctx)
 
-	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.CreateUser(w, r)
-	}))
-
-	for _, middleware := range siw.HandlerMiddlewares {
-		handler = middleware(handler)
-	}
-
-	handler.ServeHTTP(w, r)
-}
-
-// DeleteUser operation middleware
-func (siw *ServerInterfaceWrapper) DeleteUser(w http.ResponseWriter, r *http.Request) {
-
-	var err error
+	// Parameter object where we will unmarshal all parameters from the context
+	var params ListTicketsParams
 
-	// ------------- Path parameter "id" -------------
-	var id string
+	// ------------- Optional query parameter "offset" -------------
 
-	err = runtime.BindStyledParameterWithOptions("simple", "id", chi.URLParam(r, "id"), &id, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	err = runtime.BindQueryParameter("form", true, false, "offset", r.URL.Query(), &params.Offset)
 	if err != nil {
-		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "id", Err: err})
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "offset", Err: err})
 		return
 	}
 
-	ctx := r.Context()
-
-	ctx = context.WithValue(ctx, OAuth2Scopes, []string{"user:write"})
+	// ------------- Optional query parameter "limit" -------------
 
-	r = r.WithContext(ctx)
+	err = runtime.BindQueryParameter("form", true, false, "limit", r.URL.Query(), &params.Limit)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "limit", Err: err})
+		return
+	}
 
 	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.DeleteUser(w, r, id)
+		siw.Handler.ListTickets(w, r, params)
 	}))
 
 	for _, middleware := range siw.HandlerMiddlewares {
@@ -3158,28 +3640,17 @@ func (siw *ServerInterfaceWrapper) DeleteUser(w http.ResponseWriter, r *http.Req
 	handler.ServeHTTP(w, r)
 }
 
-// GetUser operation middleware
-func (siw *ServerInterfaceWrapper) GetUser(w http.ResponseWriter, r *http.Request) {
-
-	var err error
-
-	// ------------- Path parameter "id" -------------
-	var id string
-
-	err = runtime.BindStyledParameterWithOptions("simple", "id", chi.URLParam(r, "id"), &id, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
-	if err != nil {
-		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "id", Err: err})
-		return
-	}
+// CreateTicket operation middleware
+func (siw *ServerInterfaceWrapper) CreateTicket(w http.ResponseWriter, r *http.Request) {
 
 	ctx := r.Context()
 
-	ctx = context.WithValue(ctx, OAuth2Scopes, []string{"user:read"})
+	ctx = context.WithValue(ctx, OAuth2Scopes, []string{"ticket:write"})
 
 	r = r.WithContext(ctx)
 
 	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.GetUser(w, r, id)
+		siw.Handler.CreateTicket(w, r)
 	}))
 
 	for _, middleware := range siw.HandlerMiddlewares {
@@ -3189,8 +3660,8 @@ func (siw *ServerInterfaceWrapper) GetUser(w http.ResponseWriter, r *http.Reques
 	handler.ServeHTTP(w, r)
 }
 
-// UpdateUser operation middleware
-func (siw *ServerInterfaceWrapper) UpdateUser(w http.ResponseWriter, r *http.Request) {
+// DeleteTicket operation middleware
+func (siw *ServerInterfaceWrapper) DeleteTicket(w http.ResponseWriter, r *http.Request) {
 
 	var err error
 
@@ -3205,12 +3676,12 @@ func (siw *ServerInterfaceWrapper) UpdateUser(w http.ResponseWriter, r *http.Req
 
 	ctx := r.Context()
 
-	ctx = context.WithValue(ctx, OAuth2Scopes, []string{"user:write"})
+	ctx = context.WithValue(ctx, OAuth2Scopes, []string{"ticket:write"})
 
 	r = r.WithContext(ctx)
 
 	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.UpdateUser(w, r, id)
+		siw.Handler.DeleteTicket(w, r, id)
 	}))
 
 	for _, middleware := range siw.HandlerMiddlewares {
@@ -3220,8 +3691,8 @@ func (siw *ServerInterfaceWrapper) UpdateUser(w http.ResponseWriter, r *http.Req
 	handler.ServeHTTP(w, r)
 }
 
-// ListUserGroups operation middleware
-func (siw *ServerInterfaceWrapper) ListUserGroups(w http.ResponseWriter, r *http.Request) {
+// GetTicket operation middleware
+func (siw *ServerInterfaceWrapper) GetTicket(w http.ResponseWriter, r *http.Request) {
 
 	var err error
 
@@ -3236,12 +3707,12 @@ func (siw *ServerInterfaceWrapper) ListUserGroups(w http.ResponseWriter, r *http
 
 	ctx := r.Context()
 
-	ctx = context.WithValue(ctx, OAuth2Scopes, []string{"user:read"})
+	ctx = context.WithValue(ctx, OAuth2Scopes, []string{"ticket:read"})
 
 	r = r.WithContext(ctx)
 
 	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.ListUserGroups(w, r, id)
+		siw.Handler.GetTicket(w, r, id)
 	}))
 
 	for _, middleware := range siw.HandlerMiddlewares {
@@ -3251,8 +3722,8 @@ func (siw *ServerInterfaceWrapper) ListUserGroups(w http.ResponseWriter, r *http
 	handler.ServeHTTP(w, r)
 }
 
-// AddUserGroup operation middleware
-func (siw *ServerInterfaceWrapper) AddUserGroup(w http.ResponseWriter, r *http.Request) {
+// UpdateTicket operation middleware
+func (siw *ServerInterfaceWrapper) UpdateTicket(w http.ResponseWriter, r *http.Request) {
 
 	var err error
 
@@ -3267,12 +3738,12 @@ func (siw *ServerInterfaceWrapper) AddUserGroup(w http.ResponseWriter, r *http.R
 
 	ctx := r.Context()
 
-	ctx = context.WithValue(ctx, OAuth2Scopes, []string{"user:write"})
+	ctx = context.WithValue(ctx, OAuth2Scopes, []string{"ticket:write"})
 
 	r = r.WithContext(ctx)
 
 	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.AddUserGroup(w, r, id)
+		siw.Handler.UpdateTicket(w, r, id)
 	}))
 
 	for _, middleware := range siw.HandlerMiddlewares {
@@ -3282,8 +3753,77 @@ func (siw *ServerInterfaceWrapper) AddUserGroup(w http.ResponseWriter, r *http.R
 	handler.ServeHTTP(w, r)
 }
 
-// RemoveUserGroup operation middleware
-func (siw *ServerInterfaceWrapper) RemoveUserGroup(w http.ResponseWriter, r *http.Request) {
+// ListTimeline operation middleware
+func (siw *ServerInterfaceWrapper) ListTimeline(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, OAuth2Scopes, []string{"ticket:read"})
+
+	r = r.WithContext(ctx)
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params ListTimelineParams
+
+	// ------------- Optional query parameter "ticket" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "ticket", r.URL.Query(), &params.Ticket)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "ticket", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "offset" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "offset", r.URL.Query(), &params.Offset)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "offset", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "limit" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "limit", r.URL.Query(), &params.Limit)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "limit", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.ListTimeline(w, r, params)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// CreateTimeline operation middleware
+func (siw *ServerInterfaceWrapper) CreateTimeline(w http.ResponseWriter, r *http.Request) {
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, OAuth2Scopes, []string{"ticket:write"})
+
+	r = r.WithContext(ctx)
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.CreateTimeline(w, r)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// DeleteTimeline operation middleware
+func (siw *ServerInterfaceWrapper) DeleteTimeline(w http.ResponseWriter, r *http.Request) {
 
 	var err error
 
@@ -3296,23 +3836,45 @@ func (siw *ServerInterfaceWrapper) RemoveUserGroup(w http.ResponseWriter, r *htt
 		return
 	}
 
-	// ------------- Path parameter "groupId" -------------
-	var groupId string
+	ctx := r.Context()
 
-	err = runtime.BindStyledParameterWithOptions("simple", "groupId", chi.URLParam(r, "groupId"), &groupId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	ctx = context.WithValue(ctx, OAuth2Scopes, []string{"ticket:write"})
+
+	r = r.WithContext(ctx)
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.DeleteTimeline(w, r, id)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// GetTimeline operation middleware
+func (siw *ServerInterfaceWrapper) GetTimeline(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "id" -------------
+	var id string
+
+	err = runtime.BindStyledParameterWithOptions("simple", "id", chi.URLParam(r, "id"), &id, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
 	if err != nil {
-		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "groupId", Err: err})
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "id", Err: err})
 		return
 	}
 
 	ctx := r.Context()
 
-	ctx = context.WithValue(ctx, OAuth2Scopes, []string{"user:write"})
+	ctx = context.WithValue(ctx, OAuth2Scopes, []string{"ticket:read"})
 
 	r = r.WithContext(ctx)
 
 	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.RemoveUserGroup(w, r, id, groupId)
+		siw.Handler.GetTimeline(w, r, id)
 	}))
 
 	for _, middleware := range siw.HandlerMiddlewares {
@@ -3322,8 +3884,8 @@ func (siw *ServerInterfaceWrapper) RemoveUserGroup(w http.ResponseWriter, r *htt
 	handler.ServeHTTP(w, r)
 }
 
-// ListUserPermissions operation middleware
-func (siw *ServerInterfaceWrapper) ListUserPermissions(w http.ResponseWriter, r *http.Request) {
+// UpdateTimeline operation middleware
+func (siw *ServerInterfaceWrapper) UpdateTimeline(w http.ResponseWriter, r *http.Request) {
 
 	var err error
 
@@ -3338,12 +3900,12 @@ func (siw *ServerInterfaceWrapper) ListUserPermissions(w http.ResponseWriter, r
 
 	ctx := r.Context()
 
-	ctx = context.WithValue(ctx, OAuth2Scopes, []string{"user:read"})
+	ctx = context.WithValue(ctx, OAuth2Scopes, []string{"ticket:write"})
 
 	r = r.WithContext(ctx)
 
 	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.ListUserPermissions(w, r, id)
+		siw.Handler.UpdateTimeline(w, r, id)
 	}))
 
 	for _, middleware := range siw.HandlerMiddlewares {
@@ -3353,19 +3915,19 @@ func (siw *ServerInterfaceWrapper) ListUserPermissions(w http.ResponseWriter, r
 	handler.ServeHTTP(w, r)
 }
 
-// ListWebhooks operation middleware
-func (siw *ServerInterfaceWrapper) ListWebhooks(w http.ResponseWriter, r *http.Request) {
+// ListApiTokens operation middleware
+func (siw *ServerInterfaceWrapper) ListApiTokens(w http.ResponseWriter, r *http.Request) {
 
 	var err error
 
 	ctx := r.Context()
 
-	ctx = context.WithValue(ctx, OAuth2Scopes, []string{"webhook:read"})
+	ctx = context.WithValue(ctx, OAuth2Scopes, []string{})
 
 	r = r.WithContext(ctx)
 
 	// Parameter object where we will unmarshal all parameters from the context
-	var params ListWebhooksParams
+	var params ListApiTokensParams
 
 	// ------------- Optional query parameter "offset" -------------
 
@@ -3384,7 +3946,7 @@ func (siw *ServerInterfaceWrapper) ListWebhooks(w http.ResponseWriter, r *http.R
 	}
 
 	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.ListWebhooks(w, r, params)
+		siw.Handler.ListApiTokens(w, r, params)
 	}))
 
 	for _, middleware := range siw.HandlerMiddlewares {
@@ -3394,17 +3956,17 @@ func (siw *ServerInterfaceWrapper) ListWebhooks(w http.ResponseWriter, r *http.R
 	handler.ServeHTTP(w, r)
 }
 
-// CreateWebhook operation middleware
-func (siw *ServerInterfaceWrapper) CreateWebhook(w http.ResponseWriter, r *http.Request) {
+// CreateApiToken operation middleware
+func (siw *ServerInterfaceWrapper) CreateApiToken(w http.ResponseWriter, r *http.Request) {
 
 	ctx := r.Context()
 
-	ctx = context.WithValue(ctx, OAuth2Scopes, []string{"webhook:write"})
+	ctx = context.WithValue(ctx, OAuth2Scopes, []string{})
 
 	r = r.WithContext(ctx)
 
 	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.CreateWebhook(w, r)
+		siw.Handler.CreateApiToken(w, r)
 	}))
 
 	for _, middleware := range siw.HandlerMiddlewares {
@@ -3414,8 +3976,8 @@ func (siw *ServerInterfaceWrapper) CreateWebhook(w http.ResponseWriter, r *http.
 	handler.ServeHTTP(w, r)
 }
 
-// DeleteWebhook operation middleware
-func (siw *ServerInterfaceWrapper) DeleteWebhook(w http.ResponseWriter, r *http.Request) {
+// DeleteApiToken operation middleware
+func (siw *ServerInterfaceWrapper) DeleteApiToken(w http.ResponseWriter, r *http.Request) {
 
 	var err error
 
@@ -3430,12 +3992,12 @@ func (siw *ServerInterfaceWrapper) DeleteWebhook(w http.ResponseWriter, r *http.
 
 	ctx := r.Context()
 
-	ctx = context.WithValue(ctx, OAuth2Scopes, []string{"webhook:write"})
+	ctx = context.WithValue(ctx, OAuth2Scopes, []string{})
 
 	r = r.WithContext(ctx)
 
 	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.DeleteWebhook(w, r, id)
+		siw.Handler.DeleteApiToken(w, r, id)
 	}))
 
 	for _, middleware := range siw.HandlerMiddlewares {
@@ -3445,28 +4007,58 @@ func (siw *ServerInterfaceWrapper) DeleteWebhook(w http.ResponseWriter, r *http.
 	handler.ServeHTTP(w, r)
 }
 
-// GetWebhook operation middleware
-func (siw *ServerInterfaceWrapper) GetWebhook(w http.ResponseWriter, r *http.Request) {
+// ListTypes operation middleware
+func (siw *ServerInterfaceWrapper) ListTypes(w http.ResponseWriter, r *http.Request) {
 
 	var err error
 
-	// ------------- Path parameter "id" -------------
-	var id string
+	ctx := r.Context()
 
-	err = runtime.BindStyledParameterWithOptions("simple", "id", chi.URLParam(r, "id"), &id, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	ctx = context.WithValue(ctx, OAuth2Scopes, []string{"type:read"})
+
+	r = r.WithContext(ctx)
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params ListTypesParams
+
+	// ------------- Optional query parameter "offset" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "offset", r.URL.Query(), &params.Offset)
 	if err != nil {
-		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "id", Err: err})
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "offset", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "limit" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "limit", r.URL.Query(), &params.Limit)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "limit", Err: err})
 		return
 	}
 
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.ListTypes(w, r, params)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// CreateType operation middleware
+func (siw *ServerInterfaceWrapper) CreateType(w http.ResponseWriter, r *http.Request) {
+
 	ctx := r.Context()
 
-	ctx = context.WithValue(ctx, OAuth2Scopes, []string{"webhook:read"})
+	ctx = context.WithValue(ctx, OAuth2Scopes, []string{"type:write"})
 
 	r = r.WithContext(ctx)
 
 	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.GetWebhook(w, r, id)
+		siw.Handler.CreateType(w, r)
 	}))
 
 	for _, middleware := range siw.HandlerMiddlewares {
@@ -3476,8 +4068,8 @@ func (siw *ServerInterfaceWrapper) GetWebhook(w http.ResponseWriter, r *http.Req
 	handler.ServeHTTP(w, r)
 }
 
-// UpdateWebhook operation middleware
-func (siw *ServerInterfaceWrapper) UpdateWebhook(w http.ResponseWriter, r *http.Request) {
+// DeleteType operation middleware
+func (siw *ServerInterfaceWrapper) DeleteType(w http.ResponseWriter, r *http.Request) {
 
 	var err error
 
@@ -3492,12 +4084,12 @@ func (siw *ServerInterfaceWrapper) UpdateWebhook(w http.ResponseWriter, r *http.
 
 	ctx := r.Context()
 
-	ctx = context.WithValue(ctx, OAuth2Scopes, []string{"webhook:write"})
+	ctx = context.WithValue(ctx, OAuth2Scopes, []string{"type:write"})
 
 	r = r.WithContext(ctx)
 
 	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.UpdateWebhook(w, r, id)
+		siw.Handler.DeleteType(w, r, id)
 	}))
 
 	for _, middleware := range siw.HandlerMiddlewares {
@@ -3507,65 +4099,971 @@ func (siw *ServerInterfaceWrapper) UpdateWebhook(w http.ResponseWriter, r *http.
 	handler.ServeHTTP(w, r)
 }
 
-type UnescapedCookieParamError struct {
-	ParamName string
-	Err       error
-}
+// GetType operation middleware
+func (siw *ServerInterfaceWrapper) GetType(w http.ResponseWriter, r *http.Request) {
 
-func (e *UnescapedCookieParamError) Error() string {
-	return fmt.Sprintf("error unescaping cookie parameter '%s'", e.ParamName)
-}
+	var err error
 
-func (e *UnescapedCookieParamError) Unwrap() error {
-	return e.Err
-}
+	// ------------- Path parameter "id" -------------
+	var id string
 
-type UnmarshalingParamError struct {
-	ParamName string
-	Err       error
-}
+	err = runtime.BindStyledParameterWithOptions("simple", "id", chi.URLParam(r, "id"), &id, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "id", Err: err})
+		return
+	}
 
-func (e *UnmarshalingParamError) Error() string {
-	return fmt.Sprintf("Error unmarshaling parameter %s as JSON: %s", e.ParamName, e.Err.Error())
-}
+	ctx := r.Context()
 
-func (e *UnmarshalingParamError) Unwrap() error {
-	return e.Err
-}
+	ctx = context.WithValue(ctx, OAuth2Scopes, []string{"type:read"})
 
-type RequiredParamError struct {
-	ParamName string
-}
+	r = r.WithContext(ctx)
 
-func (e *RequiredParamError) Error() string {
-	return fmt.Sprintf("Query argument %s is required, but not found", e.ParamName)
-}
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.GetType(w, r, id)
+	}))
 
-type RequiredHeaderError struct {
-	ParamName string
-	Err       error
-}
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
 
-func (e *RequiredHeaderError) Error() string {
-	return fmt.Sprintf("Header parameter %s is required, but not found", e.ParamName)
+	handler.ServeHTTP(w, r)
 }
 
-func (e *RequiredHeaderError) Unwrap() error {
-	return e.Err
-}
+// UpdateType operation middleware
+func (siw *ServerInterfaceWrapper) UpdateType(w http.ResponseWriter, r *http.Request) {
 
-type InvalidParamFormatError struct {
-	ParamName string
-	Err       error
-}
+	var err error
 
-func (e *InvalidParamFormatError) Error() string {
-	return fmt.Sprintf("Invalid format for parameter %s: %s", e.ParamName, e.Err.Error())
-}
+	// ------------- Path parameter "id" -------------
+	var id string
 
-func (e *InvalidParamFormatError) Unwrap() error {
-	return e.Err
-}
+	err = runtime.BindStyledParameterWithOptions("simple", "id", chi.URLParam(r, "id"), &id, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "id", Err: err})
+		return
+	}
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, OAuth2Scopes, []string{"type:write"})
+
+	r = r.WithContext(ctx)
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.UpdateType(w, r, id)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// ListUsers operation middleware
+func (siw *ServerInterfaceWrapper) ListUsers(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, OAuth2Scopes, []string{"user:read"})
+
+	r = r.WithContext(ctx)
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params ListUsersParams
+
+	// ------------- Optional query parameter "offset" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "offset", r.URL.Query(), &params.Offset)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "offset", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "limit" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "limit", r.URL.Query(), &params.Limit)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "limit", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.ListUsers(w, r, params)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// CreateUser operation middleware
+func (siw *ServerInterfaceWrapper) CreateUser(w http.ResponseWriter, r *http.Request) {
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, OAuth2Scopes, []string{"user:write"})
+
+	r = r.WithContext(ctx)
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.CreateUser(w, r)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// DeleteUser operation middleware
+func (siw *ServerInterfaceWrapper) DeleteUser(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "id" -------------
+	var id string
+
+	err = runtime.BindStyledParameterWithOptions("simple", "id", chi.URLParam(r, "id"), &id, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "id", Err: err})
+		return
+	}
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, OAuth2Scopes, []string{"user:write"})
+
+	r = r.WithContext(ctx)
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.DeleteUser(w, r, id)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// GetUser operation middleware
+func (siw *ServerInterfaceWrapper) GetUser(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "id" -------------
+	var id string
+
+	err = runtime.BindStyledParameterWithOptions("simple", "id", chi.URLParam(r, "id"), &id, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "id", Err: err})
+		return
+	}
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, OAuth2Scopes, []string{"user:read"})
+
+	r = r.WithContext(ctx)
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.GetUser(w, r, id)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// UpdateUser operation middleware
+func (siw *ServerInterfaceWrapper) UpdateUser(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "id" -------------
+	var id string
+
+	err = runtime.BindStyledParameterWithOptions("simple", "id", chi.URLParam(r, "id"), &id, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "id", Err: err})
+		return
+	}
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, OAuth2Scopes, []string{"user:write"})
+
+	r = r.WithContext(ctx)
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.UpdateUser(w, r, id)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// ListUserGroups operation middleware
+func (siw *ServerInterfaceWrapper) ListUserGroups(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "id" -------------
+	var id string
+
+	err = runtime.BindStyledParameterWithOptions("simple", "id", chi.URLParam(r, "id"), &id, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "id", Err: err})
+		return
+	}
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, OAuth2Scopes, []string{"user:read"})
+
+	r = r.WithContext(ctx)
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.ListUserGroups(w, r, id)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// AddUserGroup operation middleware
+func (siw *ServerInterfaceWrapper) AddUserGroup(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "id" -------------
+	var id string
+
+	err = runtime.BindStyledParameterWithOptions("simple", "id", chi.URLParam(r, "id"), &id, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "id", Err: err})
+		return
+	}
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, OAuth2Scopes, []string{"user:write"})
+
+	r = r.WithContext(ctx)
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.AddUserGroup(w, r, id)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// RemoveUserGroup operation middleware
+func (siw *ServerInterfaceWrapper) RemoveUserGroup(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "id" -------------
+	var id string
+
+	err = runtime.BindStyledParameterWithOptions("simple", "id", chi.URLParam(r, "id"), &id, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "id", Err: err})
+		return
+	}
+
+	// ------------- Path parameter "groupId" -------------
+	var groupId string
+
+	err = runtime.BindStyledParameterWithOptions("simple", "groupId", chi.URLParam(r, "groupId"), &groupId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "groupId", Err: err})
+		return
+	}
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, OAuth2Scopes, []string{"user:write"})
+
+	r = r.WithContext(ctx)
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.RemoveUserGroup(w, r, id, groupId)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// ListUserPermissions operation middleware
+func (siw *ServerInterfaceWrapper) ListUserPermissions(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "id" -------------
+	var id string
+
+	err = runtime.BindStyledParameterWithOptions("simple", "id", chi.URLParam(r, "id"), &id, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "id", Err: err})
+		return
+	}
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, OAuth2Scopes, []string{"user:read"})
+
+	r = r.WithContext(ctx)
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.ListUserPermissions(w, r, id)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// DeleteUserSessions operation middleware
+func (siw *ServerInterfaceWrapper) DeleteUserSessions(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "id" -------------
+	var id string
+
+	err = runtime.BindStyledParameterWithOptions("simple", "id", chi.URLParam(r, "id"), &id, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "id", Err: err})
+		return
+	}
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, OAuth2Scopes, []string{"user:write"})
+
+	r = r.WithContext(ctx)
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.DeleteUserSessions(w, r, id)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// ListUserSessions operation middleware
+func (siw *ServerInterfaceWrapper) ListUserSessions(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "id" -------------
+	var id string
+
+	err = runtime.BindStyledParameterWithOptions("simple", "id", chi.URLParam(r, "id"), &id, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "id", Err: err})
+		return
+	}
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, OAuth2Scopes, []string{"user:write"})
+
+	r = r.WithContext(ctx)
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params ListUserSessionsParams
+
+	// ------------- Optional query parameter "offset" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "offset", r.URL.Query(), &params.Offset)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "offset", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "limit" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "limit", r.URL.Query(), &params.Limit)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "limit", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.ListUserSessions(w, r, id, params)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// DeleteUserSession operation middleware
+func (siw *ServerInterfaceWrapper) DeleteUserSession(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "id" -------------
+	var id string
+
+	err = runtime.BindStyledParameterWithOptions("simple", "id", chi.URLParam(r, "id"), &id, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "id", Err: err})
+		return
+	}
+
+	// ------------- Path parameter "sessionId" -------------
+	var sessionId string
+
+	err = runtime.BindStyledParameterWithOptions("simple", "sessionId", chi.URLParam(r, "sessionId"), &sessionId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "sessionId", Err: err})
+		return
+	}
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, OAuth2Scopes, []string{"user:write"})
+
+	r = r.WithContext(ctx)
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.DeleteUserSession(w, r, id, sessionId)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// ListUserApiTokens operation middleware
+func (siw *ServerInterfaceWrapper) ListUserApiTokens(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "id" -------------
+	var id string
+
+	err = runtime.BindStyledParameterWithOptions("simple", "id", chi.URLParam(r, "id"), &id, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "id", Err: err})
+		return
+	}
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, OAuth2Scopes, []string{"user:write"})
+
+	r = r.WithContext(ctx)
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params ListUserApiTokensParams
+
+	// ------------- Optional query parameter "offset" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "offset", r.URL.Query(), &params.Offset)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "offset", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "limit" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "limit", r.URL.Query(), &params.Limit)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "limit", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.ListUserApiTokens(w, r, id, params)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// CreateUserApiToken operation middleware
+func (siw *ServerInterfaceWrapper) CreateUserApiToken(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "id" -------------
+	var id string
+
+	err = runtime.BindStyledParameterWithOptions("simple", "id", chi.URLParam(r, "id"), &id, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "id", Err: err})
+		return
+	}
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, OAuth2Scopes, []string{"user:write"})
+
+	r = r.WithContext(ctx)
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.CreateUserApiToken(w, r, id)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// DeleteUserApiToken operation middleware
+func (siw *ServerInterfaceWrapper) DeleteUserApiToken(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "id" -------------
+	var id string
+
+	err = runtime.BindStyledParameterWithOptions("simple", "id", chi.URLParam(r, "id"), &id, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "id", Err: err})
+		return
+	}
+
+	// ------------- Path parameter "tokenId" -------------
+	var tokenId string
+
+	err = runtime.BindStyledParameterWithOptions("simple", "tokenId", chi.URLParam(r, "tokenId"), &tokenId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "tokenId", Err: err})
+		return
+	}
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, OAuth2Scopes, []string{"user:write"})
+
+	r = r.WithContext(ctx)
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.DeleteUserApiToken(w, r, id, tokenId)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// ListWebhooks operation middleware
+func (siw *ServerInterfaceWrapper) ListWebhooks(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, OAuth2Scopes, []string{"webhook:read"})
+
+	r = r.WithContext(ctx)
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params ListWebhooksParams
+
+	// ------------- Optional query parameter "offset" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "offset", r.URL.Query(), &params.Offset)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "offset", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "limit" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "limit", r.URL.Query(), &params.Limit)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "limit", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.ListWebhooks(w, r, params)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// CreateWebhook operation middleware
+func (siw *ServerInterfaceWrapper) CreateWebhook(w http.ResponseWriter, r *http.Request) {
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, OAuth2Scopes, []string{"webhook:write"})
+
+	r = r.WithContext(ctx)
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.CreateWebhook(w, r)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// DeleteWebhook operation middleware
+func (siw *ServerInterfaceWrapper) DeleteWebhook(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "id" -------------
+	var id string
+
+	err = runtime.BindStyledParameterWithOptions("simple", "id", chi.URLParam(r, "id"), &id, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "id", Err: err})
+		return
+	}
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, OAuth2Scopes, []string{"webhook:write"})
+
+	r = r.WithContext(ctx)
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.DeleteWebhook(w, r, id)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// GetWebhook operation middleware
+func (siw *ServerInterfaceWrapper) GetWebhook(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "id" -------------
+	var id string
+
+	err = runtime.BindStyledParameterWithOptions("simple", "id", chi.URLParam(r, "id"), &id, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "id", Err: err})
+		return
+	}
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, OAuth2Scopes, []string{"webhook:read"})
+
+	r = r.WithContext(ctx)
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.GetWebhook(w, r, id)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// UpdateWebhook operation middleware
+func (siw *ServerInterfaceWrapper) UpdateWebhook(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "id" -------------
+	var id string
+
+	err = runtime.BindStyledParameterWithOptions("simple", "id", chi.URLParam(r, "id"), &id, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "id", Err: err})
+		return
+	}
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, OAuth2Scopes, []string{"webhook:write"})
+
+	r = r.WithContext(ctx)
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.UpdateWebhook(w, r, id)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// ListWebhookDeliveries operation middleware
+func (siw *ServerInterfaceWrapper) ListWebhookDeliveries(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "id" -------------
+	var id string
+
+	err = runtime.BindStyledParameterWithOptions("simple", "id", chi.URLParam(r, "id"), &id, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "id", Err: err})
+		return
+	}
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, OAuth2Scopes, []string{"webhook:read"})
+
+	r = r.WithContext(ctx)
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params ListWebhookDeliveriesParams
+
+	// ------------- Optional query parameter "offset" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "offset", r.URL.Query(), &params.Offset)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "offset", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "limit" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "limit", r.URL.Query(), &params.Limit)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "limit", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.ListWebhookDeliveries(w, r, id, params)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// GetWebhookDelivery operation middleware
+func (siw *ServerInterfaceWrapper) GetWebhookDelivery(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "id" -------------
+	var id string
+
+	err = runtime.BindStyledParameterWithOptions("simple", "id", chi.URLParam(r, "id"), &id, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "id", Err: err})
+		return
+	}
+
+	// ------------- Path parameter "deliveryId" -------------
+	var deliveryId string
+
+	err = runtime.BindStyledParameterWithOptions("simple", "deliveryId", chi.URLParam(r, "deliveryId"), &deliveryId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "deliveryId", Err: err})
+		return
+	}
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, OAuth2Scopes, []string{"webhook:read"})
+
+	r = r.WithContext(ctx)
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.GetWebhookDelivery(w, r, id, deliveryId)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// RedeliverWebhookDelivery operation middleware
+func (siw *ServerInterfaceWrapper) RedeliverWebhookDelivery(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "id" -------------
+	var id string
+
+	err = runtime.BindStyledParameterWithOptions("simple", "id", chi.URLParam(r, "id"), &id, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "id", Err: err})
+		return
+	}
+
+	// ------------- Path parameter "deliveryId" -------------
+	var deliveryId string
+
+	err = runtime.BindStyledParameterWithOptions("simple", "deliveryId", chi.URLParam(r, "deliveryId"), &deliveryId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "deliveryId", Err: err})
+		return
+	}
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, OAuth2Scopes, []string{"webhook:write"})
+
+	r = r.WithContext(ctx)
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.RedeliverWebhookDelivery(w, r, id, deliveryId)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// RotateWebhookSecret operation middleware
+func (siw *ServerInterfaceWrapper) RotateWebhookSecret(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "id" -------------
+	var id string
+
+	err = runtime.BindStyledParameterWithOptions("simple", "id", chi.URLParam(r, "id"), &id, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "id", Err: err})
+		return
+	}
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, OAuth2Scopes, []string{"webhook:write"})
+
+	r = r.WithContext(ctx)
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.RotateWebhookSecret(w, r, id)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+type UnescapedCookieParamError struct {
+	ParamName string
+	Err       error
+}
+
+func (e *UnescapedCookieParamError) Error() string {
+	return fmt.Sprintf("error unescaping cookie parameter '%s'", e.ParamName)
+}
+
+func (e *UnescapedCookieParamError) Unwrap() error {
+	return e.Err
+}
+
+type UnmarshalingParamError struct {
+	ParamName string
+	Err       error
+}
+
+func (e *UnmarshalingParamError) Error() string {
+	return fmt.Sprintf("Error unmarshaling parameter %s as JSON: %s", e.ParamName, e.Err.Error())
+}
+
+func (e *UnmarshalingParamError) Unwrap() error {
+	return e.Err
+}
+
+type RequiredParamError struct {
+	ParamName string
+}
+
+func (e *RequiredParamError) Error() string {
+	return fmt.Sprintf("Query argument %s is required, but not found", e.ParamName)
+}
+
+type RequiredHeaderError struct {
+	ParamName string
+	Err       error
+}
+
+func (e *RequiredHeaderError) Error() string {
+	return fmt.Sprintf("Header parameter %s is required, but not found", e.ParamName)
+}
+
+func (e *RequiredHeaderError) Unwrap() error {
+	return e.Err
+}
+
+type InvalidParamFormatError struct {
+	ParamName string
+	Err       error
+}
+
+func (e *InvalidParamFormatError) Error() string {
+	return fmt.Sprintf("Invalid format for parameter %s: %s", e.ParamName, e.Err.Error())
+}
+
+func (e *InvalidParamFormatError) Unwrap() error {
+	return e.Err
+}
 
 type TooManyValuesForParamError struct {
 	ParamName string
@@ -3620,6 +5118,9 @@ func HandlerWithOptions(si ServerInterface, options ChiServerOptions) http.Handl
 		ErrorHandlerFunc:   options.ErrorHandlerFunc,
 	}
 
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/changes", wrapper.ListChanges)
+	})
 	r.Group(func(r chi.Router) {
 		r.Get(options.BaseURL+"/comments", wrapper.ListComments)
 	})
@@ -3704,6 +5205,18 @@ func HandlerWithOptions(si ServerInterface, options ChiServerOptions) http.Handl
 	r.Group(func(r chi.Router) {
 		r.Patch(options.BaseURL+"/links/{id}", wrapper.UpdateLink)
 	})
+	r.Group(func(r chi.Router) {
+		r.Delete(options.BaseURL+"/python_environments", wrapper.PurgePythonEnvironments)
+	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/python_environments", wrapper.ListPythonEnvironments)
+	})
+	r.Group(func(r chi.Router) {
+		r.Post(options.BaseURL+"/python_environments", wrapper.PrewarmPythonEnvironment)
+	})
+	r.Group(func(r chi.Router) {
+		r.Delete(options.BaseURL+"/python_environments/{id}", wrapper.DeletePythonEnvironment)
+	})
 	r.Group(func(r chi.Router) {
 		r.Get(options.BaseURL+"/reactions", wrapper.ListReactions)
 	})
@@ -3719,6 +5232,36 @@ func HandlerWithOptions(si ServerInterface, options ChiServerOptions) http.Handl
 	r.Group(func(r chi.Router) {
 		r.Patch(options.BaseURL+"/reactions/{id}", wrapper.UpdateReaction)
 	})
+	r.Group(func(r chi.Router) {
+		r.Post(options.BaseURL+"/reactions/{id}/run", wrapper.RunReaction)
+	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/reactions/{id}/runs", wrapper.ListReactionRuns)
+	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/reactions/{id}/runs/{runId}", wrapper.GetReactionRun)
+	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/secrets", wrapper.ListSecrets)
+	})
+	r.Group(func(r chi.Router) {
+		r.Post(options.BaseURL+"/secrets", wrapper.CreateSecret)
+	})
+	r.Group(func(r chi.Router) {
+		r.Delete(options.BaseURL+"/secrets/{id}", wrapper.DeleteSecret)
+	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/secrets/{id}", wrapper.GetSecret)
+	})
+	r.Group(func(r chi.Router) {
+		r.Patch(options.BaseURL+"/secrets/{id}", wrapper.UpdateSecret)
+	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/sessions", wrapper.ListSessions)
+	})
+	r.Group(func(r chi.Router) {
+		r.Delete(options.BaseURL+"/sessions/{id}", wrapper.DeleteSession)
+	})
 	r.Group(func(r chi.Router) {
 		r.Get(options.BaseURL+"/settings", wrapper.GetSettings)
 	})
@@ -3776,6 +5319,15 @@ func HandlerWithOptions(si ServerInterface, options ChiServerOptions) http.Handl
 	r.Group(func(r chi.Router) {
 		r.Patch(options.BaseURL+"/timeline/{id}", wrapper.UpdateTimeline)
 	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/tokens", wrapper.ListApiTokens)
+	})
+	r.Group(func(r chi.Router) {
+		r.Post(options.BaseURL+"/tokens", wrapper.CreateApiToken)
+	})
+	r.Group(func(r chi.Router) {
+		r.Delete(options.BaseURL+"/tokens/{id}", wrapper.DeleteApiToken)
+	})
 	r.Group(func(r chi.Router) {
 		r.Get(options.BaseURL+"/types", wrapper.ListTypes)
 	})
@@ -3818,6 +5370,24 @@ func HandlerWithOptions(si ServerInterface, options ChiServerOptions) http.Handl
 	r.Group(func(r chi.Router) {
 		r.Get(options.BaseURL+"/users/{id}/permissions", wrapper.ListUserPermissions)
 	})
+	r.Group(func(r chi.Router) {
+		r.Delete(options.BaseURL+"/users/{id}/sessions", wrapper.DeleteUserSessions)
+	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/users/{id}/sessions", wrapper.ListUserSessions)
+	})
+	r.Group(func(r chi.Router) {
+		r.Delete(options.BaseURL+"/users/{id}/sessions/{sessionId}", wrapper.DeleteUserSession)
+	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/users/{id}/tokens", wrapper.ListUserApiTokens)
+	})
+	r.Group(func(r chi.Router) {
+		r.Post(options.BaseURL+"/users/{id}/tokens", wrapper.CreateUserApiToken)
+	})
+	r.Group(func(r chi.Router) {
+		r.Delete(options.BaseURL+"/users/{id}/tokens/{tokenId}", wrapper.DeleteUserApiToken)
+	})
 	r.Group(func(r chi.Router) {
 		r.Get(options.BaseURL+"/webhooks", wrapper.ListWebhooks)
 	})
@@ -3833,10 +5403,39 @@ func HandlerWithOptions(si ServerInterface, options ChiServerOptions) http.Handl
 	r.Group(func(r chi.Router) {
 		r.Patch(options.BaseURL+"/webhooks/{id}", wrapper.UpdateWebhook)
 	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/webhooks/{id}/deliveries", wrapper.ListWebhookDeliveries)
+	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/webhooks/{id}/deliveries/{deliveryId}", wrapper.GetWebhookDelivery)
+	})
+	r.Group(func(r chi.Router) {
+		r.Post(options.BaseURL+"/webhooks/{id}/deliveries/{deliveryId}/redeliver", wrapper.RedeliverWebhookDelivery)
+	})
+	r.Group(func(r chi.Router) {
+		r.Post(options.BaseURL+"/webhooks/{id}/rotate_secret", wrapper.RotateWebhookSecret)
+	})
 
 	return r
 }
 
+type ListChangesRequestObject struct {
+	Params ListChangesParams
+}
+
+type ListChangesResponseObject interface {
+	VisitListChangesResponse(w http.ResponseWriter) error
+}
+
+type ListChanges200JSONResponse []Change
+
+func (response ListChanges200JSONResponse) VisitListChangesResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
 type ListCommentsRequestObject struct {
 	Params ListCommentsParams
 }
@@ -4317,67 +5916,287 @@ type DeleteLinkResponseObject interface {
 	VisitDeleteLinkResponse(w http.ResponseWriter) error
 }
 
-type DeleteLink204Response struct {
+type DeleteLink204Response struct {
+}
+
+func (response DeleteLink204Response) VisitDeleteLinkResponse(w http.ResponseWriter) error {
+	w.WriteHeader(204)
+	return nil
+}
+
+type GetLinkRequestObject struct {
+	Id string `json:"id"`
+}
+
+type GetLinkResponseObject interface {
+	VisitGetLinkResponse(w http.ResponseWriter) error
+}
+
+type GetLink200JSONResponse Link
+
+func (response GetLink200JSONResponse) VisitGetLinkResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type UpdateLinkRequestObject struct {
+	Id   string `json:"id"`
+	Body *UpdateLinkJSONRequestBody
+}
+
+type UpdateLinkResponseObject interface {
+	VisitUpdateLinkResponse(w http.ResponseWriter) error
+}
+
+type UpdateLink200JSONResponse Link
+
+func (response UpdateLink200JSONResponse) VisitUpdateLinkResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PurgePythonEnvironmentsRequestObject struct {
+}
+
+type PurgePythonEnvironmentsResponseObject interface {
+	VisitPurgePythonEnvironmentsResponse(w http.ResponseWriter) error
+}
+
+type PurgePythonEnvironments204Response struct {
+}
+
+func (response PurgePythonEnvironments204Response) VisitPurgePythonEnvironmentsResponse(w http.ResponseWriter) error {
+	w.WriteHeader(204)
+	return nil
+}
+
+type ListPythonEnvironmentsRequestObject struct {
+}
+
+type ListPythonEnvironmentsResponseObject interface {
+	VisitListPythonEnvironmentsResponse(w http.ResponseWriter) error
+}
+
+type ListPythonEnvironments200JSONResponse []PythonEnvironment
+
+func (response ListPythonEnvironments200JSONResponse) VisitListPythonEnvironmentsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PrewarmPythonEnvironmentRequestObject struct {
+	Body *PrewarmPythonEnvironmentJSONRequestBody
+}
+
+type PrewarmPythonEnvironmentResponseObject interface {
+	VisitPrewarmPythonEnvironmentResponse(w http.ResponseWriter) error
+}
+
+type PrewarmPythonEnvironment200JSONResponse PythonEnvironment
+
+func (response PrewarmPythonEnvironment200JSONResponse) VisitPrewarmPythonEnvironmentResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type DeletePythonEnvironmentRequestObject struct {
+	Id string `json:"id"`
+}
+
+type DeletePythonEnvironmentResponseObject interface {
+	VisitDeletePythonEnvironmentResponse(w http.ResponseWriter) error
+}
+
+type DeletePythonEnvironment204Response struct {
+}
+
+func (response DeletePythonEnvironment204Response) VisitDeletePythonEnvironmentResponse(w http.ResponseWriter) error {
+	w.WriteHeader(204)
+	return nil
+}
+
+type ListReactionsRequestObject struct {
+	Params ListReactionsParams
+}
+
+type ListReactionsResponseObject interface {
+	VisitListReactionsResponse(w http.ResponseWriter) error
+}
+
+type ListReactions200ResponseHeaders struct {
+	XTotalCount int
+}
+
+type ListReactions200JSONResponse struct {
+	Body    []Reaction
+	Headers ListReactions200ResponseHeaders
+}
+
+func (response ListReactions200JSONResponse) VisitListReactionsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.Header().Set("X-Total-Count", fmt.Sprint(response.Headers.XTotalCount))
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response.Body)
+}
+
+type CreateReactionRequestObject struct {
+	Body *CreateReactionJSONRequestBody
+}
+
+type CreateReactionResponseObject interface {
+	VisitCreateReactionResponse(w http.ResponseWriter) error
+}
+
+type CreateReaction200JSONResponse Reaction
+
+func (response CreateReaction200JSONResponse) VisitCreateReactionResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type DeleteReactionRequestObject struct {
+	Id string `json:"id"`
+}
+
+type DeleteReactionResponseObject interface {
+	VisitDeleteReactionResponse(w http.ResponseWriter) error
+}
+
+type DeleteReaction204Response struct {
+}
+
+func (response DeleteReaction204Response) VisitDeleteReactionResponse(w http.ResponseWriter) error {
+	w.WriteHeader(204)
+	return nil
+}
+
+type GetReactionRequestObject struct {
+	Id string `json:"id"`
+}
+
+type GetReactionResponseObject interface {
+	VisitGetReactionResponse(w http.ResponseWriter) error
+}
+
+type GetReaction200JSONResponse Reaction
+
+func (response GetReaction200JSONResponse) VisitGetReactionResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type UpdateReactionRequestObject struct {
+	Id   string `json:"id"`
+	Body *UpdateReactionJSONRequestBody
+}
+
+type UpdateReactionResponseObject interface {
+	VisitUpdateReactionResponse(w http.ResponseWriter) error
+}
+
+type UpdateReaction200JSONResponse Reaction
+
+func (response UpdateReaction200JSONResponse) VisitUpdateReactionResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type RunReactionRequestObject struct {
+	Id     string `json:"id"`
+	Params RunReactionParams
+	Body   *RunReactionJSONRequestBody
+}
+
+type RunReactionResponseObject interface {
+	VisitRunReactionResponse(w http.ResponseWriter) error
+}
+
+type RunReaction200JSONResponse ReactionRunResult
+
+func (response RunReaction200JSONResponse) VisitRunReactionResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
 }
 
-func (response DeleteLink204Response) VisitDeleteLinkResponse(w http.ResponseWriter) error {
-	w.WriteHeader(204)
-	return nil
+type ListReactionRunsRequestObject struct {
+	Id     string `json:"id"`
+	Params ListReactionRunsParams
 }
 
-type GetLinkRequestObject struct {
-	Id string `json:"id"`
+type ListReactionRunsResponseObject interface {
+	VisitListReactionRunsResponse(w http.ResponseWriter) error
 }
 
-type GetLinkResponseObject interface {
-	VisitGetLinkResponse(w http.ResponseWriter) error
+type ListReactionRuns200ResponseHeaders struct {
+	XTotalCount int
 }
 
-type GetLink200JSONResponse Link
+type ListReactionRuns200JSONResponse struct {
+	Body    []ReactionRun
+	Headers ListReactionRuns200ResponseHeaders
+}
 
-func (response GetLink200JSONResponse) VisitGetLinkResponse(w http.ResponseWriter) error {
+func (response ListReactionRuns200JSONResponse) VisitListReactionRunsResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
+	w.Header().Set("X-Total-Count", fmt.Sprint(response.Headers.XTotalCount))
 	w.WriteHeader(200)
 
-	return json.NewEncoder(w).Encode(response)
+	return json.NewEncoder(w).Encode(response.Body)
 }
 
-type UpdateLinkRequestObject struct {
-	Id   string `json:"id"`
-	Body *UpdateLinkJSONRequestBody
+type GetReactionRunRequestObject struct {
+	Id    string `json:"id"`
+	RunId string `json:"runId"`
 }
 
-type UpdateLinkResponseObject interface {
-	VisitUpdateLinkResponse(w http.ResponseWriter) error
+type GetReactionRunResponseObject interface {
+	VisitGetReactionRunResponse(w http.ResponseWriter) error
 }
 
-type UpdateLink200JSONResponse Link
+type GetReactionRun200JSONResponse ReactionRun
 
-func (response UpdateLink200JSONResponse) VisitUpdateLinkResponse(w http.ResponseWriter) error {
+func (response GetReactionRun200JSONResponse) VisitGetReactionRunResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(200)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type ListReactionsRequestObject struct {
-	Params ListReactionsParams
+type ListSecretsRequestObject struct {
+	Params ListSecretsParams
 }
 
-type ListReactionsResponseObject interface {
-	VisitListReactionsResponse(w http.ResponseWriter) error
+type ListSecretsResponseObject interface {
+	VisitListSecretsResponse(w http.ResponseWriter) error
 }
 
-type ListReactions200ResponseHeaders struct {
+type ListSecrets200ResponseHeaders struct {
 	XTotalCount int
 }
 
-type ListReactions200JSONResponse struct {
-	Body    []Reaction
-	Headers ListReactions200ResponseHeaders
+type ListSecrets200JSONResponse struct {
+	Body    []Secret
+	Headers ListSecrets200ResponseHeaders
 }
 
-func (response ListReactions200JSONResponse) VisitListReactionsResponse(w http.ResponseWriter) error {
+func (response ListSecrets200JSONResponse) VisitListSecretsResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.Header().Set("X-Total-Count", fmt.Sprint(response.Headers.XTotalCount))
 	w.WriteHeader(200)
@@ -4385,74 +6204,115 @@ func (response ListReactions200JSONResponse) VisitListReactionsResponse(w http.R
 	return json.NewEncoder(w).Encode(response.Body)
 }
 
-type CreateReactionRequestObject struct {
-	Body *CreateReactionJSONRequestBody
+type CreateSecretRequestObject struct {
+	Body *CreateSecretJSONRequestBody
 }
 
-type CreateReactionResponseObject interface {
-	VisitCreateReactionResponse(w http.ResponseWriter) error
+type CreateSecretResponseObject interface {
+	VisitCreateSecretResponse(w http.ResponseWriter) error
 }
 
-type CreateReaction200JSONResponse Reaction
+type CreateSecret200JSONResponse Secret
 
-func (response CreateReaction200JSONResponse) VisitCreateReactionResponse(w http.ResponseWriter) error {
+func (response CreateSecret200JSONResponse) VisitCreateSecretResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(200)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type DeleteReactionRequestObject struct {
+type DeleteSecretRequestObject struct {
 	Id string `json:"id"`
 }
 
-type DeleteReactionResponseObject interface {
-	VisitDeleteReactionResponse(w http.ResponseWriter) error
+type DeleteSecretResponseObject interface {
+	VisitDeleteSecretResponse(w http.ResponseWriter) error
 }
 
-type DeleteReaction204Response struct {
+type DeleteSecret204Response struct {
 }
 
-func (response DeleteReaction204Response) VisitDeleteReactionResponse(w http.ResponseWriter) error {
+func (response DeleteSecret204Response) VisitDeleteSecretResponse(w http.ResponseWriter) error {
 	w.WriteHeader(204)
 	return nil
 }
 
-type GetReactionRequestObject struct {
+type GetSecretRequestObject struct {
 	Id string `json:"id"`
 }
 
-type GetReactionResponseObject interface {
-	VisitGetReactionResponse(w http.ResponseWriter) error
+type GetSecretResponseObject interface {
+	VisitGetSecretResponse(w http.ResponseWriter) error
 }
 
-type GetReaction200JSONResponse Reaction
+type GetSecret200JSONResponse Secret
 
-func (response GetReaction200JSONResponse) VisitGetReactionResponse(w http.ResponseWriter) error {
+func (response GetSecret200JSONResponse) VisitGetSecretResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(200)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type UpdateReactionRequestObject struct {
+type UpdateSecretRequestObject struct {
 	Id   string `json:"id"`
-	Body *UpdateReactionJSONRequestBody
+	Body *UpdateSecretJSONRequestBody
 }
 
-type UpdateReactionResponseObject interface {
-	VisitUpdateReactionResponse(w http.ResponseWriter) error
+type UpdateSecretResponseObject interface {
+	VisitUpdateSecretResponse(w http.ResponseWriter) error
 }
 
-type UpdateReaction200JSONResponse Reaction
+type UpdateSecret200JSONResponse Secret
 
-func (response UpdateReaction200JSONResponse) VisitUpdateReactionResponse(w http.ResponseWriter) error {
+func (response UpdateSecret200JSONResponse) VisitUpdateSecretResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(200)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
+type ListSessionsRequestObject struct {
+	Params ListSessionsParams
+}
+
+type ListSessionsResponseObject interface {
+	VisitListSessionsResponse(w http.ResponseWriter) error
+}
+
+type ListSessions200ResponseHeaders struct {
+	XTotalCount int
+}
+
+type ListSessions200JSONResponse struct {
+	Body    []Session
+	Headers ListSessions200ResponseHeaders
+}
+
+func (response ListSessions200JSONResponse) VisitListSessionsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.Header().Set("X-Total-Count", fmt.Sprint(response.Headers.XTotalCount))
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response.Body)
+}
+
+type DeleteSessionRequestObject struct {
+	Id string `json:"id"`
+}
+
+type DeleteSessionResponseObject interface {
+	VisitDeleteSessionResponse(w http.ResponseWriter) error
+}
+
+type DeleteSession204Response struct {
+}
+
+func (response DeleteSession204Response) VisitDeleteSessionResponse(w http.ResponseWriter) error {
+	w.WriteHeader(204)
+	return nil
+}
+
 type GetSettingsRequestObject struct {
 }
 
@@ -4806,6 +6666,64 @@ func (response UpdateTimeline200JSONResponse) VisitUpdateTimelineResponse(w http
 	return json.NewEncoder(w).Encode(response)
 }
 
+type ListApiTokensRequestObject struct {
+	Params ListApiTokensParams
+}
+
+type ListApiTokensResponseObject interface {
+	VisitListApiTokensResponse(w http.ResponseWriter) error
+}
+
+type ListApiTokens200ResponseHeaders struct {
+	XTotalCount int
+}
+
+type ListApiTokens200JSONResponse struct {
+	Body    []ApiToken
+	Headers ListApiTokens200ResponseHeaders
+}
+
+func (response ListApiTokens200JSONResponse) VisitListApiTokensResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.Header().Set("X-Total-Count", fmt.Sprint(response.Headers.XTotalCount))
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response.Body)
+}
+
+type CreateApiTokenRequestObject struct {
+	Body *CreateApiTokenJSONRequestBody
+}
+
+type CreateApiTokenResponseObject interface {
+	VisitCreateApiTokenResponse(w http.ResponseWriter) error
+}
+
+type CreateApiToken200JSONResponse ApiToken
+
+func (response CreateApiToken200JSONResponse) VisitCreateApiTokenResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type DeleteApiTokenRequestObject struct {
+	Id string `json:"id"`
+}
+
+type DeleteApiTokenResponseObject interface {
+	VisitDeleteApiTokenResponse(w http.ResponseWriter) error
+}
+
+type DeleteApiToken204Response struct {
+}
+
+func (response DeleteApiToken204Response) VisitDeleteApiTokenResponse(w http.ResponseWriter) error {
+	w.WriteHeader(204)
+	return nil
+}
+
 type ListTypesRequestObject struct {
 	Params ListTypesParams
 }
@@ -5060,6 +6978,126 @@ func (response ListUserPermissions200JSONResponse) VisitListUserPermissionsRespo
 	return json.NewEncoder(w).Encode(response)
 }
 
+type DeleteUserSessionsRequestObject struct {
+	Id string `json:"id"`
+}
+
+type DeleteUserSessionsResponseObject interface {
+	VisitDeleteUserSessionsResponse(w http.ResponseWriter) error
+}
+
+type DeleteUserSessions204Response struct {
+}
+
+func (response DeleteUserSessions204Response) VisitDeleteUserSessionsResponse(w http.ResponseWriter) error {
+	w.WriteHeader(204)
+	return nil
+}
+
+type ListUserSessionsRequestObject struct {
+	Id     string `json:"id"`
+	Params ListUserSessionsParams
+}
+
+type ListUserSessionsResponseObject interface {
+	VisitListUserSessionsResponse(w http.ResponseWriter) error
+}
+
+type ListUserSessions200ResponseHeaders struct {
+	XTotalCount int
+}
+
+type ListUserSessions200JSONResponse struct {
+	Body    []Session
+	Headers ListUserSessions200ResponseHeaders
+}
+
+func (response ListUserSessions200JSONResponse) VisitListUserSessionsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.Header().Set("X-Total-Count", fmt.Sprint(response.Headers.XTotalCount))
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response.Body)
+}
+
+type DeleteUserSessionRequestObject struct {
+	Id        string `json:"id"`
+	SessionId string `json:"sessionId"`
+}
+
+type DeleteUserSessionResponseObject interface {
+	VisitDeleteUserSessionResponse(w http.ResponseWriter) error
+}
+
+type DeleteUserSession204Response struct {
+}
+
+func (response DeleteUserSession204Response) VisitDeleteUserSessionResponse(w http.ResponseWriter) error {
+	w.WriteHeader(204)
+	return nil
+}
+
+type ListUserApiTokensRequestObject struct {
+	Id     string `json:"id"`
+	Params ListUserApiTokensParams
+}
+
+type ListUserApiTokensResponseObject interface {
+	VisitListUserApiTokensResponse(w http.ResponseWriter) error
+}
+
+type ListUserApiTokens200ResponseHeaders struct {
+	XTotalCount int
+}
+
+type ListUserApiTokens200JSONResponse struct {
+	Body    []ApiToken
+	Headers ListUserApiTokens200ResponseHeaders
+}
+
+func (response ListUserApiTokens200JSONResponse) VisitListUserApiTokensResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.Header().Set("X-Total-Count", fmt.Sprint(response.Headers.XTotalCount))
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response.Body)
+}
+
+type CreateUserApiTokenRequestObject struct {
+	Id   string `json:"id"`
+	Body *CreateUserApiTokenJSONRequestBody
+}
+
+type CreateUserApiTokenResponseObject interface {
+	VisitCreateUserApiTokenResponse(w http.ResponseWriter) error
+}
+
+type CreateUserApiToken200JSONResponse ApiToken
+
+func (response CreateUserApiToken200JSONResponse) VisitCreateUserApiTokenResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type DeleteUserApiTokenRequestObject struct {
+	Id      string `json:"id"`
+	TokenId string `json:"tokenId"`
+}
+
+type DeleteUserApiTokenResponseObject interface {
+	VisitDeleteUserApiTokenResponse(w http.ResponseWriter) error
+}
+
+type DeleteUserApiToken204Response struct {
+}
+
+func (response DeleteUserApiToken204Response) VisitDeleteUserApiTokenResponse(w http.ResponseWriter) error {
+	w.WriteHeader(204)
+	return nil
+}
+
 type ListWebhooksRequestObject struct {
 	Params ListWebhooksParams
 }
@@ -5118,35 +7156,114 @@ func (response DeleteWebhook204Response) VisitDeleteWebhookResponse(w http.Respo
 	return nil
 }
 
-type GetWebhookRequestObject struct {
-	Id string `json:"id"`
+type GetWebhookRequestObject struct {
+	Id string `json:"id"`
+}
+
+type GetWebhookResponseObject interface {
+	VisitGetWebhookResponse(w http.ResponseWriter) error
+}
+
+type GetWebhook200JSONResponse Webhook
+
+func (response GetWebhook200JSONResponse) VisitGetWebhookResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type UpdateWebhookRequestObject struct {
+	Id   string `json:"id"`
+	Body *UpdateWebhookJSONRequestBody
+}
+
+type UpdateWebhookResponseObject interface {
+	VisitUpdateWebhookResponse(w http.ResponseWriter) error
+}
+
+type UpdateWebhook200JSONResponse Webhook
+
+func (response UpdateWebhook200JSONResponse) VisitUpdateWebhookResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ListWebhookDeliveriesRequestObject struct {
+	Id     string `json:"id"`
+	Params ListWebhookDeliveriesParams
+}
+
+type ListWebhookDeliveriesResponseObject interface {
+	VisitListWebhookDeliveriesResponse(w http.ResponseWriter) error
+}
+
+type ListWebhookDeliveries200ResponseHeaders struct {
+	XTotalCount int
+}
+
+type ListWebhookDeliveries200JSONResponse struct {
+	Body    []WebhookDelivery
+	Headers ListWebhookDeliveries200ResponseHeaders
+}
+
+func (response ListWebhookDeliveries200JSONResponse) VisitListWebhookDeliveriesResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.Header().Set("X-Total-Count", fmt.Sprint(response.Headers.XTotalCount))
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response.Body)
+}
+
+type GetWebhookDeliveryRequestObject struct {
+	Id         string `json:"id"`
+	DeliveryId string `json:"deliveryId"`
+}
+
+type GetWebhookDeliveryResponseObject interface {
+	VisitGetWebhookDeliveryResponse(w http.ResponseWriter) error
+}
+
+type GetWebhookDelivery200JSONResponse WebhookDelivery
+
+func (response GetWebhookDelivery200JSONResponse) VisitGetWebhookDeliveryResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type RedeliverWebhookDeliveryRequestObject struct {
+	Id         string `json:"id"`
+	DeliveryId string `json:"deliveryId"`
 }
 
-type GetWebhookResponseObject interface {
-	VisitGetWebhookResponse(w http.ResponseWriter) error
+type RedeliverWebhookDeliveryResponseObject interface {
+	VisitRedeliverWebhookDeliveryResponse(w http.ResponseWriter) error
 }
 
-type GetWebhook200JSONResponse Webhook
+type RedeliverWebhookDelivery200JSONResponse WebhookDelivery
 
-func (response GetWebhook200JSONResponse) VisitGetWebhookResponse(w http.ResponseWriter) error {
+func (response RedeliverWebhookDelivery200JSONResponse) VisitRedeliverWebhookDeliveryResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(200)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type UpdateWebhookRequestObject struct {
-	Id   string `json:"id"`
-	Body *UpdateWebhookJSONRequestBody
+type RotateWebhookSecretRequestObject struct {
+	Id string `json:"id"`
 }
 
-type UpdateWebhookResponseObject interface {
-	VisitUpdateWebhookResponse(w http.ResponseWriter) error
+type RotateWebhookSecretResponseObject interface {
+	VisitRotateWebhookSecretResponse(w http.ResponseWriter) error
 }
 
-type UpdateWebhook200JSONResponse Webhook
+type RotateWebhookSecret200JSONResponse Webhook
 
-func (response UpdateWebhook200JSONResponse) VisitUpdateWebhookResponse(w http.ResponseWriter) error {
+func (response RotateWebhookSecret200JSONResponse) VisitRotateWebhookSecretResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(200)
 
@@ -5155,6 +7272,9 @@ func (response UpdateWebhook200JSONResponse) VisitUpdateWebhookResponse(w http.R
 
 // StrictServerInterface represents all server handlers.
 type StrictServerInterface interface {
+	// List the changes of all collections after a sequence number
+	// (GET /changes)
+	ListChanges(ctx context.Context, request ListChangesRequestObject) (ListChangesResponseObject, error)
 	// List all comments
 	// (GET /comments)
 	ListComments(ctx context.Context, request ListCommentsRequestObject) (ListCommentsResponseObject, error)
@@ -5239,6 +7359,18 @@ type StrictServerInterface interface {
 	// Update a link by ID
 	// (PATCH /links/{id})
 	UpdateLink(ctx context.Context, request UpdateLinkRequestObject) (UpdateLinkResponseObject, error)
+	// Remove all cached Python environments
+	// (DELETE /python_environments)
+	PurgePythonEnvironments(ctx context.Context, request PurgePythonEnvironmentsRequestObject) (PurgePythonEnvironmentsResponseObject, error)
+	// List all cached Python environments
+	// (GET /python_environments)
+	ListPythonEnvironments(ctx context.Context, request ListPythonEnvironmentsRequestObject) (ListPythonEnvironmentsResponseObject, error)
+	// Create a Python environment for the given requirements in advance
+	// (POST /python_environments)
+	PrewarmPythonEnvironment(ctx context.Context, request PrewarmPythonEnvironmentRequestObject) (PrewarmPythonEnvironmentResponseObject, error)
+	// Remove a cached Python environment by ID
+	// (DELETE /python_environments/{id})
+	DeletePythonEnvironment(ctx context.Context, request DeletePythonEnvironmentRequestObject) (DeletePythonEnvironmentResponseObject, error)
 	// List all reactions
 	// (GET /reactions)
 	ListReactions(ctx context.Context, request ListReactionsRequestObject) (ListReactionsResponseObject, error)
@@ -5254,6 +7386,36 @@ type StrictServerInterface interface {
 	// Update a reaction by ID
 	// (PATCH /reactions/{id})
 	UpdateReaction(ctx context.Context, request UpdateReactionRequestObject) (UpdateReactionResponseObject, error)
+	// Run a reaction with the given payload
+	// (POST /reactions/{id}/run)
+	RunReaction(ctx context.Context, request RunReactionRequestObject) (RunReactionResponseObject, error)
+	// List all runs of a reaction
+	// (GET /reactions/{id}/runs)
+	ListReactionRuns(ctx context.Context, request ListReactionRunsRequestObject) (ListReactionRunsResponseObject, error)
+	// Get a single run of a reaction
+	// (GET /reactions/{id}/runs/{runId})
+	GetReactionRun(ctx context.Context, request GetReactionRunRequestObject) (GetReactionRunResponseObject, error)
+	// List all secrets without their values
+	// (GET /secrets)
+	ListSecrets(ctx context.Context, request ListSecretsRequestObject) (ListSecretsResponseObject, error)
+	// Create a new secret
+	// (POST /secrets)
+	CreateSecret(ctx context.Context, request CreateSecretRequestObject) (CreateSecretResponseObject, error)
+	// Delete a secret by ID
+	// (DELETE /secrets/{id})
+	DeleteSecret(ctx context.Context, request DeleteSecretRequestObject) (DeleteSecretResponseObject, error)
+	// Get a single secret by ID without its value
+	// (GET /secrets/{id})
+	GetSecret(ctx context.Context, request GetSecretRequestObject) (GetSecretResponseObject, error)
+	// Update a secret by ID
+	// (PATCH /secrets/{id})
+	UpdateSecret(ctx context.Context, request UpdateSecretRequestObject) (UpdateSecretResponseObject, error)
+	// List the login sessions of the current user
+	// (GET /sessions)
+	ListSessions(ctx context.Context, request ListSessionsRequestObject) (ListSessionsResponseObject, error)
+	// Revoke a login session of the current user
+	// (DELETE /sessions/{id})
+	DeleteSession(ctx context.Context, request DeleteSessionRequestObject) (DeleteSessionResponseObject, error)
 	// Get system settings
 	// (GET /settings)
 	GetSettings(ctx context.Context, request GetSettingsRequestObject) (GetSettingsResponseObject, error)
@@ -5311,6 +7473,15 @@ type StrictServerInterface interface {
 	// Update a timeline item by ID
 	// (PATCH /timeline/{id})
 	UpdateTimeline(ctx context.Context, request UpdateTimelineRequestObject) (UpdateTimelineResponseObject, error)
+	// List the API tokens of the current user
+	// (GET /tokens)
+	ListApiTokens(ctx context.Context, request ListApiTokensRequestObject) (ListApiTokensResponseObject, error)
+	// Create an API token for the current user
+	// (POST /tokens)
+	CreateApiToken(ctx context.Context, request CreateApiTokenRequestObject) (CreateApiTokenResponseObject, error)
+	// Revoke an API token of the current user
+	// (DELETE /tokens/{id})
+	DeleteApiToken(ctx context.Context, request DeleteApiTokenRequestObject) (DeleteApiTokenResponseObject, error)
 	// List all types
 	// (GET /types)
 	ListTypes(ctx context.Context, request ListTypesRequestObject) (ListTypesResponseObject, error)
@@ -5353,6 +7524,24 @@ type StrictServerInterface interface {
 	// List all permissions for a user
 	// (GET /users/{id}/permissions)
 	ListUserPermissions(ctx context.Context, request ListUserPermissionsRequestObject) (ListUserPermissionsResponseObject, error)
+	// Revoke all login sessions of a user
+	// (DELETE /users/{id}/sessions)
+	DeleteUserSessions(ctx context.Context, request DeleteUserSessionsRequestObject) (DeleteUserSessionsResponseObject, error)
+	// List the login sessions of a user
+	// (GET /users/{id}/sessions)
+	ListUserSessions(ctx context.Context, request ListUserSessionsRequestObject) (ListUserSessionsResponseObject, error)
+	// Revoke a login session of a user
+	// (DELETE /users/{id}/sessions/{sessionId})
+	DeleteUserSession(ctx context.Context, request DeleteUserSessionRequestObject) (DeleteUserSessionResponseObject, error)
+	// List the API tokens of a user
+	// (GET /users/{id}/tokens)
+	ListUserApiTokens(ctx context.Context, request ListUserApiTokensRequestObject) (ListUserApiTokensResponseObject, error)
+	// Create an API token for a user
+	// (POST /users/{id}/tokens)
+	CreateUserApiToken(ctx context.Context, request CreateUserApiTokenRequestObject) (CreateUserApiTokenResponseObject, error)
+	// Revoke an API token of a user
+	// (DELETE /users/{id}/tokens/{tokenId})
+	DeleteUserApiToken(ctx context.Context, request DeleteUserApiTokenRequestObject) (DeleteUserApiTokenResponseObject, error)
 	// List all webhooks
 	// (GET /webhooks)
 	ListWebhooks(ctx context.Context, request ListWebhooksRequestObject) (ListWebhooksResponseObject, error)
@@ -5368,56 +7557,419 @@ type StrictServerInterface interface {
 	// Update a webhook by ID
 	// (PATCH /webhooks/{id})
 	UpdateWebhook(ctx context.Context, request UpdateWebhookRequestObject) (UpdateWebhookResponseObject, error)
+	// List all deliveries of a webhook
+	// (GET /webhooks/{id}/deliveries)
+	ListWebhookDeliveries(ctx context.Context, request ListWebhookDeliveriesRequestObject) (ListWebhookDeliveriesResponseObject, error)
+	// Get a single delivery of a webhook
+	// (GET /webhooks/{id}/deliveries/{deliveryId})
+	GetWebhookDelivery(ctx context.Context, request GetWebhookDeliveryRequestObject) (GetWebhookDeliveryResponseObject, error)
+	// Send the payload of a delivery again
+	// (POST /webhooks/{id}/deliveries/{deliveryId}/redeliver)
+	RedeliverWebhookDelivery(ctx context.Context, request RedeliverWebhookDeliveryRequestObject) (RedeliverWebhookDeliveryResponseObject, error)
+	// Replace the signing secret of a webhook
+	// (POST /webhooks/{id}/rotate_secret)
+	RotateWebhookSecret(ctx context.Context, request RotateWebhookSecretRequestObject) (RotateWebhookSecretResponseObject, error)
+}
+
+type StrictHandlerFunc = strictnethttp.StrictHTTPHandlerFunc
+type StrictMiddlewareFunc = strictnethttp.StrictHTTPMiddlewareFunc
+
+type StrictHTTPServerOptions struct {
+	RequestErrorHandlerFunc  func(w http.ResponseWriter, r *http.Request, err error)
+	ResponseErrorHandlerFunc func(w http.ResponseWriter, r *http.Request, err error)
+}
+
+func NewStrictHandler(ssi StrictServerInterface, middlewares []StrictMiddlewareFunc) ServerInterface {
+	return &strictHandler{ssi: ssi, middlewares: middlewares, options: StrictHTTPServerOptions{
+		RequestErrorHandlerFunc: func(w http.ResponseWriter, r *http.Request, err error) {
+			http.Error(w, err.Error(), http.StatusBadRequest)
+		},
+		ResponseErrorHandlerFunc: func(w http.ResponseWriter, r *http.Request, err error) {
+			http.Error(w, err.Error(), http.StatusInternalServerError)
+		},
+	}}
+}
+
+func NewStrictHandlerWithOptions(ssi StrictServerInterface, middlewares []StrictMiddlewareFunc, options StrictHTTPServerOptions) ServerInterface {
+	return &strictHandler{ssi: ssi, middlewares: middlewares, options: options}
+}
+
+type strictHandler struct {
+	ssi         StrictServerInterface
+	middlewares []StrictMiddlewareFunc
+	options     StrictHTTPServerOptions
+}
+
+// ListChanges operation middleware
+func (sh *strictHandler) ListChanges(w http.ResponseWriter, r *http.Request, params ListChangesParams) {
+	var request ListChangesRequestObject
+
+	request.Params = params
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.ListChanges(ctx, request.(ListChangesRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "ListChanges")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(ListChangesResponseObject); ok {
+		if err := validResponse.VisitListChangesResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// ListComments operation middleware
+func (sh *strictHandler) ListComments(w http.ResponseWriter, r *http.Request, params ListCommentsParams) {
+	var request ListCommentsRequestObject
+
+	request.Params = params
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.ListComments(ctx, request.(ListCommentsRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "ListComments")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(ListCommentsResponseObject); ok {
+		if err := validResponse.VisitListCommentsResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// CreateComment operation middleware
+func (sh *strictHandler) CreateComment(w http.ResponseWriter, r *http.Request) {
+	var request CreateCommentRequestObject
+
+	var body CreateCommentJSONRequestBody
+	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+		sh.options.RequestErrorHandlerFunc(w, r, fmt.Errorf("can't decode JSON body: %w", err))
+		return
+	}
+	request.Body = &body
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.CreateComment(ctx, request.(CreateCommentRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "CreateComment")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(CreateCommentResponseObject); ok {
+		if err := validResponse.VisitCreateCommentResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// DeleteComment operation middleware
+func (sh *strictHandler) DeleteComment(w http.ResponseWriter, r *http.Request, id string) {
+	var request DeleteCommentRequestObject
+
+	request.Id = id
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.DeleteComment(ctx, request.(DeleteCommentRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "DeleteComment")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(DeleteCommentResponseObject); ok {
+		if err := validResponse.VisitDeleteCommentResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// GetComment operation middleware
+func (sh *strictHandler) GetComment(w http.ResponseWriter, r *http.Request, id string) {
+	var request GetCommentRequestObject
+
+	request.Id = id
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.GetComment(ctx, request.(GetCommentRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "GetComment")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(GetCommentResponseObject); ok {
+		if err := validResponse.VisitGetCommentResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// UpdateComment operation middleware
+func (sh *strictHandler) UpdateComment(w http.ResponseWriter, r *http.Request, id string) {
+	var request UpdateCommentRequestObject
+
+	request.Id = id
+
+	var body UpdateCommentJSONRequestBody
+	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+		sh.options.RequestErrorHandlerFunc(w, r, fmt.Errorf("can't decode JSON body: %w", err))
+		return
+	}
+	request.Body = &body
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.UpdateComment(ctx, request.(UpdateCommentRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "UpdateComment")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(UpdateCommentResponseObject); ok {
+		if err := validResponse.VisitUpdateCommentResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// GetConfig operation middleware
+func (sh *strictHandler) GetConfig(w http.ResponseWriter, r *http.Request) {
+	var request GetConfigRequestObject
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.GetConfig(ctx, request.(GetConfigRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "GetConfig")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(GetConfigResponseObject); ok {
+		if err := validResponse.VisitGetConfigResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// GetDashboardCounts operation middleware
+func (sh *strictHandler) GetDashboardCounts(w http.ResponseWriter, r *http.Request) {
+	var request GetDashboardCountsRequestObject
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.GetDashboardCounts(ctx, request.(GetDashboardCountsRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "GetDashboardCounts")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(GetDashboardCountsResponseObject); ok {
+		if err := validResponse.VisitGetDashboardCountsResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// ListFiles operation middleware
+func (sh *strictHandler) ListFiles(w http.ResponseWriter, r *http.Request, params ListFilesParams) {
+	var request ListFilesRequestObject
+
+	request.Params = params
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.ListFiles(ctx, request.(ListFilesRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "ListFiles")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(ListFilesResponseObject); ok {
+		if err := validResponse.VisitListFilesResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
 }
 
-type StrictHandlerFunc = strictnethttp.StrictHTTPHandlerFunc
-type StrictMiddlewareFunc = strictnethttp.StrictHTTPMiddlewareFunc
+// CreateFile operation middleware
+func (sh *strictHandler) CreateFile(w http.ResponseWriter, r *http.Request) {
+	var request CreateFileRequestObject
 
-type StrictHTTPServerOptions struct {
-	RequestErrorHandlerFunc  func(w http.ResponseWriter, r *http.Request, err error)
-	ResponseErrorHandlerFunc func(w http.ResponseWriter, r *http.Request, err error)
+	var body CreateFileJSONRequestBody
+	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+		sh.options.RequestErrorHandlerFunc(w, r, fmt.Errorf("can't decode JSON body: %w", err))
+		return
+	}
+	request.Body = &body
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.CreateFile(ctx, request.(CreateFileRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "CreateFile")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(CreateFileResponseObject); ok {
+		if err := validResponse.VisitCreateFileResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
 }
 
-func NewStrictHandler(ssi StrictServerInterface, middlewares []StrictMiddlewareFunc) ServerInterface {
-	return &strictHandler{ssi: ssi, middlewares: middlewares, options: StrictHTTPServerOptions{
-		RequestErrorHandlerFunc: func(w http.ResponseWriter, r *http.Request, err error) {
-			http.Error(w, err.Error(), http.StatusBadRequest)
-		},
-		ResponseErrorHandlerFunc: func(w http.ResponseWriter, r *http.Request, err error) {
-			http.Error(w, err.Error(), http.StatusInternalServerError)
-		},
-	}}
+// DeleteFile operation middleware
+func (sh *strictHandler) DeleteFile(w http.ResponseWriter, r *http.Request, id string) {
+	var request DeleteFileRequestObject
+
+	request.Id = id
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.DeleteFile(ctx, request.(DeleteFileRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "DeleteFile")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(DeleteFileResponseObject); ok {
+		if err := validResponse.VisitDeleteFileResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
 }
 
-func NewStrictHandlerWithOptions(ssi StrictServerInterface, middlewares []StrictMiddlewareFunc, options StrictHTTPServerOptions) ServerInterface {
-	return &strictHandler{ssi: ssi, middlewares: middlewares, options: options}
+// GetFile operation middleware
+func (sh *strictHandler) GetFile(w http.ResponseWriter, r *http.Request, id string) {
+	var request GetFileRequestObject
+
+	request.Id = id
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.GetFile(ctx, request.(GetFileRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "GetFile")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(GetFileResponseObject); ok {
+		if err := validResponse.VisitGetFileResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
 }
 
-type strictHandler struct {
-	ssi         StrictServerInterface
-	middlewares []StrictMiddlewareFunc
-	options     StrictHTTPServerOptions
+// DownloadFile operation middleware
+func (sh *strictHandler) DownloadFile(w http.ResponseWriter, r *http.Request, id string) {
+	var request DownloadFileRequestObject
+
+	request.Id = id
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.DownloadFile(ctx, request.(DownloadFileRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "DownloadFile")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(DownloadFileResponseObject); ok {
+		if err := validResponse.VisitDownloadFileResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
 }
 
-// ListComments operation middleware
-func (sh *strictHandler) ListComments(w http.ResponseWriter, r *http.Request, params ListCommentsParams) {
-	var request ListCommentsRequestObject
+// ListGroups operation middleware
+func (sh *strictHandler) ListGroups(w http.ResponseWriter, r *http.Request, params ListGroupsParams) {
+	var request ListGroupsRequestObject
 
 	request.Params = params
 
 	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
-		return sh.ssi.ListComments(ctx, request.(ListCommentsRequestObject))
+		return sh.ssi.ListGroups(ctx, request.(ListGroupsRequestObject))
 	}
 	for _, middleware := range sh.middlewares {
-		handler = middleware(handler, "ListComments")
+		handler = middleware(handler, "ListGroups")
 	}
 
 	response, err := handler(r.Context(), w, r, request)
 
 	if err != nil {
 		sh.options.ResponseErrorHandlerFunc(w, r, err)
-	} else if validResponse, ok := response.(ListCommentsResponseObject); ok {
-		if err := validResponse.VisitListCommentsResponse(w); err != nil {
+	} else if validResponse, ok := response.(ListGroupsResponseObject); ok {
+		if err := validResponse.VisitListGroupsResponse(w); err != nil {
 			sh.options.ResponseErrorHandlerFunc(w, r, err)
 		}
 	} else if response != nil {
@@ -5425,11 +7977,11 @@ func (sh *strictHandler) ListComments(w http.ResponseWriter, r *http.Request, pa
 	}
 }
 
-// CreateComment operation middleware
-func (sh *strictHandler) CreateComment(w http.ResponseWriter, r *http.Request) {
-	var request CreateCommentRequestObject
+// CreateGroup operation middleware
+func (sh *strictHandler) CreateGroup(w http.ResponseWriter, r *http.Request) {
+	var request CreateGroupRequestObject
 
-	var body CreateCommentJSONRequestBody
+	var body CreateGroupJSONRequestBody
 	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
 		sh.options.RequestErrorHandlerFunc(w, r, fmt.Errorf("can't decode JSON body: %w", err))
 		return
@@ -5437,18 +7989,18 @@ func (sh *strictHandler) CreateComment(w http.ResponseWriter, r *http.Request) {
 	request.Body = &body
 
 	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
-		return sh.ssi.CreateComment(ctx, request.(CreateCommentRequestObject))
+		return sh.ssi.CreateGroup(ctx, request.(CreateGroupRequestObject))
 	}
 	for _, middleware := range sh.middlewares {
-		handler = middleware(handler, "CreateComment")
+		handler = middleware(handler, "CreateGroup")
 	}
 
 	response, err := handler(r.Context(), w, r, request)
 
 	if err != nil {
 		sh.options.ResponseErrorHandlerFunc(w, r, err)
-	} else if validResponse, ok := response.(CreateCommentResponseObject); ok {
-		if err := validResponse.VisitCreateCommentResponse(w); err != nil {
+	} else if validResponse, ok := response.(CreateGroupResponseObject); ok {
+		if err := validResponse.VisitCreateGroupResponse(w); err != nil {
 			sh.options.ResponseErrorHandlerFunc(w, r, err)
 		}
 	} else if response != nil {
@@ -5456,25 +8008,25 @@ func (sh *strictHandler) CreateComment(w http.ResponseWriter, r *http.Request) {
 	}
 }
 
-// DeleteComment operation middleware
-func (sh *strictHandler) DeleteComment(w http.ResponseWriter, r *http.Request, id string) {
-	var request DeleteCommentRequestObject
+// DeleteGroup operation middleware
+func (sh *strictHandler) DeleteGroup(w http.ResponseWriter, r *http.Request, id string) {
+	var request DeleteGroupRequestObject
 
 	request.Id = id
 
 	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
-		return sh.ssi.DeleteComment(ctx, request.(DeleteCommentRequestObject))
+		return sh.ssi.DeleteGroup(ctx, request.(DeleteGroupRequestObject))
 	}
 	for _, middleware := range sh.middlewares {
-		handler = middleware(handler, "DeleteComment")
+		handler = middleware(handler, "DeleteGroup")
 	}
 
 	response, err := handler(r.Context(), w, r, request)
 
 	if err != nil {
 		sh.options.ResponseErrorHandlerFunc(w, r, err)
-	} else if validResponse, ok := response.(DeleteCommentResponseObject); ok {
-		if err := validResponse.VisitDeleteCommentResponse(w); err != nil {
+	} else if validResponse, ok := response.(DeleteGroupResponseObject); ok {
+		if err := validResponse.VisitDeleteGroupResponse(w); err != nil {
 			sh.options.ResponseErrorHandlerFunc(w, r, err)
 		}
 	} else if response != nil {
@@ -5482,25 +8034,84 @@ func (sh *strictHandler) DeleteComment(w http.ResponseWriter, r *http.Request, i
 	}
 }
 
-// GetComment operation middleware
-func (sh *strictHandler) GetComment(w http.ResponseWriter, r *http.Request, id string) {
-	var request GetCommentRequestObject
+// GetGroup operation middleware
+func (sh *strictHandler) GetGroup(w http.ResponseWriter, r *http.Request, id string) {
+	var request GetGroupRequestObject
 
 	request.Id = id
 
 	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
-		return sh.ssi.GetComment(ctx, request.(GetCommentRequestObject))
+		return sh.ssi.GetGroup(ctx, request.(GetGroupRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "GetGroup")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(GetGroupResponseObject); ok {
+		if err := validResponse.VisitGetGroupResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// UpdateGroup operation middleware
+func (sh *strictHandler) UpdateGroup(w http.ResponseWriter, r *http.Request, id string) {
+	var request UpdateGroupRequestObject
+
+	request.Id = id
+
+	var body UpdateGroupJSONRequestBody
+	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+		sh.options.RequestErrorHandlerFunc(w, r, fmt.Errorf("can't decode JSON body: %w", err))
+		return
+	}
+	request.Body = &body
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.UpdateGroup(ctx, request.(UpdateGroupRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "UpdateGroup")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(UpdateGroupResponseObject); ok {
+		if err := validResponse.VisitUpdateGroupResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// ListChildGroups operation middleware
+func (sh *strictHandler) ListChildGroups(w http.ResponseWriter, r *http.Request, id string) {
+	var request ListChildGroupsRequestObject
+
+	request.Id = id
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.ListChildGroups(ctx, request.(ListChildGroupsRequestObject))
 	}
 	for _, middleware := range sh.middlewares {
-		handler = middleware(handler, "GetComment")
+		handler = middleware(handler, "ListChildGroups")
 	}
 
 	response, err := handler(r.Context(), w, r, request)
 
 	if err != nil {
 		sh.options.ResponseErrorHandlerFunc(w, r, err)
-	} else if validResponse, ok := response.(GetCommentResponseObject); ok {
-		if err := validResponse.VisitGetCommentResponse(w); err != nil {
+	} else if validResponse, ok := response.(ListChildGroupsResponseObject); ok {
+		if err := validResponse.VisitListChildGroupsResponse(w); err != nil {
 			sh.options.ResponseErrorHandlerFunc(w, r, err)
 		}
 	} else if response != nil {
@@ -5508,32 +8119,26 @@ func (sh *strictHandler) GetComment(w http.ResponseWriter, r *http.Request, id s
 	}
 }
 
-// UpdateComment operation middleware
-func (sh *strictHandler) UpdateComment(w http.ResponseWriter, r *http.Request, id string) {
-	var request UpdateCommentRequestObject
+// RemoveGroupParent operation middleware
+func (sh *strictHandler) RemoveGroupParent(w http.ResponseWriter, r *http.Request, id string, parentGroupId string) {
+	var request RemoveGroupParentRequestObject
 
 	request.Id = id
-
-	var body UpdateCommentJSONRequestBody
-	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
-		sh.options.RequestErrorHandlerFunc(w, r, fmt.Errorf("can't decode JSON body: %w", err))
-		return
-	}
-	request.Body = &body
+	request.ParentGroupId = parentGroupId
 
 	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
-		return sh.ssi.UpdateComment(ctx, request.(UpdateCommentRequestObject))
+		return sh.ssi.RemoveGroupParent(ctx, request.(RemoveGroupParentRequestObject))
 	}
 	for _, middleware := range sh.middlewares {
-		handler = middleware(handler, "UpdateComment")
+		handler = middleware(handler, "RemoveGroupParent")
 	}
 
 	response, err := handler(r.Context(), w, r, request)
 
 	if err != nil {
 		sh.options.ResponseErrorHandlerFunc(w, r, err)
-	} else if validResponse, ok := response.(UpdateCommentResponseObject); ok {
-		if err := validResponse.VisitUpdateCommentResponse(w); err != nil {
+	} else if validResponse, ok := response.(RemoveGroupParentResponseObject); ok {
+		if err := validResponse.VisitRemoveGroupParentResponse(w); err != nil {
 			sh.options.ResponseErrorHandlerFunc(w, r, err)
 		}
 	} else if response != nil {
@@ -5541,23 +8146,25 @@ func (sh *strictHandler) UpdateComment(w http.ResponseWriter, r *http.Request, i
 	}
 }
 
-// GetConfig operation middleware
-func (sh *strictHandler) GetConfig(w http.ResponseWriter, r *http.Request) {
-	var request GetConfigRequestObject
+// ListParentGroups operation middleware
+func (sh *strictHandler) ListParentGroups(w http.ResponseWriter, r *http.Request, id string) {
+	var request ListParentGroupsRequestObject
+
+	request.Id = id
 
 	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
-		return sh.ssi.GetConfig(ctx, request.(GetConfigRequestObject))
+		return sh.ssi.ListParentGroups(ctx, request.(ListParentGroupsRequestObject))
 	}
 	for _, middleware := range sh.middlewares {
-		handler = middleware(handler, "GetConfig")
+		handler = middleware(handler, "ListParentGroups")
 	}
 
 	response, err := handler(r.Context(), w, r, request)
 
 	if err != nil {
 		sh.options.ResponseErrorHandlerFunc(w, r, err)
-	} else if validResponse, ok := response.(GetConfigResponseObject); ok {
-		if err := validResponse.VisitGetConfigResponse(w); err != nil {
+	} else if validResponse, ok := response.(ListParentGroupsResponseObject); ok {
+		if err := validResponse.VisitListParentGroupsResponse(w); err != nil {
 			sh.options.ResponseErrorHandlerFunc(w, r, err)
 		}
 	} else if response != nil {
@@ -5565,23 +8172,32 @@ func (sh *strictHandler) GetConfig(w http.ResponseWriter, r *http.Request) {
 	}
 }
 
-// GetDashboardCounts operation middleware
-func (sh *strictHandler) GetDashboardCounts(w http.ResponseWriter, r *http.Request) {
-	var request GetDashboardCountsRequestObject
+// AddGroupParent operation middleware
+func (sh *strictHandler) AddGroupParent(w http.ResponseWriter, r *http.Request, id string) {
+	var request AddGroupParentRequestObject
+
+	request.Id = id
+
+	var body AddGroupParentJSONRequestBody
+	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+		sh.options.RequestErrorHandlerFunc(w, r, fmt.Errorf("can't decode JSON body: %w", err))
+		return
+	}
+	request.Body = &body
 
 	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
-		return sh.ssi.GetDashboardCounts(ctx, request.(GetDashboardCountsRequestObject))
+		return sh.ssi.AddGroupParent(ctx, request.(AddGroupParentRequestObject))
 	}
 	for _, middleware := range sh.middlewares {
-		handler = middleware(handler, "GetDashboardCounts")
+		handler = middleware(handler, "AddGroupParent")
 	}
 
 	response, err := handler(r.Context(), w, r, request)
 
 	if err != nil {
 		sh.options.ResponseErrorHandlerFunc(w, r, err)
-	} else if validResponse, ok := response.(GetDashboardCountsResponseObject); ok {
-		if err := validResponse.VisitGetDashboardCountsResponse(w); err != nil {
+	} else if validResponse, ok := response.(AddGroupParentResponseObject); ok {
+		if err := validResponse.VisitAddGroupParentResponse(w); err != nil {
 			sh.options.ResponseErrorHandlerFunc(w, r, err)
 		}
 	} else if response != nil {
@@ -5589,25 +8205,25 @@ func (sh *strictHandler) GetDashboardCounts(w http.ResponseWriter, r *http.Reque
 	}
 }
 
-// ListFiles operation middleware
-func (sh *strictHandler) ListFiles(w http.ResponseWriter, r *http.Request, params ListFilesParams) {
-	var request ListFilesRequestObject
+// ListParentPermissions operation middleware
+func (sh *strictHandler) ListParentPermissions(w http.ResponseWriter, r *http.Request, id string) {
+	var request ListParentPermissionsRequestObject
 
-	request.Params = params
+	request.Id = id
 
 	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
-		return sh.ssi.ListFiles(ctx, request.(ListFilesRequestObject))
+		return sh.ssi.ListParentPermissions(ctx, request.(ListParentPermissionsRequestObject))
 	}
 	for _, middleware := range sh.middlewares {
-		handler = middleware(handler, "ListFiles")
+		handler = middleware(handler, "ListParentPermissions")
 	}
 
 	response, err := handler(r.Context(), w, r, request)
 
 	if err != nil {
 		sh.options.ResponseErrorHandlerFunc(w, r, err)
-	} else if validResponse, ok := response.(ListFilesResponseObject); ok {
-		if err := validResponse.VisitListFilesResponse(w); err != nil {
+	} else if validResponse, ok := response.(ListParentPermissionsResponseObject); ok {
+		if err := validResponse.VisitListParentPermissionsResponse(w); err != nil {
 			sh.options.ResponseErrorHandlerFunc(w, r, err)
 		}
 	} else if response != nil {
@@ -5615,30 +8231,25 @@ func (sh *strictHandler) ListFiles(w http.ResponseWriter, r *http.Request, param
 	}
 }
 
-// CreateFile operation middleware
-func (sh *strictHandler) CreateFile(w http.ResponseWriter, r *http.Request) {
-	var request CreateFileRequestObject
+// ListGroupUsers operation middleware
+func (sh *strictHandler) ListGroupUsers(w http.ResponseWriter, r *http.Request, id string) {
+	var request ListGroupUsersRequestObject
 
-	var body CreateFileJSONRequestBody
-	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
-		sh.options.RequestErrorHandlerFunc(w, r, fmt.Errorf("can't decode JSON body: %w", err))
-		return
-	}
-	request.Body = &body
+	request.Id = id
 
 	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
-		return sh.ssi.CreateFile(ctx, request.(CreateFileRequestObject))
+		return sh.ssi.ListGroupUsers(ctx, request.(ListGroupUsersRequestObject))
 	}
 	for _, middleware := range sh.middlewares {
-		handler = middleware(handler, "CreateFile")
+		handler = middleware(handler, "ListGroupUsers")
 	}
 
 	response, err := handler(r.Context(), w, r, request)
 
 	if err != nil {
 		sh.options.ResponseErrorHandlerFunc(w, r, err)
-	} else if validResponse, ok := response.(CreateFileResponseObject); ok {
-		if err := validResponse.VisitCreateFileResponse(w); err != nil {
+	} else if validResponse, ok := response.(ListGroupUsersResponseObject); ok {
+		if err := validResponse.VisitListGroupUsersResponse(w); err != nil {
 			sh.options.ResponseErrorHandlerFunc(w, r, err)
 		}
 	} else if response != nil {
@@ -5646,25 +8257,25 @@ func (sh *strictHandler) CreateFile(w http.ResponseWriter, r *http.Request) {
 	}
 }
 
-// DeleteFile operation middleware
-func (sh *strictHandler) DeleteFile(w http.ResponseWriter, r *http.Request, id string) {
-	var request DeleteFileRequestObject
+// ListLinks operation middleware
+func (sh *strictHandler) ListLinks(w http.ResponseWriter, r *http.Request, params ListLinksParams) {
+	var request ListLinksRequestObject
 
-	request.Id = id
+	request.Params = params
 
 	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
-		return sh.ssi.DeleteFile(ctx, request.(DeleteFileRequestObject))
+		return sh.ssi.ListLinks(ctx, request.(ListLinksRequestObject))
 	}
 	for _, middleware := range sh.middlewares {
-		handler = middleware(handler, "DeleteFile")
+		handler = middleware(handler, "ListLinks")
 	}
 
 	response, err := handler(r.Context(), w, r, request)
 
 	if err != nil {
 		sh.options.ResponseErrorHandlerFunc(w, r, err)
-	} else if validResponse, ok := response.(DeleteFileResponseObject); ok {
-		if err := validResponse.VisitDeleteFileResponse(w); err != nil {
+	} else if validResponse, ok := response.(ListLinksResponseObject); ok {
+		if err := validResponse.VisitListLinksResponse(w); err != nil {
 			sh.options.ResponseErrorHandlerFunc(w, r, err)
 		}
 	} else if response != nil {
@@ -5672,25 +8283,30 @@ func (sh *strictHandler) DeleteFile(w http.ResponseWriter, r *http.Request, id s
 	}
 }
 
-// GetFile operation middleware
-func (sh *strictHandler) GetFile(w http.ResponseWriter, r *http.Request, id string) {
-	var request GetFileRequestObject
+// CreateLink operation middleware
+func (sh *strictHandler) CreateLink(w http.ResponseWriter, r *http.Request) {
+	var request CreateLinkRequestObject
 
-	request.Id = id
+	var body CreateLinkJSONRequestBody
+	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+		sh.options.RequestErrorHandlerFunc(w, r, fmt.Errorf("can't decode JSON body: %w", err))
+		return
+	}
+	request.Body = &body
 
 	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
-		return sh.ssi.GetFile(ctx, request.(GetFileRequestObject))
+		return sh.ssi.CreateLink(ctx, request.(CreateLinkRequestObject))
 	}
 	for _, middleware := range sh.middlewares {
-		handler = middleware(handler, "GetFile")
+		handler = middleware(handler, "CreateLink")
 	}
 
 	response, err := handler(r.Context(), w, r, request)
 
 	if err != nil {
 		sh.options.ResponseErrorHandlerFunc(w, r, err)
-	} else if validResponse, ok := response.(GetFileResponseObject); ok {
-		if err := validResponse.VisitGetFileResponse(w); err != nil {
+	} else if validResponse, ok := response.(CreateLinkResponseObject); ok {
+		if err := validResponse.VisitCreateLinkResponse(w); err != nil {
 			sh.options.ResponseErrorHandlerFunc(w, r, err)
 		}
 	} else if response != nil {
@@ -5698,25 +8314,25 @@ func (sh *strictHandler) GetFile(w http.ResponseWriter, r *http.Request, id stri
 	}
 }
 
-// DownloadFile operation middleware
-func (sh *strictHandler) DownloadFile(w http.ResponseWriter, r *http.Request, id string) {
-	var request DownloadFileRequestObject
+// DeleteLink operation middleware
+func (sh *strictHandler) DeleteLink(w http.ResponseWriter, r *http.Request, id string) {
+	var request DeleteLinkRequestObject
 
 	request.Id = id
 
 	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
-		return sh.ssi.DownloadFile(ctx, request.(DownloadFileRequestObject))
+		return sh.ssi.DeleteLink(ctx, request.(DeleteLinkRequestObject))
 	}
 	for _, middleware := range sh.middlewares {
-		handler = middleware(handler, "DownloadFile")
+		handler = middleware(handler, "DeleteLink")
 	}
 
 	response, err := handler(r.Context(), w, r, request)
 
 	if err != nil {
 		sh.options.ResponseErrorHandlerFunc(w, r, err)
-	} else if validResponse, ok := response.(DownloadFileResponseObject); ok {
-		if err := validResponse.VisitDownloadFileResponse(w); err != nil {
+	} else if validResponse, ok := response.(DeleteLinkResponseObject); ok {
+		if err := validResponse.VisitDeleteLinkResponse(w); err != nil {
 			sh.options.ResponseErrorHandlerFunc(w, r, err)
 		}
 	} else if response != nil {
@@ -5724,25 +8340,25 @@ func (sh *strictHandler) DownloadFile(w http.ResponseWriter, r *http.Request, id
 	}
 }
 
-// ListGroups operation middleware
-func (sh *strictHandler) ListGroups(w http.ResponseWriter, r *http.Request, params ListGroupsParams) {
-	var request ListGroupsRequestObject
+// GetLink operation middleware
+func (sh *strictHandler) GetLink(w http.ResponseWriter, r *http.Request, id string) {
+	var request GetLinkRequestObject
 
-	request.Params = params
+	request.Id = id
 
 	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
-		return sh.ssi.ListGroups(ctx, request.(ListGroupsRequestObject))
+		return sh.ssi.GetLink(ctx, request.(GetLinkRequestObject))
 	}
 	for _, middleware := range sh.middlewares {
-		handler = middleware(handler, "ListGroups")
+		handler = middleware(handler, "GetLink")
 	}
 
 	response, err := handler(r.Context(), w, r, request)
 
 	if err != nil {
 		sh.options.ResponseErrorHandlerFunc(w, r, err)
-	} else if validResponse, ok := response.(ListGroupsResponseObject); ok {
-		if err := validResponse.VisitListGroupsResponse(w); err != nil {
+	} else if validResponse, ok := response.(GetLinkResponseObject); ok {
+		if err := validResponse.VisitGetLinkResponse(w); err != nil {
 			sh.options.ResponseErrorHandlerFunc(w, r, err)
 		}
 	} else if response != nil {
@@ -5750,11 +8366,13 @@ func (sh *strictHandler) ListGroups(w http.ResponseWriter, r *http.Request, para
 	}
 }
 
-// CreateGroup operation middleware
-func (sh *strictHandler) CreateGroup(w http.ResponseWriter, r *http.Request) {
-	var request CreateGroupRequestObject
+// UpdateLink operation middleware
+func (sh *strictHandler) UpdateLink(w http.ResponseWriter, r *http.Request, id string) {
+	var request UpdateLinkRequestObject
 
-	var body CreateGroupJSONRequestBody
+	request.Id = id
+
+	var body UpdateLinkJSONRequestBody
 	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
 		sh.options.RequestErrorHandlerFunc(w, r, fmt.Errorf("can't decode JSON body: %w", err))
 		return
@@ -5762,18 +8380,18 @@ func (sh *strictHandler) CreateGroup(w http.ResponseWriter, r *http.Request) {
 	request.Body = &body
 
 	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
-		return sh.ssi.CreateGroup(ctx, request.(CreateGroupRequestObject))
+		return sh.ssi.UpdateLink(ctx, request.(UpdateLinkRequestObject))
 	}
 	for _, middleware := range sh.middlewares {
-		handler = middleware(handler, "CreateGroup")
+		handler = middleware(handler, "UpdateLink")
 	}
 
 	response, err := handler(r.Context(), w, r, request)
 
 	if err != nil {
 		sh.options.ResponseErrorHandlerFunc(w, r, err)
-	} else if validResponse, ok := response.(CreateGroupResponseObject); ok {
-		if err := validResponse.VisitCreateGroupResponse(w); err != nil {
+	} else if validResponse, ok := response.(UpdateLinkResponseObject); ok {
+		if err := validResponse.VisitUpdateLinkResponse(w); err != nil {
 			sh.options.ResponseErrorHandlerFunc(w, r, err)
 		}
 	} else if response != nil {
@@ -5781,25 +8399,23 @@ func (sh *strictHandler) CreateGroup(w http.ResponseWriter, r *http.Request) {
 	}
 }
 
-// DeleteGroup operation middleware
-func (sh *strictHandler) DeleteGroup(w http.ResponseWriter, r *http.Request, id string) {
-	var request DeleteGroupRequestObject
-
-	request.Id = id
+// PurgePythonEnvironments operation middleware
+func (sh *strictHandler) PurgePythonEnvironments(w http.ResponseWriter, r *http.Request) {
+	var request PurgePythonEnvironmentsRequestObject
 
 	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
-		return sh.ssi.DeleteGroup(ctx, request.(DeleteGroupRequestObject))
+		return sh.ssi.PurgePythonEnvironments(ctx, request.(PurgePythonEnvironmentsRequestObject))
 	}
 	for _, middleware := range sh.middlewares {
-		handler = middleware(handler, "DeleteGroup")
+		handler = middleware(handler, "PurgePythonEnvironments")
 	}
 
 	response, err := handler(r.Context(), w, r, request)
 
 	if err != nil {
 		sh.options.ResponseErrorHandlerFunc(w, r, err)
-	} else if validResponse, ok := response.(DeleteGroupResponseObject); ok {
-		if err := validResponse.VisitDeleteGroupResponse(w); err != nil {
+	} else if validResponse, ok := response.(PurgePythonEnvironmentsResponseObject); ok {
+		if err := validResponse.VisitPurgePythonEnvironmentsResponse(w); err != nil {
 			sh.options.ResponseErrorHandlerFunc(w, r, err)
 		}
 	} else if response != nil {
@@ -5807,25 +8423,23 @@ func (sh *strictHandler) DeleteGroup(w http.ResponseWriter, r *http.Request, id
 	}
 }
 
-// GetGroup operation middleware
-func (sh *strictHandler) GetGroup(w http.ResponseWriter, r *http.Request, id string) {
-	var request GetGroupRequestObject
-
-	request.Id = id
+// ListPythonEnvironments operation middleware
+func (sh *strictHandler) ListPythonEnvironments(w http.ResponseWriter, r *http.Request) {
+	var request ListPythonEnvironmentsRequestObject
 
 	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
-		return sh.ssi.GetGroup(ctx, request.(GetGroupRequestObject))
+		return sh.ssi.ListPythonEnvironments(ctx, request.(ListPythonEnvironmentsRequestObject))
 	}
 	for _, middleware := range sh.middlewares {
-		handler = middleware(handler, "GetGroup")
+		handler = middleware(handler, "ListPythonEnvironments")
 	}
 
 	response, err := handler(r.Context(), w, r, request)
 
 	if err != nil {
 		sh.options.ResponseErrorHandlerFunc(w, r, err)
-	} else if validResponse, ok := response.(GetGroupResponseObject); ok {
-		if err := validResponse.VisitGetGroupResponse(w); err != nil {
+	} else if validResponse, ok := response.(ListPythonEnvironmentsResponseObject); ok {
+		if err := validResponse.VisitListPythonEnvironmentsResponse(w); err != nil {
 			sh.options.ResponseErrorHandlerFunc(w, r, err)
 		}
 	} else if response != nil {
@@ -5833,13 +8447,11 @@ func (sh *strictHandler) GetGroup(w http.ResponseWriter, r *http.Request, id str
 	}
 }
 
-// UpdateGroup operation middleware
-func (sh *strictHandler) UpdateGroup(w http.ResponseWriter, r *http.Request, id string) {
-	var request UpdateGroupRequestObject
-
-	request.Id = id
+// PrewarmPythonEnvironment operation middleware
+func (sh *strictHandler) PrewarmPythonEnvironment(w http.ResponseWriter, r *http.Request) {
+	var request PrewarmPythonEnvironmentRequestObject
 
-	var body UpdateGroupJSONRequestBody
+	var body PrewarmPythonEnvironmentJSONRequestBody
 	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
 		sh.options.RequestErrorHandlerFunc(w, r, fmt.Errorf("can't decode JSON body: %w", err))
 		return
@@ -5847,18 +8459,18 @@ func (sh *strictHandler) UpdateGroup(w http.ResponseWriter, r *http.Request, id
 	request.Body = &body
 
 	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
-		return sh.ssi.UpdateGroup(ctx, request.(UpdateGroupRequestObject))
+		return sh.ssi.PrewarmPythonEnvironment(ctx, request.(PrewarmPythonEnvironmentRequestObject))
 	}
 	for _, middleware := range sh.middlewares {
-		handler = middleware(handler, "UpdateGroup")
+		handler = middleware(handler, "PrewarmPythonEnvironment")
 	}
 
 	response, err := handler(r.Context(), w, r, request)
 
 	if err != nil {
 		sh.options.ResponseErrorHandlerFunc(w, r, err)
-	} else if validResponse, ok := response.(UpdateGroupResponseObject); ok {
-		if err := validResponse.VisitUpdateGroupResponse(w); err != nil {
+	} else if validResponse, ok := response.(PrewarmPythonEnvironmentResponseObject); ok {
+		if err := validResponse.VisitPrewarmPythonEnvironmentResponse(w); err != nil {
 			sh.options.ResponseErrorHandlerFunc(w, r, err)
 		}
 	} else if response != nil {
@@ -5866,25 +8478,25 @@ func (sh *strictHandler) UpdateGroup(w http.ResponseWriter, r *http.Request, id
 	}
 }
 
-// ListChildGroups operation middleware
-func (sh *strictHandler) ListChildGroups(w http.ResponseWriter, r *http.Request, id string) {
-	var request ListChildGroupsRequestObject
+// DeletePythonEnvironment operation middleware
+func (sh *strictHandler) DeletePythonEnvironment(w http.ResponseWriter, r *http.Request, id string) {
+	var request DeletePythonEnvironmentRequestObject
 
 	request.Id = id
 
 	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
-		return sh.ssi.ListChildGroups(ctx, request.(ListChildGroupsRequestObject))
+		return sh.ssi.DeletePythonEnvironment(ctx, request.(DeletePythonEnvironmentRequestObject))
 	}
 	for _, middleware := range sh.middlewares {
-		handler = middleware(handler, "ListChildGroups")
+		handler = middleware(handler, "DeletePythonEnvironment")
 	}
 
 	response, err := handler(r.Context(), w, r, request)
 
 	if err != nil {
 		sh.options.ResponseErrorHandlerFunc(w, r, err)
-	} else if validResponse, ok := response.(ListChildGroupsResponseObject); ok {
-		if err := validResponse.VisitListChildGroupsResponse(w); err != nil {
+	} else if validResponse, ok := response.(DeletePythonEnvironmentResponseObject); ok {
+		if err := validResponse.VisitDeletePythonEnvironmentResponse(w); err != nil {
 			sh.options.ResponseErrorHandlerFunc(w, r, err)
 		}
 	} else if response != nil {
@@ -5892,26 +8504,25 @@ func (sh *strictHandler) ListChildGroups(w http.ResponseWriter, r *http.Request,
 	}
 }
 
-// RemoveGroupParent operation middleware
-func (sh *strictHandler) RemoveGroupParent(w http.ResponseWriter, r *http.Request, id string, parentGroupId string) {
-	var request RemoveGroupParentRequestObject
+// ListReactions operation middleware
+func (sh *strictHandler) ListReactions(w http.ResponseWriter, r *http.Request, params ListReactionsParams) {
+	var request ListReactionsRequestObject
 
-	request.Id = id
-	request.ParentGroupId = parentGroupId
+	request.Params = params
 
 	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
-		return sh.ssi.RemoveGroupParent(ctx, request.(RemoveGroupParentRequestObject))
+		return sh.ssi.ListReactions(ctx, request.(ListReactionsRequestObject))
 	}
 	for _, middleware := range sh.middlewares {
-		handler = middleware(handler, "RemoveGroupParent")
+		handler = middleware(handler, "ListReactions")
 	}
 
 	response, err := handler(r.Context(), w, r, request)
 
 	if err != nil {
 		sh.options.ResponseErrorHandlerFunc(w, r, err)
-	} else if validResponse, ok := response.(RemoveGroupParentResponseObject); ok {
-		if err := validResponse.VisitRemoveGroupParentResponse(w); err != nil {
+	} else if validResponse, ok := response.(ListReactionsResponseObject); ok {
+		if err := validResponse.VisitListReactionsResponse(w); err != nil {
 			sh.options.ResponseErrorHandlerFunc(w, r, err)
 		}
 	} else if response != nil {
@@ -5919,25 +8530,30 @@ func (sh *strictHandler) RemoveGroupParent(w http.ResponseWriter, r *http.Reques
 	}
 }
 
-// ListParentGroups operation middleware
-func (sh *strictHandler) ListParentGroups(w http.ResponseWriter, r *http.Request, id string) {
-	var request ListParentGroupsRequestObject
+// CreateReaction operation middleware
+func (sh *strictHandler) CreateReaction(w http.ResponseWriter, r *http.Request) {
+	var request CreateReactionRequestObject
 
-	request.Id = id
+	var body CreateReactionJSONRequestBody
+	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+		sh.options.RequestErrorHandlerFunc(w, r, fmt.Errorf("can't decode JSON body: %w", err))
+		return
+	}
+	request.Body = &body
 
 	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
-		return sh.ssi.ListParentGroups(ctx, request.(ListParentGroupsRequestObject))
+		return sh.ssi.CreateReaction(ctx, request.(CreateReactionRequestObject))
 	}
 	for _, middleware := range sh.middlewares {
-		handler = middleware(handler, "ListParentGroups")
+		handler = middleware(handler, "CreateReaction")
 	}
 
 	response, err := handler(r.Context(), w, r, request)
 
 	if err != nil {
 		sh.options.ResponseErrorHandlerFunc(w, r, err)
-	} else if validResponse, ok := response.(ListParentGroupsResponseObject); ok {
-		if err := validResponse.VisitListParentGroupsResponse(w); err != nil {
+	} else if validResponse, ok := response.(CreateReactionResponseObject); ok {
+		if err := validResponse.VisitCreateReactionResponse(w); err != nil {
 			sh.options.ResponseErrorHandlerFunc(w, r, err)
 		}
 	} else if response != nil {
@@ -5945,32 +8561,25 @@ func (sh *strictHandler) ListParentGroups(w http.ResponseWriter, r *http.Request
 	}
 }
 
-// AddGroupParent operation middleware
-func (sh *strictHandler) AddGroupParent(w http.ResponseWriter, r *http.Request, id string) {
-	var request AddGroupParentRequestObject
+// DeleteReaction operation middleware
+func (sh *strictHandler) DeleteReaction(w http.ResponseWriter, r *http.Request, id string) {
+	var request DeleteReactionRequestObject
 
 	request.Id = id
 
-	var body AddGroupParentJSONRequestBody
-	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
-		sh.options.RequestErrorHandlerFunc(w, r, fmt.Errorf("can't decode JSON body: %w", err))
-		return
-	}
-	request.Body = &body
-
 	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
-		return sh.ssi.AddGroupParent(ctx, request.(AddGroupParentRequestObject))
+		return sh.ssi.DeleteReaction(ctx, request.(DeleteReactionRequestObject))
 	}
 	for _, middleware := range sh.middlewares {
-		handler = middleware(handler, "AddGroupParent")
+		handler = middleware(handler, "DeleteReaction")
 	}
 
 	response, err := handler(r.Context(), w, r, request)
 
 	if err != nil {
 		sh.options.ResponseErrorHandlerFunc(w, r, err)
-	} else if validResponse, ok := response.(AddGroupParentResponseObject); ok {
-		if err := validResponse.VisitAddGroupParentResponse(w); err != nil {
+	} else if validResponse, ok := response.(DeleteReactionResponseObject); ok {
+		if err := validResponse.VisitDeleteReactionResponse(w); err != nil {
 			sh.options.ResponseErrorHandlerFunc(w, r, err)
 		}
 	} else if response != nil {
@@ -5978,25 +8587,25 @@ func (sh *strictHandler) AddGroupParent(w http.ResponseWriter, r *http.Request,
 	}
 }
 
-// ListParentPermissions operation middleware
-func (sh *strictHandler) ListParentPermissions(w http.ResponseWriter, r *http.Request, id string) {
-	var request ListParentPermissionsRequestObject
+// GetReaction operation middleware
+func (sh *strictHandler) GetReaction(w http.ResponseWriter, r *http.Request, id string) {
+	var request GetReactionRequestObject
 
 	request.Id = id
 
 	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
-		return sh.ssi.ListParentPermissions(ctx, request.(ListParentPermissionsRequestObject))
+		return sh.ssi.GetReaction(ctx, request.(GetReactionRequestObject))
 	}
 	for _, middleware := range sh.middlewares {
-		handler = middleware(handler, "ListParentPermissions")
+		handler = middleware(handler, "GetReaction")
 	}
 
 	response, err := handler(r.Context(), w, r, request)
 
 	if err != nil {
 		sh.options.ResponseErrorHandlerFunc(w, r, err)
-	} else if validResponse, ok := response.(ListParentPermissionsResponseObject); ok {
-		if err := validResponse.VisitListParentPermissionsResponse(w); err != nil {
+	} else if validResponse, ok := response.(GetReactionResponseObject); ok {
+		if err := validResponse.VisitGetReactionResponse(w); err != nil {
 			sh.options.ResponseErrorHandlerFunc(w, r, err)
 		}
 	} else if response != nil {
@@ -6004,25 +8613,32 @@ func (sh *strictHandler) ListParentPermissions(w http.ResponseWriter, r *http.Re
 	}
 }
 
-// ListGroupUsers operation middleware
-func (sh *strictHandler) ListGroupUsers(w http.ResponseWriter, r *http.Request, id string) {
-	var request ListGroupUsersRequestObject
+// UpdateReaction operation middleware
+func (sh *strictHandler) UpdateReaction(w http.ResponseWriter, r *http.Request, id string) {
+	var request UpdateReactionRequestObject
 
 	request.Id = id
 
+	var body UpdateReactionJSONRequestBody
+	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+		sh.options.RequestErrorHandlerFunc(w, r, fmt.Errorf("can't decode JSON body: %w", err))
+		return
+	}
+	request.Body = &body
+
 	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
-		return sh.ssi.ListGroupUsers(ctx, request.(ListGroupUsersRequestObject))
+		return sh.ssi.UpdateReaction(ctx, request.(UpdateReactionRequestObject))
 	}
 	for _, middleware := range sh.middlewares {
-		handler = middleware(handler, "ListGroupUsers")
+		handler = middleware(handler, "UpdateReaction")
 	}
 
 	response, err := handler(r.Context(), w, r, request)
 
 	if err != nil {
 		sh.options.ResponseErrorHandlerFunc(w, r, err)
-	} else if validResponse, ok := response.(ListGroupUsersResponseObject); ok {
-		if err := validResponse.VisitListGroupUsersResponse(w); err != nil {
+	} else if validResponse, ok := response.(UpdateReactionResponseObject); ok {
+		if err := validResponse.VisitUpdateReactionResponse(w); err != nil {
 			sh.options.ResponseErrorHandlerFunc(w, r, err)
 		}
 	} else if response != nil {
@@ -6030,25 +8646,33 @@ func (sh *strictHandler) ListGroupUsers(w http.ResponseWriter, r *http.Request,
 	}
 }
 
-// ListLinks operation middleware
-func (sh *strictHandler) ListLinks(w http.ResponseWriter, r *http.Request, params ListLinksParams) {
-	var request ListLinksRequestObject
+// RunReaction operation middleware
+func (sh *strictHandler) RunReaction(w http.ResponseWriter, r *http.Request, id string, params RunReactionParams) {
+	var request RunReactionRequestObject
 
+	request.Id = id
 	request.Params = params
 
+	var body RunReactionJSONRequestBody
+	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+		sh.options.RequestErrorHandlerFunc(w, r, fmt.Errorf("can't decode JSON body: %w", err))
+		return
+	}
+	request.Body = &body
+
 	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
-		return sh.ssi.ListLinks(ctx, request.(ListLinksRequestObject))
+		return sh.ssi.RunReaction(ctx, request.(RunReactionRequestObject))
 	}
 	for _, middleware := range sh.middlewares {
-		handler = middleware(handler, "ListLinks")
+		handler = middleware(handler, "RunReaction")
 	}
 
 	response, err := handler(r.Context(), w, r, request)
 
 	if err != nil {
 		sh.options.ResponseErrorHandlerFunc(w, r, err)
-	} else if validResponse, ok := response.(ListLinksResponseObject); ok {
-		if err := validResponse.VisitListLinksResponse(w); err != nil {
+	} else if validResponse, ok := response.(RunReactionResponseObject); ok {
+		if err := validResponse.VisitRunReactionResponse(w); err != nil {
 			sh.options.ResponseErrorHandlerFunc(w, r, err)
 		}
 	} else if response != nil {
@@ -6056,30 +8680,26 @@ func (sh *strictHandler) ListLinks(w http.ResponseWriter, r *http.Request, param
 	}
 }
 
-// CreateLink operation middleware
-func (sh *strictHandler) CreateLink(w http.ResponseWriter, r *http.Request) {
-	var request CreateLinkRequestObject
+// ListReactionRuns operation middleware
+func (sh *strictHandler) ListReactionRuns(w http.ResponseWriter, r *http.Request, id string, params ListReactionRunsParams) {
+	var request ListReactionRunsRequestObject
 
-	var body CreateLinkJSONRequestBody
-	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
-		sh.options.RequestErrorHandlerFunc(w, r, fmt.Errorf("can't decode JSON body: %w", err))
-		return
-	}
-	request.Body = &body
+	request.Id = id
+	request.Params = params
 
 	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
-		return sh.ssi.CreateLink(ctx, request.(CreateLinkRequestObject))
+		return sh.ssi.ListReactionRuns(ctx, request.(ListReactionRunsRequestObject))
 	}
 	for _, middleware := range sh.middlewares {
-		handler = middleware(handler, "CreateLink")
+		handler = middleware(handler, "ListReactionRuns")
 	}
 
 	response, err := handler(r.Context(), w, r, request)
 
 	if err != nil {
 		sh.options.ResponseErrorHandlerFunc(w, r, err)
-	} else if validResponse, ok := response.(CreateLinkResponseObject); ok {
-		if err := validResponse.VisitCreateLinkResponse(w); err != nil {
+	} else if validResponse, ok := response.(ListReactionRunsResponseObject); ok {
+		if err := validResponse.VisitListReactionRunsResponse(w); err != nil {
 			sh.options.ResponseErrorHandlerFunc(w, r, err)
 		}
 	} else if response != nil {
@@ -6087,25 +8707,26 @@ func (sh *strictHandler) CreateLink(w http.ResponseWriter, r *http.Request) {
 	}
 }
 
-// DeleteLink operation middleware
-func (sh *strictHandler) DeleteLink(w http.ResponseWriter, r *http.Request, id string) {
-	var request DeleteLinkRequestObject
+// GetReactionRun operation middleware
+func (sh *strictHandler) GetReactionRun(w http.ResponseWriter, r *http.Request, id string, runId string) {
+	var request GetReactionRunRequestObject
 
 	request.Id = id
+	request.RunId = runId
 
 	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
-		return sh.ssi.DeleteLink(ctx, request.(DeleteLinkRequestObject))
+		return sh.ssi.GetReactionRun(ctx, request.(GetReactionRunRequestObject))
 	}
 	for _, middleware := range sh.middlewares {
-		handler = middleware(handler, "DeleteLink")
+		handler = middleware(handler, "GetReactionRun")
 	}
 
 	response, err := handler(r.Context(), w, r, request)
 
 	if err != nil {
 		sh.options.ResponseErrorHandlerFunc(w, r, err)
-	} else if validResponse, ok := response.(DeleteLinkResponseObject); ok {
-		if err := validResponse.VisitDeleteLinkResponse(w); err != nil {
+	} else if validResponse, ok := response.(GetReactionRunResponseObject); ok {
+		if err := validResponse.VisitGetReactionRunResponse(w); err != nil {
 			sh.options.ResponseErrorHandlerFunc(w, r, err)
 		}
 	} else if response != nil {
@@ -6113,25 +8734,25 @@ func (sh *strictHandler) DeleteLink(w http.ResponseWriter, r *http.Request, id s
 	}
 }
 
-// GetLink operation middleware
-func (sh *strictHandler) GetLink(w http.ResponseWriter, r *http.Request, id string) {
-	var request GetLinkRequestObject
+// ListSecrets operation middleware
+func (sh *strictHandler) ListSecrets(w http.ResponseWriter, r *http.Request, params ListSecretsParams) {
+	var request ListSecretsRequestObject
 
-	request.Id = id
+	request.Params = params
 
 	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
-		return sh.ssi.GetLink(ctx, request.(GetLinkRequestObject))
+		return sh.ssi.ListSecrets(ctx, request.(ListSecretsRequestObject))
 	}
 	for _, middleware := range sh.middlewares {
-		handler = middleware(handler, "GetLink")
+		handler = middleware(handler, "ListSecrets")
 	}
 
 	response, err := handler(r.Context(), w, r, request)
 
 	if err != nil {
 		sh.options.ResponseErrorHandlerFunc(w, r, err)
-	} else if validResponse, ok := response.(GetLinkResponseObject); ok {
-		if err := validResponse.VisitGetLinkResponse(w); err != nil {
+	} else if validResponse, ok := response.(ListSecretsResponseObject); ok {
+		if err := validResponse.VisitListSecretsResponse(w); err != nil {
 			sh.options.ResponseErrorHandlerFunc(w, r, err)
 		}
 	} else if response != nil {
@@ -6139,13 +8760,11 @@ func (sh *strictHandler) GetLink(w http.ResponseWriter, r *http.Request, id stri
 	}
 }
 
-// UpdateLink operation middleware
-func (sh *strictHandler) UpdateLink(w http.ResponseWriter, r *http.Request, id string) {
-	var request UpdateLinkRequestObject
-
-	request.Id = id
+// CreateSecret operation middleware
+func (sh *strictHandler) CreateSecret(w http.ResponseWriter, r *http.Request) {
+	var request CreateSecretRequestObject
 
-	var body UpdateLinkJSONRequestBody
+	var body CreateSecretJSONRequestBody
 	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
 		sh.options.RequestErrorHandlerFunc(w, r, fmt.Errorf("can't decode JSON body: %w", err))
 		return
@@ -6153,18 +8772,18 @@ func (sh *strictHandler) UpdateLink(w http.ResponseWriter, r *http.Request, id s
 	request.Body = &body
 
 	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
-		return sh.ssi.UpdateLink(ctx, request.(UpdateLinkRequestObject))
+		return sh.ssi.CreateSecret(ctx, request.(CreateSecretRequestObject))
 	}
 	for _, middleware := range sh.middlewares {
-		handler = middleware(handler, "UpdateLink")
+		handler = middleware(handler, "CreateSecret")
 	}
 
 	response, err := handler(r.Context(), w, r, request)
 
 	if err != nil {
 		sh.options.ResponseErrorHandlerFunc(w, r, err)
-	} else if validResponse, ok := response.(UpdateLinkResponseObject); ok {
-		if err := validResponse.VisitUpdateLinkResponse(w); err != nil {
+	} else if validResponse, ok := response.(CreateSecretResponseObject); ok {
+		if err := validResponse.VisitCreateSecretResponse(w); err != nil {
 			sh.options.ResponseErrorHandlerFunc(w, r, err)
 		}
 	} else if response != nil {
@@ -6172,25 +8791,25 @@ func (sh *strictHandler) UpdateLink(w http.ResponseWriter, r *http.Request, id s
 	}
 }
 
-// ListReactions operation middleware
-func (sh *strictHandler) ListReactions(w http.ResponseWriter, r *http.Request, params ListReactionsParams) {
-	var request ListReactionsRequestObject
+// DeleteSecret operation middleware
+func (sh *strictHandler) DeleteSecret(w http.ResponseWriter, r *http.Request, id string) {
+	var request DeleteSecretRequestObject
 
-	request.Params = params
+	request.Id = id
 
 	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
-		return sh.ssi.ListReactions(ctx, request.(ListReactionsRequestObject))
+		return sh.ssi.DeleteSecret(ctx, request.(DeleteSecretRequestObject))
 	}
 	for _, middleware := range sh.middlewares {
-		handler = middleware(handler, "ListReactions")
+		handler = middleware(handler, "DeleteSecret")
 	}
 
 	response, err := handler(r.Context(), w, r, request)
 
 	if err != nil {
 		sh.options.ResponseErrorHandlerFunc(w, r, err)
-	} else if validResponse, ok := response.(ListReactionsResponseObject); ok {
-		if err := validResponse.VisitListReactionsResponse(w); err != nil {
+	} else if validResponse, ok := response.(DeleteSecretResponseObject); ok {
+		if err := validResponse.VisitDeleteSecretResponse(w); err != nil {
 			sh.options.ResponseErrorHandlerFunc(w, r, err)
 		}
 	} else if response != nil {
@@ -6198,30 +8817,25 @@ func (sh *strictHandler) ListReactions(w http.ResponseWriter, r *http.Request, p
 	}
 }
 
-// CreateReaction operation middleware
-func (sh *strictHandler) CreateReaction(w http.ResponseWriter, r *http.Request) {
-	var request CreateReactionRequestObject
+// GetSecret operation middleware
+func (sh *strictHandler) GetSecret(w http.ResponseWriter, r *http.Request, id string) {
+	var request GetSecretRequestObject
 
-	var body CreateReactionJSONRequestBody
-	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
-		sh.options.RequestErrorHandlerFunc(w, r, fmt.Errorf("can't decode JSON body: %w", err))
-		return
-	}
-	request.Body = &body
+	request.Id = id
 
 	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
-		return sh.ssi.CreateReaction(ctx, request.(CreateReactionRequestObject))
+		return sh.ssi.GetSecret(ctx, request.(GetSecretRequestObject))
 	}
 	for _, middleware := range sh.middlewares {
-		handler = middleware(handler, "CreateReaction")
+		handler = middleware(handler, "GetSecret")
 	}
 
 	response, err := handler(r.Context(), w, r, request)
 
 	if err != nil {
 		sh.options.ResponseErrorHandlerFunc(w, r, err)
-	} else if validResponse, ok := response.(CreateReactionResponseObject); ok {
-		if err := validResponse.VisitCreateReactionResponse(w); err != nil {
+	} else if validResponse, ok := response.(GetSecretResponseObject); ok {
+		if err := validResponse.VisitGetSecretResponse(w); err != nil {
 			sh.options.ResponseErrorHandlerFunc(w, r, err)
 		}
 	} else if response != nil {
@@ -6229,25 +8843,32 @@ func (sh *strictHandler) CreateReaction(w http.ResponseWriter, r *http.Request)
 	}
 }
 
-// DeleteReaction operation middleware
-func (sh *strictHandler) DeleteReaction(w http.ResponseWriter, r *http.Request, id string) {
-	var request DeleteReactionRequestObject
+// UpdateSecret operation middleware
+func (sh *strictHandler) UpdateSecret(w http.ResponseWriter, r *http.Request, id string) {
+	var request UpdateSecretRequestObject
 
 	request.Id = id
 
+	var body UpdateSecretJSONRequestBody
+	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+		sh.options.RequestErrorHandlerFunc(w, r, fmt.Errorf("can't decode JSON body: %w", err))
+		return
+	}
+	request.Body = &body
+
 	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
-		return sh.ssi.DeleteReaction(ctx, request.(DeleteReactionRequestObject))
+		return sh.ssi.UpdateSecret(ctx, request.(UpdateSecretRequestObject))
 	}
 	for _, middleware := range sh.middlewares {
-		handler = middleware(handler, "DeleteReaction")
+		handler = middleware(handler, "UpdateSecret")
 	}
 
 	response, err := handler(r.Context(), w, r, request)
 
 	if err != nil {
 		sh.options.ResponseErrorHandlerFunc(w, r, err)
-	} else if validResponse, ok := response.(DeleteReactionResponseObject); ok {
-		if err := validResponse.VisitDeleteReactionResponse(w); err != nil {
+	} else if validResponse, ok := response.(UpdateSecretResponseObject); ok {
+		if err := validResponse.VisitUpdateSecretResponse(w); err != nil {
 			sh.options.ResponseErrorHandlerFunc(w, r, err)
 		}
 	} else if response != nil {
@@ -6255,25 +8876,25 @@ func (sh *strictHandler) DeleteReaction(w http.ResponseWriter, r *http.Request,
 	}
 }
 
-// GetReaction operation middleware
-func (sh *strictHandler) GetReaction(w http.ResponseWriter, r *http.Request, id string) {
-	var request GetReactionRequestObject
+// ListSessions operation middleware
+func (sh *strictHandler) ListSessions(w http.ResponseWriter, r *http.Request, params ListSessionsParams) {
+	var request ListSessionsRequestObject
 
-	request.Id = id
+	request.Params = params
 
 	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
-		return sh.ssi.GetReaction(ctx, request.(GetReactionRequestObject))
+		return sh.ssi.ListSessions(ctx, request.(ListSessionsRequestObject))
 	}
 	for _, middleware := range sh.middlewares {
-		handler = middleware(handler, "GetReaction")
+		handler = middleware(handler, "ListSessions")
 	}
 
 	response, err := handler(r.Context(), w, r, request)
 
 	if err != nil {
 		sh.options.ResponseErrorHandlerFunc(w, r, err)
-	} else if validResponse, ok := response.(GetReactionResponseObject); ok {
-		if err := validResponse.VisitGetReactionResponse(w); err != nil {
+	} else if validResponse, ok := response.(ListSessionsResponseObject); ok {
+		if err := validResponse.VisitListSessionsResponse(w); err != nil {
 			sh.options.ResponseErrorHandlerFunc(w, r, err)
 		}
 	} else if response != nil {
@@ -6281,32 +8902,25 @@ func (sh *strictHandler) GetReaction(w http.ResponseWriter, r *http.Request, id
 	}
 }
 
-// UpdateReaction operation middleware
-func (sh *strictHandler) UpdateReaction(w http.ResponseWriter, r *http.Request, id string) {
-	var request UpdateReactionRequestObject
+// DeleteSession operation middleware
+func (sh *strictHandler) DeleteSession(w http.ResponseWriter, r *http.Request, id string) {
+	var request DeleteSessionRequestObject
 
 	request.Id = id
 
-	var body UpdateReactionJSONRequestBody
-	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
-		sh.options.RequestErrorHandlerFunc(w, r, fmt.Errorf("can't decode JSON body: %w", err))
-		return
-	}
-	request.Body = &body
-
 	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
-		return sh.ssi.UpdateReaction(ctx, request.(UpdateReactionRequestObject))
+		return sh.ssi.DeleteSession(ctx, request.(DeleteSessionRequestObject))
 	}
 	for _, middleware := range sh.middlewares {
-		handler = middleware(handler, "UpdateReaction")
+		handler = middleware(handler, "DeleteSession")
 	}
 
 	response, err := handler(r.Context(), w, r, request)
 
 	if err != nil {
 		sh.options.ResponseErrorHandlerFunc(w, r, err)
-	} else if validResponse, ok := response.(UpdateReactionResponseObject); ok {
-		if err := validResponse.VisitUpdateReactionResponse(w); err != nil {
+	} else if validResponse, ok := response.(DeleteSessionResponseObject); ok {
+		if err := validResponse.VisitDeleteSessionResponse(w); err != nil {
 			sh.options.ResponseErrorHandlerFunc(w, r, err)
 		}
 	} else if response != nil {
@@ -6729,11 +9343,96 @@ func (sh *strictHandler) ListTimeline(w http.ResponseWriter, r *http.Request, pa
 	}
 }
 
-// CreateTimeline operation middleware
-func (sh *strictHandler) CreateTimeline(w http.ResponseWriter, r *http.Request) {
-	var request CreateTimelineRequestObject
+// CreateTimeline operation middleware
+func (sh *strictHandler) CreateTimeline(w http.ResponseWriter, r *http.Request) {
+	var request CreateTimelineRequestObject
+
+	var body CreateTimelineJSONRequestBody
+	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+		sh.options.RequestErrorHandlerFunc(w, r, fmt.Errorf("can't decode JSON body: %w", err))
+		return
+	}
+	request.Body = &body
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.CreateTimeline(ctx, request.(CreateTimelineRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "CreateTimeline")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(CreateTimelineResponseObject); ok {
+		if err := validResponse.VisitCreateTimelineResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// DeleteTimeline operation middleware
+func (sh *strictHandler) DeleteTimeline(w http.ResponseWriter, r *http.Request, id string) {
+	var request DeleteTimelineRequestObject
+
+	request.Id = id
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.DeleteTimeline(ctx, request.(DeleteTimelineRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "DeleteTimeline")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(DeleteTimelineResponseObject); ok {
+		if err := validResponse.VisitDeleteTimelineResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// GetTimeline operation middleware
+func (sh *strictHandler) GetTimeline(w http.ResponseWriter, r *http.Request, id string) {
+	var request GetTimelineRequestObject
+
+	request.Id = id
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.GetTimeline(ctx, request.(GetTimelineRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "GetTimeline")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(GetTimelineResponseObject); ok {
+		if err := validResponse.VisitGetTimelineResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// UpdateTimeline operation middleware
+func (sh *strictHandler) UpdateTimeline(w http.ResponseWriter, r *http.Request, id string) {
+	var request UpdateTimelineRequestObject
 
-	var body CreateTimelineJSONRequestBody
+	request.Id = id
+
+	var body UpdateTimelineJSONRequestBody
 	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
 		sh.options.RequestErrorHandlerFunc(w, r, fmt.Errorf("can't decode JSON body: %w", err))
 		return
@@ -6741,18 +9440,18 @@ func (sh *strictHandler) CreateTimeline(w http.ResponseWriter, r *http.Request)
 	request.Body = &body
 
 	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
-		return sh.ssi.CreateTimeline(ctx, request.(CreateTimelineRequestObject))
+		return sh.ssi.UpdateTimeline(ctx, request.(UpdateTimelineRequestObject))
 	}
 	for _, middleware := range sh.middlewares {
-		handler = middleware(handler, "CreateTimeline")
+		handler = middleware(handler, "UpdateTimeline")
 	}
 
 	response, err := handler(r.Context(), w, r, request)
 
 	if err != nil {
 		sh.options.ResponseErrorHandlerFunc(w, r, err)
-	} else if validResponse, ok := response.(CreateTimelineResponseObject); ok {
-		if err := validResponse.VisitCreateTimelineResponse(w); err != nil {
+	} else if validResponse, ok := response.(UpdateTimelineResponseObject); ok {
+		if err := validResponse.VisitUpdateTimelineResponse(w); err != nil {
 			sh.options.ResponseErrorHandlerFunc(w, r, err)
 		}
 	} else if response != nil {
@@ -6760,25 +9459,25 @@ func (sh *strictHandler) CreateTimeline(w http.ResponseWriter, r *http.Request)
 	}
 }
 
-// DeleteTimeline operation middleware
-func (sh *strictHandler) DeleteTimeline(w http.ResponseWriter, r *http.Request, id string) {
-	var request DeleteTimelineRequestObject
+// ListApiTokens operation middleware
+func (sh *strictHandler) ListApiTokens(w http.ResponseWriter, r *http.Request, params ListApiTokensParams) {
+	var request ListApiTokensRequestObject
 
-	request.Id = id
+	request.Params = params
 
 	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
-		return sh.ssi.DeleteTimeline(ctx, request.(DeleteTimelineRequestObject))
+		return sh.ssi.ListApiTokens(ctx, request.(ListApiTokensRequestObject))
 	}
 	for _, middleware := range sh.middlewares {
-		handler = middleware(handler, "DeleteTimeline")
+		handler = middleware(handler, "ListApiTokens")
 	}
 
 	response, err := handler(r.Context(), w, r, request)
 
 	if err != nil {
 		sh.options.ResponseErrorHandlerFunc(w, r, err)
-	} else if validResponse, ok := response.(DeleteTimelineResponseObject); ok {
-		if err := validResponse.VisitDeleteTimelineResponse(w); err != nil {
+	} else if validResponse, ok := response.(ListApiTokensResponseObject); ok {
+		if err := validResponse.VisitListApiTokensResponse(w); err != nil {
 			sh.options.ResponseErrorHandlerFunc(w, r, err)
 		}
 	} else if response != nil {
@@ -6786,25 +9485,30 @@ func (sh *strictHandler) DeleteTimeline(w http.ResponseWriter, r *http.Request,
 	}
 }
 
-// GetTimeline operation middleware
-func (sh *strictHandler) GetTimeline(w http.ResponseWriter, r *http.Request, id string) {
-	var request GetTimelineRequestObject
+// CreateApiToken operation middleware
+func (sh *strictHandler) CreateApiToken(w http.ResponseWriter, r *http.Request) {
+	var request CreateApiTokenRequestObject
 
-	request.Id = id
+	var body CreateApiTokenJSONRequestBody
+	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+		sh.options.RequestErrorHandlerFunc(w, r, fmt.Errorf("can't decode JSON body: %w", err))
+		return
+	}
+	request.Body = &body
 
 	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
-		return sh.ssi.GetTimeline(ctx, request.(GetTimelineRequestObject))
+		return sh.ssi.CreateApiToken(ctx, request.(CreateApiTokenRequestObject))
 	}
 	for _, middleware := range sh.middlewares {
-		handler = middleware(handler, "GetTimeline")
+		handler = middleware(handler, "CreateApiToken")
 	}
 
 	response, err := handler(r.Context(), w, r, request)
 
 	if err != nil {
 		sh.options.ResponseErrorHandlerFunc(w, r, err)
-	} else if validResponse, ok := response.(GetTimelineResponseObject); ok {
-		if err := validResponse.VisitGetTimelineResponse(w); err != nil {
+	} else if validResponse, ok := response.(CreateApiTokenResponseObject); ok {
+		if err := validResponse.VisitCreateApiTokenResponse(w); err != nil {
 			sh.options.ResponseErrorHandlerFunc(w, r, err)
 		}
 	} else if response != nil {
@@ -6812,32 +9516,25 @@ func (sh *strictHandler) GetTimeline(w http.ResponseWriter, r *http.Request, id
 	}
 }
 
-// UpdateTimeline operation middleware
-func (sh *strictHandler) UpdateTimeline(w http.ResponseWriter, r *http.Request, id string) {
-	var request UpdateTimelineRequestObject
+// DeleteApiToken operation middleware
+func (sh *strictHandler) DeleteApiToken(w http.ResponseWriter, r *http.Request, id string) {
+	var request DeleteApiTokenRequestObject
 
 	request.Id = id
 
-	var body UpdateTimelineJSONRequestBody
-	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
-		sh.options.RequestErrorHandlerFunc(w, r, fmt.Errorf("can't decode JSON body: %w", err))
-		return
-	}
-	request.Body = &body
-
 	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
-		return sh.ssi.UpdateTimeline(ctx, request.(UpdateTimelineRequestObject))
+		return sh.ssi.DeleteApiToken(ctx, request.(DeleteApiTokenRequestObject))
 	}
 	for _, middleware := range sh.middlewares {
-		handler = middleware(handler, "UpdateTimeline")
+		handler = middleware(handler, "DeleteApiToken")
 	}
 
 	response, err := handler(r.Context(), w, r, request)
 
 	if err != nil {
 		sh.options.ResponseErrorHandlerFunc(w, r, err)
-	} else if validResponse, ok := response.(UpdateTimelineResponseObject); ok {
-		if err := validResponse.VisitUpdateTimelineResponse(w); err != nil {
+	} else if validResponse, ok := response.(DeleteApiTokenResponseObject); ok {
+		if err := validResponse.VisitDeleteApiTokenResponse(w); err != nil {
 			sh.options.ResponseErrorHandlerFunc(w, r, err)
 		}
 	} else if response != nil {
@@ -7241,6 +9938,173 @@ func (sh *strictHandler) ListUserPermissions(w http.ResponseWriter, r *http.Requ
 	}
 }
 
+// DeleteUserSessions operation middleware
+func (sh *strictHandler) DeleteUserSessions(w http.ResponseWriter, r *http.Request, id string) {
+	var request DeleteUserSessionsRequestObject
+
+	request.Id = id
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.DeleteUserSessions(ctx, request.(DeleteUserSessionsRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "DeleteUserSessions")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(DeleteUserSessionsResponseObject); ok {
+		if err := validResponse.VisitDeleteUserSessionsResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// ListUserSessions operation middleware
+func (sh *strictHandler) ListUserSessions(w http.ResponseWriter, r *http.Request, id string, params ListUserSessionsParams) {
+	var request ListUserSessionsRequestObject
+
+	request.Id = id
+	request.Params = params
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.ListUserSessions(ctx, request.(ListUserSessionsRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "ListUserSessions")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(ListUserSessionsResponseObject); ok {
+		if err := validResponse.VisitListUserSessionsResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// DeleteUserSession operation middleware
+func (sh *strictHandler) DeleteUserSession(w http.ResponseWriter, r *http.Request, id string, sessionId string) {
+	var request DeleteUserSessionRequestObject
+
+	request.Id = id
+	request.SessionId = sessionId
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.DeleteUserSession(ctx, request.(DeleteUserSessionRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "DeleteUserSession")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(DeleteUserSessionResponseObject); ok {
+		if err := validResponse.VisitDeleteUserSessionResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// ListUserApiTokens operation middleware
+func (sh *strictHandler) ListUserApiTokens(w http.ResponseWriter, r *http.Request, id string, params ListUserApiTokensParams) {
+	var request ListUserApiTokensRequestObject
+
+	request.Id = id
+	request.Params = params
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.ListUserApiTokens(ctx, request.(ListUserApiTokensRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "ListUserApiTokens")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(ListUserApiTokensResponseObject); ok {
+		if err := validResponse.VisitListUserApiTokensResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// CreateUserApiToken operation middleware
+func (sh *strictHandler) CreateUserApiToken(w http.ResponseWriter, r *http.Request, id string) {
+	var request CreateUserApiTokenRequestObject
+
+	request.Id = id
+
+	var body CreateUserApiTokenJSONRequestBody
+	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+		sh.options.RequestErrorHandlerFunc(w, r, fmt.Errorf("can't decode JSON body: %w", err))
+		return
+	}
+	request.Body = &body
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.CreateUserApiToken(ctx, request.(CreateUserApiTokenRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "CreateUserApiToken")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(CreateUserApiTokenResponseObject); ok {
+		if err := validResponse.VisitCreateUserApiTokenResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// DeleteUserApiToken operation middleware
+func (sh *strictHandler) DeleteUserApiToken(w http.ResponseWriter, r *http.Request, id string, tokenId string) {
+	var request DeleteUserApiTokenRequestObject
+
+	request.Id = id
+	request.TokenId = tokenId
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.DeleteUserApiToken(ctx, request.(DeleteUserApiTokenRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "DeleteUserApiToken")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(DeleteUserApiTokenResponseObject); ok {
+		if err := validResponse.VisitDeleteUserApiTokenResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
 // ListWebhooks operation middleware
 func (sh *strictHandler) ListWebhooks(w http.ResponseWriter, r *http.Request, params ListWebhooksParams) {
 	var request ListWebhooksRequestObject
@@ -7382,3 +10246,110 @@ func (sh *strictHandler) UpdateWebhook(w http.ResponseWriter, r *http.Request, i
 		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
 	}
 }
+
+// ListWebhookDeliveries operation middleware
+func (sh *strictHandler) ListWebhookDeliveries(w http.ResponseWriter, r *http.Request, id string, params ListWebhookDeliveriesParams) {
+	var request ListWebhookDeliveriesRequestObject
+
+	request.Id = id
+	request.Params = params
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.ListWebhookDeliveries(ctx, request.(ListWebhookDeliveriesRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "ListWebhookDeliveries")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(ListWebhookDeliveriesResponseObject); ok {
+		if err := validResponse.VisitListWebhookDeliveriesResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// GetWebhookDelivery operation middleware
+func (sh *strictHandler) GetWebhookDelivery(w http.ResponseWriter, r *http.Request, id string, deliveryId string) {
+	var request GetWebhookDeliveryRequestObject
+
+	request.Id = id
+	request.DeliveryId = deliveryId
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.GetWebhookDelivery(ctx, request.(GetWebhookDeliveryRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "GetWebhookDelivery")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(GetWebhookDeliveryResponseObject); ok {
+		if err := validResponse.VisitGetWebhookDeliveryResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// RedeliverWebhookDelivery operation middleware
+func (sh *strictHandler) RedeliverWebhookDelivery(w http.ResponseWriter, r *http.Request, id string, deliveryId string) {
+	var request RedeliverWebhookDeliveryRequestObject
+
+	request.Id = id
+	request.DeliveryId = deliveryId
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.RedeliverWebhookDelivery(ctx, request.(RedeliverWebhookDeliveryRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "RedeliverWebhookDelivery")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(RedeliverWebhookDeliveryResponseObject); ok {
+		if err := validResponse.VisitRedeliverWebhookDeliveryResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// RotateWebhookSecret operation middleware
+func (sh *strictHandler) RotateWebhookSecret(w http.ResponseWriter, r *http.Request, id string) {
+	var request RotateWebhookSecretRequestObject
+
+	request.Id = id
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.RotateWebhookSecret(ctx, request.(RotateWebhookSecretRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "RotateWebhookSecret")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(RotateWebhookSecretResponseObject); ok {
+		if err := validResponse.VisitRotateWebhookSecretResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
@@ -1,33 +1,92 @@
 package action
 
 import (
+	"bytes"
 	"context"
 	"encoding/json"
 	"fmt"
+	"log/slog"
 	"time"
 
 	"github.com/SecurityBrewery/catalyst/app/auth"
 	"github.com/SecurityBrewery/catalyst/app/database/sqlc"
+	"github.com/SecurityBrewery/catalyst/app/mail"
+	"github.com/SecurityBrewery/catalyst/app/reaction/action/catalyst"
+	"github.com/SecurityBrewery/catalyst/app/reaction/action/email"
 	"github.com/SecurityBrewery/catalyst/app/reaction/action/python"
 	"github.com/SecurityBrewery/catalyst/app/reaction/action/webhook"
+	"github.com/SecurityBrewery/catalyst/app/secret"
 )
 
-func Run(ctx context.Context, url string, queries *sqlc.Queries, actionName string, actionData, payload json.RawMessage) ([]byte, error) {
+// Runner runs the actions of reactions and provides them with the
+// dependencies they need.
+type Runner struct {
+	queries *sqlc.Queries
+	records catalyst.Records
+	venvs   *python.Cache
+	mailer  *mail.Mailer
+	secrets *secret.Cipher
+}
+
+func NewRunner(queries *sqlc.Queries, venvs *python.Cache, mailer *mail.Mailer, secrets *secret.Cipher) *Runner {
+	return &Runner{
+		queries: queries,
+		venvs:   venvs,
+		mailer:  mailer,
+		secrets: secrets,
+	}
+}
+
+// SetRecords sets the service that the native actions use to create and
+// update records. The service is created after the runner, as it runs
+// reactions with the runner.
+func (r *Runner) SetRecords(records catalyst.Records) {
+	r.records = records
+}
+
+func (r *Runner) Run(ctx context.Context, url string, actionName string, actionData, payload json.RawMessage) ([]byte, error) {
 	action, err := decode(actionName, actionData)
 	if err != nil {
 		return nil, err
 	}
 
 	if a, ok := action.(authenticatedAction); ok {
-		token, err := systemToken(ctx, queries)
+		secretEnv, err := r.secrets.Env(ctx, r.queries, a.SecretNames())
+		if err != nil {
+			return nil, fmt.Errorf("failed to get secrets: %w", err)
+		}
+
+		token, id, err := systemToken(ctx, r.queries, a.Scopes(), a.TokenLifetime())
 		if err != nil {
 			return nil, fmt.Errorf("failed to get system token: %w", err)
 		}
 
-		a.SetEnv([]string{
+		defer func() {
+			if err := auth.RevokeActionToken(context.WithoutCancel(ctx), r.queries, id); err != nil {
+				slog.ErrorContext(ctx, "failed to revoke system token", "error", err)
+			}
+		}()
+
+		a.SetEnv(append([]string{
 			"CATALYST_APP_URL=" + url,
 			"CATALYST_TOKEN=" + token,
-		})
+		}, secretEnv...))
+	}
+
+	if a, ok := action.(sourcedAction); ok {
+		a.SetAppURL(url)
+	}
+
+	if a, ok := action.(cachedAction); ok && r.venvs != nil {
+		a.SetCache(r.venvs)
+	}
+
+	if a, ok := action.(nativeAction); ok {
+		a.SetBackend(r.queries, r.records)
+	}
+
+	if a, ok := action.(mailAction); ok && r.mailer != nil {
+		a.SetMailer(r.mailer, r.queries)
 	}
 
 	return action.Run(ctx, payload)
@@ -37,8 +96,59 @@ type action interface {
 	Run(ctx context.Context, payload json.RawMessage) ([]byte, error)
 }
 
+// authenticatedAction gets a token for the API with its scopes, which is
+// valid for its lifetime or until the action finishes, and its secrets as
+// environment variables.
 type authenticatedAction interface {
 	SetEnv(env []string)
+	Scopes() []string
+	TokenLifetime() time.Duration
+	SecretNames() []string
+}
+
+// sourcedAction identifies Catalyst by its URL, e.g. as CloudEvents source.
+type sourcedAction interface {
+	SetAppURL(url string)
+}
+
+type cachedAction interface {
+	SetCache(cache *python.Cache)
+}
+
+type nativeAction interface {
+	SetBackend(queries *sqlc.Queries, records catalyst.Records)
+}
+
+type mailAction interface {
+	SetMailer(mailer *mail.Mailer, queries *sqlc.Queries)
+}
+
+type validatedAction interface {
+	Validate() error
+}
+
+// Validate checks that the action exists, that its data can be decoded
+// without unknown fields and is valid.
+func Validate(actionName string, actionData json.RawMessage) error {
+	action, err := decode(actionName, actionData)
+	if err != nil {
+		return err
+	}
+
+	decoder := json.NewDecoder(bytes.NewReader(actionData))
+	decoder.DisallowUnknownFields()
+
+	if err := decoder.Decode(action); err != nil {
+		return fmt.Errorf("invalid %s action data: %w", actionName, err)
+	}
+
+	if a, ok := action.(validatedAction); ok {
+		if err := a.Validate(); err != nil {
+			return fmt.Errorf("invalid %s action data: %w", actionName, err)
+		}
+	}
+
+	return nil
 }
 
 func decode(actionName string, actionData json.RawMessage) (action, error) {
@@ -56,17 +166,68 @@ func decode(actionName string, actionData json.RawMessage) (action, error) {
 			return nil, err
 		}
 
+		return &reaction, nil
+	case "email":
+		var reaction email.Email
+		if err := json.Unmarshal(actionData, &reaction); err != nil {
+			return nil, err
+		}
+
+		return &reaction, nil
+	case "create_ticket":
+		var reaction catalyst.CreateTicket
+		if err := json.Unmarshal(actionData, &reaction); err != nil {
+			return nil, err
+		}
+
+		return &reaction, nil
+	case "update_ticket":
+		var reaction catalyst.UpdateTicket
+		if err := json.Unmarshal(actionData, &reaction); err != nil {
+			return nil, err
+		}
+
+		return &reaction, nil
+	case "assign_owner":
+		var reaction catalyst.AssignOwner
+		if err := json.Unmarshal(actionData, &reaction); err != nil {
+			return nil, err
+		}
+
+		return &reaction, nil
+	case "add_comment":
+		var reaction catalyst.AddComment
+		if err := json.Unmarshal(actionData, &reaction); err != nil {
+			return nil, err
+		}
+
+		return &reaction, nil
+	case "add_task":
+		var reaction catalyst.AddTask
+		if err := json.Unmarshal(actionData, &reaction); err != nil {
+			return nil, err
+		}
+
+		return &reaction, nil
+	case "add_timeline":
+		var reaction catalyst.AddTimeline
+		if err := json.Unmarshal(actionData, &reaction); err != nil {
+			return nil, err
+		}
+
 		return &reaction, nil
 	default:
 		return nil, fmt.Errorf("action %q not found", actionName)
 	}
 }
 
-func systemToken(ctx context.Context, queries *sqlc.Queries) (string, error) {
+// systemToken creates a token of the system user with the given scopes. It
+// returns the token and its ID to revoke it.
+func systemToken(ctx context.Context, queries *sqlc.Queries, scopes []string, lifetime time.Duration) (string, string, error) {
 	user, err := queries.SystemUser(ctx)
 	if err != nil {
-		return "", fmt.Errorf("failed to find system auth record: %w", err)
+		return "", "", fmt.Errorf("failed to find system auth record: %w", err)
 	}
 
-	return auth.CreateAccessToken(ctx, &user, auth.All(), time.Hour, queries)
+	return auth.CreateActionToken(ctx, &user, scopes, lifetime, queries)
 }
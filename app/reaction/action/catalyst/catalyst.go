@@ -0,0 +1,86 @@
+// Package catalyst contains native actions that modify Catalyst records
+// through the service of the REST API instead of sending HTTP requests.
+// Like requests, they are validated, publish the record hooks, so that other
+// reactions and webhooks are triggered, and are recorded in the change feed.
+// The reaction of the action itself is not triggered again.
+//
+// Like the token of Python actions, the actions are limited to the scopes
+// in their permissions, e.g. ticket:write to create or update tickets.
+//
+// All string parameters are Go templates that are rendered with the trigger
+// payload, e.g. {{.record.id}} for the ID of the record that triggered a hook.
+package catalyst
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+
+	"github.com/SecurityBrewery/catalyst/app/auth"
+	"github.com/SecurityBrewery/catalyst/app/auth/usercontext"
+	"github.com/SecurityBrewery/catalyst/app/database/sqlc"
+	"github.com/SecurityBrewery/catalyst/app/openapi"
+	"github.com/SecurityBrewery/catalyst/app/reaction/action/render"
+)
+
+// Records creates and updates the records of the native actions. It is
+// implemented by the service of the REST API.
+type Records interface {
+	CreateTicket(ctx context.Context, request openapi.CreateTicketRequestObject) (openapi.CreateTicketResponseObject, error)
+	UpdateTicket(ctx context.Context, request openapi.UpdateTicketRequestObject) (openapi.UpdateTicketResponseObject, error)
+	CreateComment(ctx context.Context, request openapi.CreateCommentRequestObject) (openapi.CreateCommentResponseObject, error)
+	CreateTask(ctx context.Context, request openapi.CreateTaskRequestObject) (openapi.CreateTaskResponseObject, error)
+	CreateTimeline(ctx context.Context, request openapi.CreateTimelineRequestObject) (openapi.CreateTimelineResponseObject, error)
+}
+
+type backend struct {
+	// Permissions are the scopes of the action. Without permissions, the
+	// action cannot modify any records.
+	Permissions []string `json:"permissions,omitempty"`
+
+	queries *sqlc.Queries
+	records Records
+}
+
+// Validate checks the permissions of the action.
+func (b *backend) Validate() error {
+	return auth.ValidatePermissions(b.Permissions)
+}
+
+func (b *backend) SetBackend(queries *sqlc.Queries, records Records) {
+	b.queries = queries
+	b.records = records
+}
+
+// systemContext checks that the permissions of the action include the
+// required scopes and returns a context with the system user and the
+// permissions, which are used for the published hooks.
+func (b *backend) systemContext(ctx context.Context, requiredScopes ...string) (context.Context, *sqlc.User, error) {
+	if b.queries == nil || b.records == nil {
+		return nil, nil, fmt.Errorf("action is not initialized")
+	}
+
+	if !auth.HasScope(b.Permissions, requiredScopes) {
+		return nil, nil, fmt.Errorf("missing required scopes: %v", requiredScopes)
+	}
+
+	user, err := b.queries.SystemUser(ctx)
+	if err != nil {
+		return nil, nil, fmt.Errorf("failed to find system user: %w", err)
+	}
+
+	ctx = usercontext.UserContext(ctx, &user)
+	ctx = usercontext.PermissionContext(ctx, b.Permissions)
+
+	return ctx, &user, nil
+}
+
+// newRenderer returns a renderer for the templated parameters of an action.
+func newRenderer(payload json.RawMessage) (*render.Renderer, error) {
+	data, err := render.Data(payload)
+	if err != nil {
+		return nil, err
+	}
+
+	return render.New(data, nil), nil
+}
@@ -0,0 +1,274 @@
+package catalyst_test
+
+import (
+	"context"
+	"encoding/json"
+	"sync"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/SecurityBrewery/catalyst/app/auth/usercontext"
+	"github.com/SecurityBrewery/catalyst/app/data"
+	"github.com/SecurityBrewery/catalyst/app/database/sqlc"
+	"github.com/SecurityBrewery/catalyst/app/hook"
+	"github.com/SecurityBrewery/catalyst/app/reaction/action/catalyst"
+	"github.com/SecurityBrewery/catalyst/app/service"
+)
+
+const payload = `{"action":"update","collection":"tickets","record":{"id":"test-ticket","name":"Test Ticket","type":"incident"},"auth":{"id":"u_bob_analyst"}}`
+
+type recorder struct {
+	mu     sync.Mutex
+	events []string
+}
+
+func record(t *testing.T, queries *sqlc.Queries) (*service.Service, *recorder) {
+	t.Helper()
+
+	hooks := hook.NewHooks()
+	r := &recorder{}
+
+	subscribe := func(name string, h *hook.Hook) {
+		h.Subscribe(func(ctx context.Context, table string, _ any) {
+			user, ok := usercontext.UserFromContext(ctx)
+			assert.True(t, ok)
+			assert.Equal(t, "system", user.ID)
+
+			r.mu.Lock()
+			defer r.mu.Unlock()
+
+			r.events = append(r.events, name+":"+table)
+		})
+	}
+
+	subscribe("before_create", hooks.OnRecordBeforeCreateRequest)
+	subscribe("after_create", hooks.OnRecordAfterCreateRequest)
+	subscribe("before_update", hooks.OnRecordBeforeUpdateRequest)
+	subscribe("after_update", hooks.OnRecordAfterUpdateRequest)
+
+	return service.New(queries, hooks, nil, nil, nil, nil, nil), r
+}
+
+func TestCreateTicket(t *testing.T) {
+	t.Parallel()
+
+	queries := data.NewTestDB(t, t.TempDir())
+	records, events := record(t, queries)
+
+	a := &catalyst.CreateTicket{
+		Name:  "Follow up: {{.record.name}}",
+		Type:  "alert",
+		State: map[string]any{"source": "{{.record.id}}", "count": 1.0},
+	}
+	a.Permissions = []string{"ticket:write"}
+	a.SetBackend(queries, records)
+
+	output, err := a.Run(t.Context(), json.RawMessage(payload))
+	require.NoError(t, err)
+
+	var ticket struct {
+		ID    string         `json:"id"`
+		Name  string         `json:"name"`
+		Open  bool           `json:"open"`
+		State map[string]any `json:"state"`
+	}
+	require.NoError(t, json.Unmarshal(output, &ticket))
+
+	assert.Equal(t, "Follow up: Test Ticket", ticket.Name)
+	assert.True(t, ticket.Open)
+	assert.Equal(t, map[string]any{"source": "test-ticket", "count": 1.0}, ticket.State)
+
+	stored, err := queries.Ticket(t.Context(), ticket.ID)
+	require.NoError(t, err)
+	assert.Equal(t, "alert", stored.Type)
+
+	assert.Equal(t, []string{"before_create:tickets", "after_create:tickets"}, events.events)
+}
+
+func TestUpdateTicket(t *testing.T) {
+	t.Parallel()
+
+	queries := data.NewTestDB(t, t.TempDir())
+	records, events := record(t, queries)
+
+	closed := false
+	resolution := "handled by {{.auth.id}}"
+
+	a := &catalyst.UpdateTicket{
+		Ticket:     "{{.record.id}}",
+		Open:       &closed,
+		Resolution: &resolution,
+	}
+	a.Permissions = []string{"ticket:write"}
+	a.SetBackend(queries, records)
+
+	_, err := a.Run(t.Context(), json.RawMessage(payload))
+	require.NoError(t, err)
+
+	ticket, err := queries.Ticket(t.Context(), "test-ticket")
+	require.NoError(t, err)
+	assert.False(t, ticket.Open)
+	require.NotNil(t, ticket.Resolution)
+	assert.Equal(t, "handled by u_bob_analyst", *ticket.Resolution)
+	assert.Equal(t, "Test Ticket", ticket.Name)
+
+	assert.Equal(t, []string{"before_update:tickets", "after_update:tickets"}, events.events)
+}
+
+func TestAssignOwner(t *testing.T) {
+	t.Parallel()
+
+	queries := data.NewTestDB(t, t.TempDir())
+	records, _ := record(t, queries)
+
+	a := &catalyst.AssignOwner{Ticket: "{{.record.id}}", Owner: "u_admin"}
+	a.Permissions = []string{"ticket:write"}
+	a.SetBackend(queries, records)
+
+	_, err := a.Run(t.Context(), json.RawMessage(payload))
+	require.NoError(t, err)
+
+	ticket, err := queries.Ticket(t.Context(), "test-ticket")
+	require.NoError(t, err)
+	require.NotNil(t, ticket.Owner)
+	assert.Equal(t, "u_admin", *ticket.Owner)
+
+	a = &catalyst.AssignOwner{Ticket: "{{.record.id}}"}
+	a.Permissions = []string{"ticket:write"}
+	a.SetBackend(queries, records)
+
+	_, err = a.Run(t.Context(), json.RawMessage(payload))
+	require.ErrorContains(t, err, "missing owner")
+}
+
+func TestAddComment(t *testing.T) {
+	t.Parallel()
+
+	queries := data.NewTestDB(t, t.TempDir())
+	records, events := record(t, queries)
+
+	a := &catalyst.AddComment{Ticket: "{{.record.id}}", Message: "Type is {{.record.type}}"}
+	a.Permissions = []string{"ticket:write"}
+	a.SetBackend(queries, records)
+
+	output, err := a.Run(t.Context(), json.RawMessage(payload))
+	require.NoError(t, err)
+
+	var comment struct {
+		Author  string `json:"author"`
+		Message string `json:"message"`
+		Ticket  string `json:"ticket"`
+	}
+	require.NoError(t, json.Unmarshal(output, &comment))
+
+	assert.Equal(t, "system", comment.Author)
+	assert.Equal(t, "Type is incident", comment.Message)
+	assert.Equal(t, "test-ticket", comment.Ticket)
+
+	assert.Equal(t, []string{"before_create:comments", "after_create:comments"}, events.events)
+}
+
+func TestAddTask(t *testing.T) {
+	t.Parallel()
+
+	queries := data.NewTestDB(t, t.TempDir())
+	records, events := record(t, queries)
+
+	owner := "{{.auth.id}}"
+
+	a := &catalyst.AddTask{Ticket: "{{.record.id}}", Name: "Triage", Owner: &owner}
+	a.Permissions = []string{"ticket:write"}
+	a.SetBackend(queries, records)
+
+	_, err := a.Run(t.Context(), json.RawMessage(payload))
+	require.NoError(t, err)
+
+	tasks, err := queries.ListTasks(t.Context(), sqlc.ListTasksParams{Ticket: "test-ticket", Limit: 100})
+	require.NoError(t, err)
+
+	var found bool
+
+	for _, task := range tasks {
+		if task.Name == "Triage" {
+			found = true
+
+			assert.True(t, task.Open)
+			require.NotNil(t, task.Owner)
+			assert.Equal(t, "u_bob_analyst", *task.Owner)
+		}
+	}
+
+	assert.True(t, found)
+	assert.Equal(t, []string{"before_create:tasks", "after_create:tasks"}, events.events)
+}
+
+func TestAddTimeline(t *testing.T) {
+	t.Parallel()
+
+	queries := data.NewTestDB(t, t.TempDir())
+	records, events := record(t, queries)
+
+	a := &catalyst.AddTimeline{Ticket: "{{.record.id}}", Message: "Reaction ran", Time: "2025-01-02T03:04:05Z"}
+	a.Permissions = []string{"ticket:write"}
+	a.SetBackend(queries, records)
+
+	output, err := a.Run(t.Context(), json.RawMessage(payload))
+	require.NoError(t, err)
+	assert.Contains(t, string(output), `"time":"2025-01-02T03:04:05Z"`)
+
+	assert.Equal(t, []string{"before_create:timeline", "after_create:timeline"}, events.events)
+
+	a = &catalyst.AddTimeline{Ticket: "{{.record.id}}", Message: "Reaction ran", Time: "yesterday"}
+	a.Permissions = []string{"ticket:write"}
+	a.SetBackend(queries, records)
+
+	_, err = a.Run(t.Context(), json.RawMessage(payload))
+	require.ErrorContains(t, err, "invalid time")
+}
+
+func TestTemplateErrors(t *testing.T) {
+	t.Parallel()
+
+	queries := data.NewTestDB(t, t.TempDir())
+	records, events := record(t, queries)
+
+	a := &catalyst.AddComment{Ticket: "{{.record.missing}}", Message: "test"}
+	a.Permissions = []string{"ticket:write"}
+	a.SetBackend(queries, records)
+
+	_, err := a.Run(t.Context(), json.RawMessage(payload))
+	require.ErrorContains(t, err, `failed to render ticket`)
+
+	a = &catalyst.AddComment{Ticket: "{{.record.id", Message: "test"}
+	a.Permissions = []string{"ticket:write"}
+	a.SetBackend(queries, records)
+
+	_, err = a.Run(t.Context(), json.RawMessage(payload))
+	require.ErrorContains(t, err, `invalid template for ticket`)
+
+	assert.Empty(t, events.events)
+}
+
+func TestPermissions(t *testing.T) {
+	t.Parallel()
+
+	queries := data.NewTestDB(t, t.TempDir())
+	records, events := record(t, queries)
+
+	a := &catalyst.AddComment{Ticket: "{{.record.id}}", Message: "test"}
+	a.Permissions = []string{"ticket:read"}
+	a.SetBackend(queries, records)
+
+	_, err := a.Run(t.Context(), json.RawMessage(payload))
+	require.ErrorContains(t, err, "missing required scopes: [ticket:write]")
+
+	a = &catalyst.AddComment{Ticket: "{{.record.id}}", Message: "test"}
+	a.SetBackend(queries, records)
+
+	_, err = a.Run(t.Context(), json.RawMessage(payload))
+	require.ErrorContains(t, err, "missing required scopes: [ticket:write]", "actions without permissions cannot modify records")
+
+	assert.Empty(t, events.events)
+}
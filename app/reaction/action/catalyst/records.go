@@ -0,0 +1,140 @@
+package catalyst
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"time"
+
+	"github.com/SecurityBrewery/catalyst/app/auth"
+	"github.com/SecurityBrewery/catalyst/app/openapi"
+)
+
+// AddComment adds a comment to a ticket. The author defaults to the system
+// user.
+type AddComment struct {
+	backend
+
+	Ticket  string `json:"ticket"`
+	Message string `json:"message"`
+	Author  string `json:"author"`
+}
+
+func (a *AddComment) Run(ctx context.Context, payload json.RawMessage) ([]byte, error) {
+	ctx, system, err := a.systemContext(ctx, auth.TicketWritePermission)
+	if err != nil {
+		return nil, err
+	}
+
+	r, err := newRenderer(payload)
+	if err != nil {
+		return nil, err
+	}
+
+	body := openapi.NewComment{
+		Ticket:  r.String("ticket", a.Ticket),
+		Message: r.String("message", a.Message),
+		Author:  r.String("author", a.Author),
+	}
+
+	if err := r.Err(); err != nil {
+		return nil, err
+	}
+
+	if body.Author == "" {
+		body.Author = system.ID
+	}
+
+	response, err := a.records.CreateComment(ctx, openapi.CreateCommentRequestObject{Body: &body})
+	if err != nil {
+		return nil, fmt.Errorf("failed to create comment: %w", err)
+	}
+
+	return json.Marshal(response)
+}
+
+// AddTask adds an open task to a ticket.
+type AddTask struct {
+	backend
+
+	Ticket string  `json:"ticket"`
+	Name   string  `json:"name"`
+	Owner  *string `json:"owner"`
+}
+
+func (a *AddTask) Run(ctx context.Context, payload json.RawMessage) ([]byte, error) {
+	ctx, _, err := a.systemContext(ctx, auth.TicketWritePermission)
+	if err != nil {
+		return nil, err
+	}
+
+	r, err := newRenderer(payload)
+	if err != nil {
+		return nil, err
+	}
+
+	body := openapi.NewTask{
+		Ticket: r.String("ticket", a.Ticket),
+		Name:   r.String("name", a.Name),
+		Owner:  r.Pointer("owner", a.Owner),
+		Open:   true,
+	}
+
+	if err := r.Err(); err != nil {
+		return nil, err
+	}
+
+	response, err := a.records.CreateTask(ctx, openapi.CreateTaskRequestObject{Body: &body})
+	if err != nil {
+		return nil, fmt.Errorf("failed to create task: %w", err)
+	}
+
+	return json.Marshal(response)
+}
+
+// AddTimeline adds a timeline entry to a ticket. The time is parsed as
+// RFC 3339 and defaults to the current time.
+type AddTimeline struct {
+	backend
+
+	Ticket  string `json:"ticket"`
+	Message string `json:"message"`
+	Time    string `json:"time"`
+}
+
+func (a *AddTimeline) Run(ctx context.Context, payload json.RawMessage) ([]byte, error) {
+	ctx, _, err := a.systemContext(ctx, auth.TicketWritePermission)
+	if err != nil {
+		return nil, err
+	}
+
+	r, err := newRenderer(payload)
+	if err != nil {
+		return nil, err
+	}
+
+	body := openapi.NewTimelineEntry{
+		Ticket:  r.String("ticket", a.Ticket),
+		Message: r.String("message", a.Message),
+		Time:    time.Now().UTC(),
+	}
+
+	timeString := r.String("time", a.Time)
+
+	if err := r.Err(); err != nil {
+		return nil, err
+	}
+
+	if timeString != "" {
+		if body.Time, err = time.Parse(time.RFC3339, timeString); err != nil {
+			return nil, fmt.Errorf("invalid time: %w", err)
+		}
+	}
+
+	response, err := a.records.CreateTimeline(ctx, openapi.CreateTimelineRequestObject{Body: &body})
+	if err != nil {
+		return nil, fmt.Errorf("failed to create timeline entry: %w", err)
+	}
+
+	return json.Marshal(response)
+}
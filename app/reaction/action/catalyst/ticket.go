@@ -0,0 +1,152 @@
+package catalyst
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+
+	"github.com/SecurityBrewery/catalyst/app/auth"
+	"github.com/SecurityBrewery/catalyst/app/openapi"
+)
+
+// CreateTicket creates a new ticket.
+type CreateTicket struct {
+	backend
+
+	Name        string         `json:"name"`
+	Type        string         `json:"type"`
+	Description string         `json:"description"`
+	Owner       *string        `json:"owner"`
+	Open        *bool          `json:"open"`
+	State       map[string]any `json:"state"`
+}
+
+func (a *CreateTicket) Run(ctx context.Context, payload json.RawMessage) ([]byte, error) {
+	ctx, _, err := a.systemContext(ctx, auth.TicketWritePermission)
+	if err != nil {
+		return nil, err
+	}
+
+	r, err := newRenderer(payload)
+	if err != nil {
+		return nil, err
+	}
+
+	open := true
+	if a.Open != nil {
+		open = *a.Open
+	}
+
+	state, _ := r.Value("state", a.State).(map[string]any)
+
+	body := openapi.NewTicket{
+		Name:        r.String("name", a.Name),
+		Type:        r.String("type", a.Type),
+		Description: r.String("description", a.Description),
+		Owner:       r.Pointer("owner", a.Owner),
+		Open:        open,
+		State:       state,
+	}
+
+	if err := r.Err(); err != nil {
+		return nil, err
+	}
+
+	response, err := a.records.CreateTicket(ctx, openapi.CreateTicketRequestObject{Body: &body})
+	if err != nil {
+		return nil, fmt.Errorf("failed to create ticket: %w", err)
+	}
+
+	return json.Marshal(response)
+}
+
+// UpdateTicket updates the given fields of a ticket.
+type UpdateTicket struct {
+	backend
+
+	Ticket      string         `json:"ticket"`
+	Name        *string        `json:"name"`
+	Description *string        `json:"description"`
+	Owner       *string        `json:"owner"`
+	Open        *bool          `json:"open"`
+	Resolution  *string        `json:"resolution"`
+	State       map[string]any `json:"state"`
+}
+
+func (a *UpdateTicket) Run(ctx context.Context, payload json.RawMessage) ([]byte, error) {
+	r, err := newRenderer(payload)
+	if err != nil {
+		return nil, err
+	}
+
+	var state *map[string]any
+	if a.State != nil {
+		rendered, _ := r.Value("state", a.State).(map[string]any)
+		state = &rendered
+	}
+
+	body := openapi.TicketUpdate{
+		Name:        r.Pointer("name", a.Name),
+		Description: r.Pointer("description", a.Description),
+		Owner:       r.Pointer("owner", a.Owner),
+		Open:        a.Open,
+		Resolution:  r.Pointer("resolution", a.Resolution),
+		State:       state,
+	}
+
+	id := r.String("ticket", a.Ticket)
+
+	if err := r.Err(); err != nil {
+		return nil, err
+	}
+
+	return a.update(ctx, id, &body)
+}
+
+// AssignOwner sets the owner of a ticket.
+type AssignOwner struct {
+	backend
+
+	Ticket string `json:"ticket"`
+	Owner  string `json:"owner"`
+}
+
+func (a *AssignOwner) Run(ctx context.Context, payload json.RawMessage) ([]byte, error) {
+	r, err := newRenderer(payload)
+	if err != nil {
+		return nil, err
+	}
+
+	id := r.String("ticket", a.Ticket)
+	owner := r.String("owner", a.Owner)
+
+	if err := r.Err(); err != nil {
+		return nil, err
+	}
+
+	if owner == "" {
+		return nil, fmt.Errorf("missing owner")
+	}
+
+	u := UpdateTicket{backend: a.backend}
+
+	return u.update(ctx, id, &openapi.TicketUpdate{Owner: &owner})
+}
+
+func (a *UpdateTicket) update(ctx context.Context, id string, body *openapi.TicketUpdate) ([]byte, error) {
+	ctx, _, err := a.systemContext(ctx, auth.TicketWritePermission)
+	if err != nil {
+		return nil, err
+	}
+
+	if id == "" {
+		return nil, fmt.Errorf("missing ticket")
+	}
+
+	response, err := a.records.UpdateTicket(ctx, openapi.UpdateTicketRequestObject{Id: id, Body: body})
+	if err != nil {
+		return nil, fmt.Errorf("failed to update ticket %s: %w", id, err)
+	}
+
+	return json.Marshal(response)
+}
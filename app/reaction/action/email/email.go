@@ -0,0 +1,179 @@
+package email
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	htmltemplate "html/template"
+	"strings"
+	texttemplate "text/template"
+
+	"github.com/SecurityBrewery/catalyst/app/database/sqlc"
+	"github.com/SecurityBrewery/catalyst/app/mail"
+	"github.com/SecurityBrewery/catalyst/app/pointer"
+	"github.com/SecurityBrewery/catalyst/app/reaction/action/render"
+)
+
+// Email sends a mail with the configured SMTP settings. All fields are
+// templates that are rendered with the trigger payload. If the payload
+// belongs to a ticket, the ticket is available as {{.ticket}}, and the
+// function userEmail returns the email address of a user ID, e.g.
+// {{userEmail .ticket.owner}}.
+type Email struct {
+	To      []string `json:"to"`
+	Subject string   `json:"subject"`
+	Body    string   `json:"body"`
+	HTML    string   `json:"html"`
+
+	mailer  *mail.Mailer
+	queries *sqlc.Queries
+}
+
+// Message is a rendered email.
+type Message struct {
+	To      []string `json:"to"`
+	Subject string   `json:"subject"`
+	Body    string   `json:"body"`
+	HTML    string   `json:"html,omitempty"`
+}
+
+func (a *Email) SetMailer(mailer *mail.Mailer, queries *sqlc.Queries) {
+	a.mailer = mailer
+	a.queries = queries
+}
+
+func (a *Email) Run(ctx context.Context, payload json.RawMessage) ([]byte, error) {
+	if a.mailer == nil {
+		return nil, errors.New("mailer is not configured")
+	}
+
+	message, err := a.Render(ctx, payload)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := a.mailer.SendMany(ctx, message.To, message.Subject, message.Body, message.HTML); err != nil {
+		return nil, err
+	}
+
+	return json.Marshal(message)
+}
+
+// Render renders the mail for the given payload without sending it.
+func (a *Email) Render(ctx context.Context, payload json.RawMessage) (*Message, error) {
+	data, err := a.templateData(ctx, payload)
+	if err != nil {
+		return nil, err
+	}
+
+	funcs := texttemplate.FuncMap{
+		"userEmail": func(id string) (string, error) { return a.userEmail(ctx, id) },
+	}
+
+	message := &Message{}
+
+	for i, to := range a.To {
+		rendered, err := render.Text(fmt.Sprintf("to[%d]", i), to, funcs, data)
+		if err != nil {
+			return nil, err
+		}
+
+		for address := range strings.SplitSeq(rendered, ",") {
+			if address = strings.TrimSpace(address); address != "" {
+				message.To = append(message.To, address)
+			}
+		}
+	}
+
+	if len(message.To) == 0 {
+		return nil, errors.New("no recipients")
+	}
+
+	if message.Subject, err = render.Text("subject", a.Subject, funcs, data); err != nil {
+		return nil, err
+	}
+
+	if message.Body, err = render.Text("body", a.Body, funcs, data); err != nil {
+		return nil, err
+	}
+
+	if a.HTML != "" {
+		tmpl, err := htmltemplate.New("html").Funcs(funcs).Option("missingkey=error").Parse(a.HTML)
+		if err != nil {
+			return nil, fmt.Errorf("invalid template for html: %w", err)
+		}
+
+		var sb strings.Builder
+		if err := tmpl.Execute(&sb, data); err != nil {
+			return nil, fmt.Errorf("failed to render html: %w", err)
+		}
+
+		message.HTML = sb.String()
+	}
+
+	return message, nil
+}
+
+// templateData returns the payload and, if the record belongs to a ticket,
+// the ticket.
+func (a *Email) templateData(ctx context.Context, payload json.RawMessage) (map[string]any, error) {
+	data := map[string]any{}
+
+	if len(payload) > 0 {
+		if err := json.Unmarshal(payload, &data); err != nil {
+			return nil, fmt.Errorf("failed to unmarshal payload: %w", err)
+		}
+	}
+
+	record, _ := data["record"].(map[string]any)
+
+	var ticketID string
+
+	switch {
+	case data["collection"] == "tickets":
+		ticketID, _ = record["id"].(string)
+	case record != nil:
+		ticketID, _ = record["ticket"].(string)
+	}
+
+	if ticketID == "" || a.queries == nil {
+		return data, nil
+	}
+
+	ticket, err := a.queries.Ticket(ctx, ticketID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get ticket %s: %w", ticketID, err)
+	}
+
+	var state map[string]any
+	_ = json.Unmarshal(ticket.State, &state)
+
+	data["ticket"] = map[string]any{
+		"id":          ticket.ID,
+		"name":        ticket.Name,
+		"type":        ticket.Type,
+		"description": ticket.Description,
+		"open":        ticket.Open,
+		"owner":       pointer.Dereference(ticket.Owner),
+		"resolution":  pointer.Dereference(ticket.Resolution),
+		"state":       state,
+		"created":     ticket.Created,
+		"updated":     ticket.Updated,
+	}
+
+	return data, nil
+}
+
+func (a *Email) userEmail(ctx context.Context, id string) (string, error) {
+	if id == "" || a.queries == nil {
+		return "", nil
+	}
+
+	user, err := a.queries.GetUser(ctx, id)
+	if err != nil {
+		return "", fmt.Errorf("failed to get user %s: %w", id, err)
+	}
+
+	return pointer.Dereference(user.Email), nil
+}
@@ -0,0 +1,87 @@
+package email_test
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/SecurityBrewery/catalyst/app/data"
+	"github.com/SecurityBrewery/catalyst/app/mail"
+	"github.com/SecurityBrewery/catalyst/app/reaction/action/email"
+)
+
+const payload = `{"action":"create","collection":"comments","record":{"id":"c_1","ticket":"test-ticket","message":"<b>urgent</b>"},"auth":{"id":"u_admin","name":"Admin"}}`
+
+func TestEmail_Render(t *testing.T) {
+	t.Parallel()
+
+	queries := data.NewTestDB(t, t.TempDir())
+
+	a := &email.Email{
+		To:      []string{"{{userEmail .ticket.owner}}", "soc@example.com, , lead@example.com"},
+		Subject: "New comment on {{.ticket.name}}",
+		Body:    "{{.auth.name}} wrote: {{.record.message}}",
+		HTML:    "<p>{{.auth.name}} wrote: {{.record.message}}</p>",
+	}
+	a.SetMailer(mail.New(queries), queries)
+
+	message, err := a.Render(t.Context(), json.RawMessage(payload))
+	require.NoError(t, err)
+
+	assert.Equal(t, []string{"analyst@catalyst-soar.com", "soc@example.com", "lead@example.com"}, message.To)
+	assert.Equal(t, "New comment on Test Ticket", message.Subject)
+	assert.Equal(t, "Admin wrote: <b>urgent</b>", message.Body)
+	assert.Equal(t, "<p>Admin wrote: &lt;b&gt;urgent&lt;/b&gt;</p>", message.HTML)
+}
+
+func TestEmail_Render_Errors(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name    string
+		email   email.Email
+		wantErr string
+	}{
+		{
+			name:    "missing key",
+			email:   email.Email{To: []string{"{{.record.missing_email}}"}},
+			wantErr: "failed to render to[0]",
+		},
+		{
+			name:    "empty recipients",
+			email:   email.Email{To: []string{" , "}},
+			wantErr: "no recipients",
+		},
+		{
+			name:    "invalid subject",
+			email:   email.Email{To: []string{"soc@example.com"}, Subject: "{{.record.id"},
+			wantErr: "invalid template for subject",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			_, err := tt.email.Render(t.Context(), json.RawMessage(payload))
+			require.ErrorContains(t, err, tt.wantErr)
+		})
+	}
+}
+
+func TestEmail_Run(t *testing.T) {
+	t.Parallel()
+
+	queries := data.NewTestDB(t, t.TempDir())
+
+	a := &email.Email{To: []string{"soc@example.com"}, Subject: "test", Body: "test"}
+
+	_, err := a.Run(t.Context(), json.RawMessage(payload))
+	require.ErrorContains(t, err, "mailer is not configured")
+
+	a.SetMailer(mail.New(queries), queries)
+
+	_, err = a.Run(t.Context(), json.RawMessage(payload))
+	require.ErrorContains(t, err, "SMTP is not enabled")
+}
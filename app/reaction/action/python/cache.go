@@ -0,0 +1,566 @@
+package python
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"io/fs"
+	"log/slog"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"slices"
+	"strings"
+	"sync"
+	"time"
+)
+
+const (
+	evictInterval = time.Hour
+	maxUnused     = 7 * 24 * time.Hour
+
+	requirementsFile = "requirements.txt"
+	versionFile      = "python_version"
+	readyFile        = ".ready"
+	lockSuffix       = ".lock"
+)
+
+// Cache stores Python virtual environments in the data directory, keyed by a
+// hash of the Python version and the requirements, so that reactions with
+// the same requirements share an environment instead of installing their
+// requirements on every run.
+//
+// Environments are locked per key with a lock file in the cache directory,
+// so the lock also holds against other processes like the venv commands:
+// creating or removing an environment requires exclusive access, while any
+// number of runs can use a ready environment at the same time.
+type Cache struct {
+	dir string
+
+	cancel context.CancelFunc
+	wg     sync.WaitGroup
+}
+
+// Environment describes a cached virtual environment.
+type Environment struct {
+	ID            string
+	PythonVersion string
+	Requirements  string
+	Created       time.Time
+	LastUsed      time.Time
+	Size          int64
+}
+
+func NewCache(dir string) (*Cache, error) {
+	dir = filepath.Join(dir, "venvs")
+
+	if err := os.MkdirAll(dir, 0o755); err != nil {
+		return nil, fmt.Errorf("failed to create venv cache directory: %w", err)
+	}
+
+	return &Cache{dir: dir}, nil
+}
+
+// Start periodically removes environments that have not been used for a week.
+func (c *Cache) Start(ctx context.Context) {
+	ctx, c.cancel = context.WithCancel(context.WithoutCancel(ctx))
+
+	c.wg.Add(1)
+
+	go func() {
+		defer c.wg.Done()
+
+		ticker := time.NewTicker(evictInterval)
+		defer ticker.Stop()
+
+		for {
+			select {
+			case <-ctx.Done():
+				return
+			case <-ticker.C:
+				if _, err := c.Evict(ctx, maxUnused); err != nil {
+					slog.ErrorContext(ctx, "failed to evict python environments", "error", err)
+				}
+			}
+		}
+	}()
+}
+
+// Stop stops the eviction loop.
+func (c *Cache) Stop() {
+	if c.cancel == nil {
+		return
+	}
+
+	c.cancel()
+	c.wg.Wait()
+}
+
+// Use runs fn with the path of a virtual environment that has the given
+// requirements installed. The environment is created if it does not exist
+// and cannot be evicted while fn is running.
+func (c *Cache) Use(ctx context.Context, requirements string, fn func(venv string) error) error {
+	version, err := pythonVersion(ctx)
+	if err != nil {
+		return err
+	}
+
+	id := key(version, requirements)
+
+	for {
+		unlock, err := c.lock(id, false)
+		if err != nil {
+			return err
+		}
+
+		if c.ready(id) {
+			c.touch(ctx, id)
+
+			err := fn(c.venv(id))
+
+			unlock()
+
+			return err
+		}
+
+		unlock()
+
+		unlock, err = c.lock(id, true)
+		if err != nil {
+			return err
+		}
+
+		if !c.ready(id) {
+			if err := c.create(ctx, id, version, requirements); err != nil {
+				unlock()
+
+				return err
+			}
+		}
+
+		unlock()
+	}
+}
+
+// Prewarm creates the environment for the given requirements, if it does not
+// exist yet, and returns it.
+func (c *Cache) Prewarm(ctx context.Context, requirements string) (*Environment, error) {
+	var id string
+
+	if err := c.Use(ctx, requirements, func(venv string) error {
+		id = filepath.Base(filepath.Dir(venv))
+
+		return nil
+	}); err != nil {
+		return nil, err
+	}
+
+	return c.Get(id)
+}
+
+// Get returns a single cached environment.
+func (c *Cache) Get(id string) (*Environment, error) {
+	if !validKey(id) {
+		return nil, fs.ErrNotExist
+	}
+
+	// don't leave a lock file behind for missing environments
+	if _, err := os.Stat(c.path(id)); err != nil {
+		return nil, err
+	}
+
+	unlock, err := c.lock(id, false)
+	if err != nil {
+		return nil, err
+	}
+	defer unlock()
+
+	if !c.ready(id) {
+		return nil, fs.ErrNotExist
+	}
+
+	return c.environment(id)
+}
+
+// List returns all ready environments, most recently used first.
+func (c *Cache) List() ([]*Environment, error) {
+	entries, err := os.ReadDir(c.dir)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read venv cache directory: %w", err)
+	}
+
+	environments := make([]*Environment, 0, len(entries))
+
+	for _, entry := range entries {
+		if !entry.IsDir() || !validKey(entry.Name()) {
+			continue
+		}
+
+		environment, err := c.Get(entry.Name())
+		if err != nil {
+			if errors.Is(err, fs.ErrNotExist) {
+				continue
+			}
+
+			return nil, err
+		}
+
+		environments = append(environments, environment)
+	}
+
+	slices.SortFunc(environments, func(a, b *Environment) int {
+		return b.LastUsed.Compare(a.LastUsed)
+	})
+
+	return environments, nil
+}
+
+// Delete removes a single environment and its lock file. It waits for
+// running actions that use the environment to finish.
+func (c *Cache) Delete(id string) error {
+	if !validKey(id) {
+		return fs.ErrNotExist
+	}
+
+	unlock, err := c.lock(id, true)
+	if err != nil {
+		return err
+	}
+	defer unlock()
+
+	// the lock file is removed while it is locked, waiting processes notice
+	// that and lock the new file instead
+	defer func() { _ = os.Remove(c.path(id) + lockSuffix) }()
+
+	if _, err := os.Stat(c.path(id)); err != nil {
+		return err
+	}
+
+	return removeAll(c.path(id))
+}
+
+// Purge removes all environments and returns the IDs of the removed ones.
+func (c *Cache) Purge(ctx context.Context) ([]string, error) {
+	return c.Evict(ctx, 0)
+}
+
+// Evict removes all environments that have not been used for at least
+// maxAge and returns the IDs of the removed ones.
+func (c *Cache) Evict(ctx context.Context, maxAge time.Duration) ([]string, error) {
+	entries, err := os.ReadDir(c.dir)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read venv cache directory: %w", err)
+	}
+
+	var removed []string
+
+	for _, entry := range entries {
+		if !entry.IsDir() || !validKey(entry.Name()) {
+			continue
+		}
+
+		info, err := entry.Info()
+		if err != nil {
+			continue
+		}
+
+		if time.Since(info.ModTime()) < maxAge {
+			continue
+		}
+
+		if err := c.Delete(entry.Name()); err != nil {
+			if errors.Is(err, fs.ErrNotExist) {
+				continue
+			}
+
+			return removed, fmt.Errorf("failed to remove python environment %s: %w", entry.Name(), err)
+		}
+
+		slog.InfoContext(ctx, "removed python environment", "id", entry.Name())
+
+		removed = append(removed, entry.Name())
+	}
+
+	return removed, nil
+}
+
+func (c *Cache) create(ctx context.Context, id, version, requirements string) error {
+	path := c.path(id)
+
+	// remove leftovers of an interrupted setup
+	if err := removeAll(path); err != nil {
+		return err
+	}
+
+	if err := os.MkdirAll(path, 0o755); err != nil {
+		return err
+	}
+
+	if err := os.WriteFile(filepath.Join(path, versionFile), []byte(version), 0o600); err != nil {
+		return err
+	}
+
+	if err := setup(ctx, path, requirements); err != nil {
+		_ = removeAll(path)
+
+		return err
+	}
+
+	// the environment is shared by all reactions with the same requirements,
+	// so scripts must not be able to change the installed packages
+	if err := chmodTree(c.venv(id), false); err != nil {
+		_ = removeAll(path)
+
+		return fmt.Errorf("failed to make python environment read-only: %w", err)
+	}
+
+	return os.WriteFile(filepath.Join(path, readyFile), nil, 0o600)
+}
+
+func (c *Cache) environment(id string) (*Environment, error) {
+	path := c.path(id)
+
+	info, err := os.Stat(path)
+	if err != nil {
+		return nil, err
+	}
+
+	ready, err := os.Stat(filepath.Join(path, readyFile))
+	if err != nil {
+		return nil, err
+	}
+
+	version, err := os.ReadFile(filepath.Join(path, versionFile))
+	if err != nil {
+		return nil, err
+	}
+
+	requirements, err := os.ReadFile(filepath.Join(path, requirementsFile))
+	if err != nil && !errors.Is(err, fs.ErrNotExist) {
+		return nil, err
+	}
+
+	size, err := dirSize(path)
+	if err != nil {
+		return nil, err
+	}
+
+	return &Environment{
+		ID:            id,
+		PythonVersion: string(version),
+		Requirements:  string(requirements),
+		Created:       ready.ModTime().UTC(),
+		LastUsed:      info.ModTime().UTC(),
+		Size:          size,
+	}, nil
+}
+
+// lock locks the lock file of the environment, shared or exclusive, and
+// returns a function that releases the lock.
+func (c *Cache) lock(id string, exclusive bool) (func(), error) {
+	path := c.path(id) + lockSuffix
+
+	for {
+		file, err := os.OpenFile(path, os.O_RDWR|os.O_CREATE, 0o600)
+		if err != nil {
+			return nil, fmt.Errorf("failed to open lock file: %w", err)
+		}
+
+		if err := lockFile(file, exclusive); err != nil {
+			_ = file.Close()
+
+			return nil, fmt.Errorf("failed to lock python environment: %w", err)
+		}
+
+		// retry if Delete removed the lock file while waiting for the lock
+		if locked, err := file.Stat(); err == nil {
+			if current, err := os.Stat(path); err == nil && os.SameFile(locked, current) {
+				return func() { _ = file.Close() }, nil
+			}
+		}
+
+		_ = file.Close()
+	}
+}
+
+// touch marks the environment as used, the modification time of the
+// environment directory is used for eviction.
+func (c *Cache) touch(ctx context.Context, id string) {
+	now := time.Now()
+
+	if err := os.Chtimes(c.path(id), now, now); err != nil {
+		slog.WarnContext(ctx, "failed to update python environment usage", "error", err, "id", id)
+	}
+}
+
+func (c *Cache) ready(id string) bool {
+	_, err := os.Stat(filepath.Join(c.path(id), readyFile))
+
+	return err == nil
+}
+
+func (c *Cache) path(id string) string {
+	return filepath.Join(c.dir, id)
+}
+
+func (c *Cache) venv(id string) string {
+	return filepath.Join(c.path(id), "venv")
+}
+
+func key(version, requirements string) string {
+	hash := sha256.Sum256([]byte(version + "\n" + normalizeRequirements(requirements)))
+
+	return hex.EncodeToString(hash[:])
+}
+
+func validKey(id string) bool {
+	if len(id) != sha256.Size*2 {
+		return false
+	}
+
+	_, err := hex.DecodeString(id)
+
+	return err == nil
+}
+
+// normalizeRequirements removes comments, blank lines and surrounding
+// whitespace and sorts the requirement specifiers, so that equivalent
+// requirement lists share an environment. Options like --index-url or -e
+// keep their order and position, because pip applies them in order.
+func normalizeRequirements(requirements string) string {
+	var lines, specifiers []string
+
+	for _, line := range requirementLines(requirements) {
+		if strings.HasPrefix(line, "-") {
+			slices.Sort(specifiers)
+			lines = append(lines, specifiers...)
+			lines = append(lines, line)
+			specifiers = nil
+
+			continue
+		}
+
+		specifiers = append(specifiers, line)
+	}
+
+	slices.Sort(specifiers)
+
+	return strings.Join(append(lines, specifiers...), "\n")
+}
+
+// requirementLines returns the non-empty lines of a requirements file with
+// continuations joined and comments removed. Like pip, a # only starts a
+// comment at the beginning of a line or after whitespace, so URL fragments
+// like #egg= are kept.
+func requirementLines(requirements string) []string {
+	var lines []string
+
+	var continued string
+
+	for line := range strings.Lines(requirements) {
+		line = strings.TrimRight(line, "\r\n")
+
+		if strings.HasSuffix(line, "\\") {
+			continued += strings.TrimSuffix(line, "\\")
+
+			continue
+		}
+
+		line, continued = continued+line, ""
+
+		if line = strings.TrimSpace(stripComment(line)); line != "" {
+			lines = append(lines, line)
+		}
+	}
+
+	if line := strings.TrimSpace(stripComment(continued)); line != "" {
+		lines = append(lines, line)
+	}
+
+	return lines
+}
+
+func stripComment(line string) string {
+	for i, r := range line {
+		if r == '#' && (i == 0 || line[i-1] == ' ' || line[i-1] == '\t') {
+			return line[:i]
+		}
+	}
+
+	return line
+}
+
+func pythonVersion(ctx context.Context) (string, error) {
+	pythonPath, err := findExec("python3", "python")
+	if err != nil {
+		return "", fmt.Errorf("python or python3 binary not found, %w", err)
+	}
+
+	b, err := exec.CommandContext(ctx, pythonPath, "--version").Output()
+	if err != nil {
+		return "", fmt.Errorf("failed to get python version, %w", err)
+	}
+
+	return strings.TrimSpace(string(b)), nil
+}
+
+// chmodTree removes or restores the write permissions of all files and
+// directories below path. Symlinks are skipped, chmod would follow them.
+func chmodTree(path string, writable bool) error {
+	return filepath.WalkDir(path, func(path string, d fs.DirEntry, err error) error {
+		if err != nil {
+			return err
+		}
+
+		if d.Type()&fs.ModeSymlink != 0 {
+			return nil
+		}
+
+		info, err := d.Info()
+		if err != nil {
+			return err
+		}
+
+		mode := info.Mode().Perm() &^ 0o222
+		if writable {
+			mode = info.Mode().Perm() | 0o200
+		}
+
+		return os.Chmod(path, mode)
+	})
+}
+
+// removeAll removes path like os.RemoveAll, but restores the write
+// permissions of read-only environments first.
+func removeAll(path string) error {
+	if err := chmodTree(path, true); err != nil && !errors.Is(err, fs.ErrNotExist) {
+		return err
+	}
+
+	return os.RemoveAll(path)
+}
+
+func dirSize(path string) (int64, error) {
+	var size int64
+
+	err := filepath.WalkDir(path, func(_ string, d fs.DirEntry, err error) error {
+		if err != nil {
+			return err
+		}
+
+		if d.Type().IsRegular() {
+			info, err := d.Info()
+			if err != nil {
+				return err
+			}
+
+			size += info.Size()
+		}
+
+		return nil
+	})
+
+	return size, err
+}
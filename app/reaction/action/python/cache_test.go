@@ -0,0 +1,198 @@
+package python
+
+import (
+	"io/fs"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestCache(t *testing.T) {
+	t.Parallel()
+
+	cache, err := NewCache(t.TempDir())
+	require.NoError(t, err)
+
+	var venvs []string
+
+	for range 2 {
+		require.NoError(t, cache.Use(t.Context(), "", func(venv string) error {
+			venvs = append(venvs, venv)
+
+			return nil
+		}))
+	}
+
+	assert.Equal(t, venvs[0], venvs[1])
+
+	environments, err := cache.List()
+	require.NoError(t, err)
+	require.Len(t, environments, 1)
+	assert.Contains(t, environments[0].PythonVersion, "Python 3")
+	assert.Positive(t, environments[0].Size)
+
+	require.NoError(t, cache.Delete(environments[0].ID))
+
+	environments, err = cache.List()
+	require.NoError(t, err)
+	assert.Empty(t, environments)
+}
+
+func TestCache_ReadOnly(t *testing.T) {
+	t.Parallel()
+
+	cache, err := NewCache(t.TempDir())
+	require.NoError(t, err)
+
+	environment, err := cache.Prewarm(t.Context(), "")
+	require.NoError(t, err)
+
+	require.NoError(t, filepath.WalkDir(cache.venv(environment.ID), func(path string, d fs.DirEntry, err error) error {
+		require.NoError(t, err)
+
+		if d.Type()&fs.ModeSymlink != 0 {
+			return nil
+		}
+
+		info, err := d.Info()
+		require.NoError(t, err)
+		assert.Zero(t, info.Mode().Perm()&0o222, path)
+
+		return nil
+	}))
+
+	require.NoError(t, cache.Delete(environment.ID))
+
+	_, err = os.Stat(cache.path(environment.ID))
+	assert.ErrorIs(t, err, fs.ErrNotExist)
+}
+
+func TestCache_Purge(t *testing.T) {
+	t.Parallel()
+
+	cache, err := NewCache(t.TempDir())
+	require.NoError(t, err)
+
+	environment, err := cache.Prewarm(t.Context(), "")
+	require.NoError(t, err)
+
+	removed, err := cache.Evict(t.Context(), maxUnused)
+	require.NoError(t, err)
+	assert.Empty(t, removed)
+
+	removed, err = cache.Purge(t.Context())
+	require.NoError(t, err)
+	assert.Equal(t, []string{environment.ID}, removed)
+}
+
+func TestCache_DeleteWaitsForOtherProcesses(t *testing.T) {
+	t.Parallel()
+
+	dir := t.TempDir()
+
+	cache, err := NewCache(dir)
+	require.NoError(t, err)
+
+	// a second cache on the same directory, like the venv command
+	other, err := NewCache(dir)
+	require.NoError(t, err)
+
+	environment, err := cache.Prewarm(t.Context(), "")
+	require.NoError(t, err)
+
+	used, release := make(chan struct{}), make(chan struct{})
+
+	go func() {
+		_ = cache.Use(t.Context(), "", func(string) error {
+			close(used)
+			<-release
+
+			return nil
+		})
+	}()
+
+	<-used
+
+	deleted := make(chan error)
+
+	go func() { deleted <- other.Delete(environment.ID) }()
+
+	select {
+	case <-deleted:
+		t.Fatal("environment was deleted while it was in use")
+	case <-time.After(200 * time.Millisecond):
+	}
+
+	close(release)
+	require.NoError(t, <-deleted)
+
+	_, err = cache.Get(environment.ID)
+	require.ErrorIs(t, err, fs.ErrNotExist)
+
+	_, err = os.Stat(filepath.Join(cache.dir, environment.ID+lockSuffix))
+	require.ErrorIs(t, err, fs.ErrNotExist, "the lock file is removed")
+
+	require.ErrorIs(t, other.Delete(environment.ID), fs.ErrNotExist)
+}
+
+func Test_key(t *testing.T) {
+	t.Parallel()
+
+	a := key("Python 3.12.0", "requests==2.31.0\n# comment\n\npyyaml\n")
+	b := key("Python 3.12.0", "pyyaml\n  requests==2.31.0  ")
+
+	assert.Equal(t, a, b)
+	assert.True(t, validKey(a))
+	assert.NotEqual(t, a, key("Python 3.13.0", "pyyaml\nrequests==2.31.0"))
+	assert.NotEqual(t, a, key("Python 3.12.0", "pyyaml"))
+	assert.False(t, validKey("../etc"))
+}
+
+func Test_normalizeRequirements(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name         string
+		requirements string
+		want         string
+	}{
+		{
+			name:         "sorts specifiers",
+			requirements: "requests==2.31.0  # http\n\npyyaml\n",
+			want:         "pyyaml\nrequests==2.31.0",
+		},
+		{
+			name:         "keeps the position of options",
+			requirements: "requests\n--index-url https://a.example/simple\npyyaml\n-e ./local\ncel",
+			want:         "requests\n--index-url https://a.example/simple\npyyaml\n-e ./local\ncel",
+		},
+		{
+			name:         "keeps URL fragments",
+			requirements: "git+https://example.com/repo.git#egg=repo # comment",
+			want:         "git+https://example.com/repo.git#egg=repo",
+		},
+		{
+			name:         "joins continuations",
+			requirements: "requests==2.31.0 \\\n    --hash=sha256:abc\n",
+			want:         "requests==2.31.0     --hash=sha256:abc",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			assert.Equal(t, tt.want, normalizeRequirements(tt.requirements))
+		})
+	}
+
+	// moving an option must not share an environment
+	assert.NotEqual(t,
+		normalizeRequirements("-i https://a.example/simple\nfoo"),
+		normalizeRequirements("foo\n-i https://a.example/simple"),
+	)
+}
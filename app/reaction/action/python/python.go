@@ -8,19 +8,65 @@ import (
 	"os"
 	"os/exec"
 	"strings"
+	"time"
+
+	"github.com/SecurityBrewery/catalyst/app/auth"
+	"github.com/SecurityBrewery/catalyst/app/secret"
 )
 
+// setupTimeout is the maximum duration to create a virtual environment and
+// install the requirements.
+const setupTimeout = 10 * time.Minute
+
 type Python struct {
 	Requirements string `json:"requirements"`
 	Script       string `json:"script"`
+	// Permissions are the scopes of the CATALYST_TOKEN that is passed to
+	// the script. Without permissions, the token cannot access the API.
+	Permissions []string `json:"permissions,omitempty"`
+	// Secrets are the names of secrets that are passed to the script as
+	// environment variables.
+	Secrets []string `json:"secrets,omitempty"`
 
-	env []string
+	Limits
+
+	env   []string
+	cache *Cache
 }
 
 func (a *Python) SetEnv(env []string) {
 	a.env = env
 }
 
+func (a *Python) Scopes() []string {
+	return a.Permissions
+}
+
+func (a *Python) SecretNames() []string {
+	return a.Secrets
+}
+
+// TokenLifetime is the maximum duration of a run, including the setup of
+// the virtual environment.
+func (a *Python) TokenLifetime() time.Duration {
+	return setupTimeout + a.Limits.timeout()
+}
+
+// Validate checks the permissions and secret names of the script.
+func (a *Python) Validate() error {
+	for _, name := range a.Secrets {
+		if err := secret.ValidateName(name); err != nil {
+			return err
+		}
+	}
+
+	return auth.ValidatePermissions(a.Permissions)
+}
+
+func (a *Python) SetCache(cache *Cache) {
+	a.cache = cache
+}
+
 func (a *Python) Run(ctx context.Context, payload json.RawMessage) ([]byte, error) {
 	tempDir, err := os.MkdirTemp("", "catalyst_action")
 	if err != nil {
@@ -29,82 +75,91 @@ func (a *Python) Run(ctx context.Context, payload json.RawMessage) ([]byte, erro
 
 	defer os.RemoveAll(tempDir)
 
-	b, err := pythonSetup(ctx, tempDir)
-	if err != nil {
-		var ee *exec.ExitError
-		if errors.As(err, &ee) {
-			b = append(b, ee.Stderr...)
+	if a.cache == nil {
+		if err := setup(ctx, tempDir, a.Requirements); err != nil {
+			return nil, err
 		}
 
-		return nil, fmt.Errorf("failed to setup python, %w: %s", err, string(b))
+		return a.pythonRunScript(ctx, tempDir, tempDir+"/venv", string(payload))
 	}
 
-	b, err = a.pythonInstallRequirements(ctx, tempDir)
+	var b []byte
+
+	err = a.cache.Use(ctx, a.Requirements, func(venv string) error {
+		b, err = a.pythonRunScript(ctx, tempDir, venv, string(payload))
+
+		return err
+	})
+
+	return b, err
+}
+
+// setup creates a virtual environment in dir/venv and installs the
+// requirements into it.
+func setup(ctx context.Context, dir, requirements string) error {
+	ctx, cancel := context.WithTimeout(ctx, setupTimeout)
+	defer cancel()
+
+	b, err := pythonSetup(ctx, dir)
 	if err != nil {
 		var ee *exec.ExitError
 		if errors.As(err, &ee) {
 			b = append(b, ee.Stderr...)
 		}
 
-		return nil, fmt.Errorf("failed to run install requirements, %w: %s", err, string(b))
+		return fmt.Errorf("failed to setup python, %w: %s", err, string(b))
 	}
 
-	b, err = a.pythonRunScript(ctx, tempDir, string(payload))
+	b, err = pythonInstallRequirements(ctx, dir, requirements)
 	if err != nil {
 		var ee *exec.ExitError
 		if errors.As(err, &ee) {
 			b = append(b, ee.Stderr...)
 		}
 
-		return nil, fmt.Errorf("failed to run script, %w: %s", err, string(b))
+		return fmt.Errorf("failed to run install requirements, %w: %s", err, string(b))
 	}
 
-	return b, nil
+	return nil
 }
 
-func pythonSetup(ctx context.Context, tempDir string) ([]byte, error) {
+func pythonSetup(ctx context.Context, dir string) ([]byte, error) {
 	pythonPath, err := findExec("python3", "python")
 	if err != nil {
 		return nil, fmt.Errorf("python or python3 binary not found, %w", err)
 	}
 
 	// setup virtual environment
-	return exec.CommandContext(ctx, pythonPath, "-m", "venv", tempDir+"/venv").Output()
+	return exec.CommandContext(ctx, pythonPath, "-m", "venv", dir+"/venv").Output()
 }
 
-func (a *Python) pythonInstallRequirements(ctx context.Context, tempDir string) ([]byte, error) {
-	hasRequirements := len(strings.TrimSpace(a.Requirements)) > 0
+func pythonInstallRequirements(ctx context.Context, dir, requirements string) ([]byte, error) {
+	hasRequirements := len(strings.TrimSpace(requirements)) > 0
 
 	if !hasRequirements {
 		return nil, nil
 	}
 
-	requirementsPath := tempDir + "/requirements.txt"
+	requirementsPath := dir + "/" + requirementsFile
 
-	if err := os.WriteFile(requirementsPath, []byte(a.Requirements), 0o600); err != nil {
+	if err := os.WriteFile(requirementsPath, []byte(requirements), 0o600); err != nil {
 		return nil, err
 	}
 
 	// install dependencies
-	pipPath := tempDir + "/venv/bin/pip"
+	pipPath := dir + "/venv/bin/pip"
 
 	return exec.CommandContext(ctx, pipPath, "install", "-r", requirementsPath).Output()
 }
 
-func (a *Python) pythonRunScript(ctx context.Context, tempDir, payload string) ([]byte, error) {
+func (a *Python) pythonRunScript(ctx context.Context, tempDir, venv, payload string) ([]byte, error) {
 	scriptPath := tempDir + "/script.py"
 
 	if err := os.WriteFile(scriptPath, []byte(a.Script), 0o600); err != nil {
 		return nil, err
 	}
 
-	pythonPath := tempDir + "/venv/bin/python"
-
-	cmd := exec.CommandContext(ctx, pythonPath, scriptPath, payload)
-
-	cmd.Env = a.env
-
-	return cmd.Output()
+	return a.runSandboxed(ctx, tempDir, venv, scriptPath, payload)
 }
 
 func findExec(name ...string) (string, error) {
@@ -1,10 +1,12 @@
 package python_test
 
 import (
+	"context"
 	"encoding/json"
 	"testing"
 
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
 
 	"github.com/SecurityBrewery/catalyst/app/reaction/action/python"
 )
@@ -102,3 +104,102 @@ func TestPython_Run(t *testing.T) {
 		})
 	}
 }
+
+func TestPython_Run_Limits(t *testing.T) {
+	t.Parallel()
+
+	cache, err := python.NewCache(t.TempDir())
+	require.NoError(t, err)
+
+	// the environments are read-only, purge them before the temp dir is removed
+	t.Cleanup(func() { _, _ = cache.Purge(context.Background()) })
+
+	tests := []struct {
+		name    string
+		script  string
+		limits  python.Limits
+		want    []byte
+		wantErr string
+	}{
+		{
+			name:    "timeout",
+			script:  "import time; time.sleep(10)",
+			limits:  python.Limits{Timeout: 1},
+			wantErr: "timeout after 1s",
+		},
+		{
+			name:    "child process is killed on timeout",
+			script:  "import subprocess; subprocess.run(['sleep', '10'])",
+			limits:  python.Limits{Timeout: 1},
+			wantErr: "timeout after 1s",
+		},
+		{
+			name:    "max output",
+			script:  "print('x' * 100)",
+			limits:  python.Limits{MaxOutput: 10},
+			wantErr: "output limit exceeded",
+		},
+		{
+			name:    "stderr is kept on the output limit",
+			script:  "import sys; sys.stderr.write('processing'); sys.stderr.flush(); print('x' * 100)",
+			limits:  python.Limits{MaxOutput: 10},
+			wantErr: "processing",
+		},
+		{
+			name:    "stderr is kept on timeout",
+			script:  "import sys, time; sys.stderr.write('processing'); sys.stderr.flush(); time.sleep(10)",
+			limits:  python.Limits{Timeout: 1},
+			wantErr: "processing",
+		},
+		{
+			name:    "max memory of child processes",
+			script:  "import subprocess, sys; subprocess.run([sys.executable, '-c', 'bytearray(256 * 1024 * 1024)'], check=True)",
+			limits:  python.Limits{MaxMemory: 128},
+			wantErr: "MemoryError",
+		},
+		{
+			name:    "max memory at startup",
+			script:  "print('started')",
+			limits:  python.Limits{MaxMemory: 1},
+			wantErr: "failed to run script",
+		},
+		{
+			name:    "max memory",
+			script:  "x = bytearray(256 * 1024 * 1024)",
+			limits:  python.Limits{MaxMemory: 128},
+			wantErr: "MemoryError",
+		},
+		{
+			name:    "limits cannot be raised",
+			script:  "import resource; resource.setrlimit(resource.RLIMIT_CPU, (100, 100))",
+			limits:  python.Limits{MaxCPU: 10},
+			wantErr: "not allowed to raise maximum limit",
+		},
+		{
+			name:   "working directory",
+			script: "import os; print(os.getcwd() == os.environ['HOME'] == os.environ['TMPDIR'])",
+			want:   []byte("True\n"),
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			a := &python.Python{
+				Script: tt.script,
+				Limits: tt.limits,
+			}
+			a.SetCache(cache)
+
+			got, err := a.Run(t.Context(), json.RawMessage("test"))
+			if tt.wantErr != "" {
+				require.ErrorContains(t, err, tt.wantErr)
+
+				return
+			}
+
+			require.NoError(t, err)
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
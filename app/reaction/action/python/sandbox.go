@@ -0,0 +1,140 @@
+package python
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"os/exec"
+	"sync"
+	"time"
+)
+
+const (
+	defaultTimeout   = 10 * time.Minute
+	defaultMaxOutput = 10 << 20 // 10 MiB
+	maxStderr        = 64 << 10 // 64 KiB
+
+	waitDelay = time.Second
+)
+
+// ErrOutputLimit is returned if a script writes more than the configured
+// maximum output.
+var ErrOutputLimit = errors.New("output limit exceeded")
+
+// Limits restrict the resources a Python script can use.
+type Limits struct {
+	// Timeout is the maximum wall clock time of a run in seconds.
+	Timeout int `json:"timeout,omitempty"`
+	// MaxOutput is the maximum size of the output in bytes.
+	MaxOutput int `json:"max_output,omitempty"`
+	// MaxMemory is the maximum address space of the interpreter in megabytes.
+	MaxMemory int `json:"max_memory,omitempty"`
+	// MaxCPU is the maximum CPU time of the interpreter in seconds.
+	MaxCPU int `json:"max_cpu,omitempty"`
+	// DisableNetwork runs the script without network access. This is only
+	// supported on Linux with unprivileged user namespaces.
+	DisableNetwork bool `json:"disable_network,omitempty"`
+}
+
+func (l Limits) timeout() time.Duration {
+	if l.Timeout <= 0 {
+		return defaultTimeout
+	}
+
+	return time.Duration(l.Timeout) * time.Second
+}
+
+func (l Limits) maxOutput() int {
+	if l.MaxOutput <= 0 {
+		return defaultMaxOutput
+	}
+
+	return l.MaxOutput
+}
+
+// runSandboxed runs the script with the interpreter of the virtual
+// environment. The script runs in its own working directory, which is also
+// used as home and temp directory, and is killed if it exceeds its timeout
+// or output limit.
+func (a *Python) runSandboxed(ctx context.Context, workDir, venv, scriptPath, payload string) ([]byte, error) {
+	ctx, cancel := context.WithTimeout(ctx, a.Limits.timeout())
+	defer cancel()
+
+	cmd := exec.CommandContext(ctx, venv+"/bin/python", scriptPath, payload)
+
+	cmd.Dir = workDir
+	cmd.Env = append([]string{"HOME=" + workDir, "TMPDIR=" + workDir}, a.env...)
+	cmd.WaitDelay = waitDelay
+
+	if err := sandbox(cmd, a.Limits); err != nil {
+		return nil, err
+	}
+
+	stdout := &limitedBuffer{limit: a.Limits.maxOutput(), exceeded: cancel}
+	stderr := &limitedBuffer{limit: maxStderr}
+
+	cmd.Stdout = stdout
+	cmd.Stderr = stderr
+
+	err := cmd.Run()
+
+	switch {
+	case stdout.overflow:
+		return nil, fmt.Errorf("failed to run script, %w: more than %d bytes: %s", ErrOutputLimit, a.Limits.maxOutput(), stderr.String())
+	case errors.Is(ctx.Err(), context.DeadlineExceeded) && err != nil:
+		return nil, fmt.Errorf("failed to run script, timeout after %s: %w: %s", a.Limits.timeout(), context.DeadlineExceeded, stderr.String())
+	case err != nil:
+		return nil, fmt.Errorf("failed to run script, %w: %s", err, stdout.String()+stderr.String())
+	}
+
+	return stdout.Bytes(), nil
+}
+
+// limitedBuffer collects output up to a limit. Additional output is dropped
+// and exceeded is called once.
+type limitedBuffer struct {
+	buf bytes.Buffer
+
+	mu       sync.Mutex
+	limit    int
+	overflow bool
+	exceeded func()
+}
+
+func (b *limitedBuffer) Write(p []byte) (int, error) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	if b.overflow {
+		return len(p), nil
+	}
+
+	if b.buf.Len()+len(p) > b.limit {
+		b.buf.Write(p[:b.limit-b.buf.Len()])
+
+		b.overflow = true
+
+		if b.exceeded != nil {
+			b.exceeded()
+		}
+
+		return len(p), nil
+	}
+
+	return b.buf.Write(p)
+}
+
+func (b *limitedBuffer) String() string {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	return b.buf.String()
+}
+
+func (b *limitedBuffer) Bytes() []byte {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	return append([]byte{}, b.buf.Bytes()...)
+}
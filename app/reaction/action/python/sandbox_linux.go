@@ -0,0 +1,62 @@
+package python
+
+import (
+	"fmt"
+	"os"
+	"os/exec"
+	"syscall"
+)
+
+// sandbox runs the script in its own process group, so that child processes
+// are killed as well, and, if requested, in new user and network namespaces
+// without any network interfaces except loopback.
+func sandbox(cmd *exec.Cmd, limits Limits) error {
+	if err := setLimits(cmd, limits); err != nil {
+		return err
+	}
+
+	cmd.SysProcAttr = &syscall.SysProcAttr{Setpgid: true}
+
+	cmd.Cancel = func() error {
+		return syscall.Kill(-cmd.Process.Pid, syscall.SIGKILL)
+	}
+
+	if limits.DisableNetwork {
+		cmd.SysProcAttr.Cloneflags = syscall.CLONE_NEWUSER | syscall.CLONE_NEWNET
+		cmd.SysProcAttr.UidMappings = []syscall.SysProcIDMap{{ContainerID: os.Getuid(), HostID: os.Getuid(), Size: 1}}
+		cmd.SysProcAttr.GidMappings = []syscall.SysProcIDMap{{ContainerID: os.Getgid(), HostID: os.Getgid(), Size: 1}}
+	}
+
+	return nil
+}
+
+// setLimits runs the interpreter through prlimit, which sets the memory and
+// CPU limits on itself before it executes the interpreter, so the limits
+// apply from the first instruction. The soft and hard limits are set to the
+// same value, so the script cannot raise them again, and they are inherited
+// by child processes.
+func setLimits(cmd *exec.Cmd, limits Limits) error {
+	if limits.MaxMemory <= 0 && limits.MaxCPU <= 0 {
+		return nil
+	}
+
+	prlimit, err := exec.LookPath("prlimit")
+	if err != nil {
+		return fmt.Errorf("prlimit is required for memory and CPU limits: %w", err)
+	}
+
+	args := []string{prlimit}
+
+	if limits.MaxMemory > 0 {
+		args = append(args, fmt.Sprintf("--as=%d", uint64(limits.MaxMemory)<<20))
+	}
+
+	if limits.MaxCPU > 0 {
+		args = append(args, fmt.Sprintf("--cpu=%d", limits.MaxCPU))
+	}
+
+	cmd.Path = prlimit
+	cmd.Args = append(append(args, "--"), cmd.Args...)
+
+	return nil
+}
@@ -0,0 +1,46 @@
+package python_test
+
+import (
+	"encoding/json"
+	"os/exec"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/SecurityBrewery/catalyst/app/reaction/action/python"
+)
+
+func TestPython_Run_DisableNetwork(t *testing.T) {
+	t.Parallel()
+
+	if err := exec.CommandContext(t.Context(), "unshare", "--user", "--net", "true").Run(); err != nil {
+		t.Skip("user namespaces are not available")
+	}
+
+	a := &python.Python{
+		Script: "import socket; print(socket.if_nameindex())",
+		Limits: python.Limits{DisableNetwork: true},
+	}
+
+	got, err := a.Run(t.Context(), json.RawMessage("test"))
+	require.NoError(t, err)
+	assert.Equal(t, "[(1, 'lo')]\n", string(got))
+}
+
+func TestPython_Run_DisableNetworkWithLimits(t *testing.T) {
+	t.Parallel()
+
+	if err := exec.CommandContext(t.Context(), "unshare", "--user", "--net", "true").Run(); err != nil {
+		t.Skip("user namespaces are not available")
+	}
+
+	a := &python.Python{
+		Script: "import resource; print(resource.getrlimit(resource.RLIMIT_CPU))",
+		Limits: python.Limits{DisableNetwork: true, MaxCPU: 10},
+	}
+
+	got, err := a.Run(t.Context(), json.RawMessage("test"))
+	require.NoError(t, err)
+	assert.Equal(t, "(10, 10)\n", string(got))
+}
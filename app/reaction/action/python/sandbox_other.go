@@ -0,0 +1,20 @@
+//go:build !linux
+
+package python
+
+import (
+	"errors"
+	"os/exec"
+)
+
+func sandbox(_ *exec.Cmd, limits Limits) error {
+	if limits.DisableNetwork {
+		return errors.New("disabling network access is only supported on linux")
+	}
+
+	if limits.MaxMemory > 0 || limits.MaxCPU > 0 {
+		return errors.New("memory and CPU limits are only supported on linux")
+	}
+
+	return nil
+}
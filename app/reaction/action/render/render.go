@@ -0,0 +1,106 @@
+// Package render renders the Go templates in the parameters of actions with
+// the trigger payload. Missing keys are errors, so a typo in a template does
+// not silently render as "<no value>".
+package render
+
+import (
+	"encoding/json"
+	"fmt"
+	"strings"
+	"text/template"
+)
+
+// Data decodes the payload of a trigger as template data.
+func Data(payload json.RawMessage) (any, error) {
+	var data any
+
+	if len(payload) > 0 {
+		if err := json.Unmarshal(payload, &data); err != nil {
+			return nil, fmt.Errorf("failed to unmarshal payload: %w", err)
+		}
+	}
+
+	return data, nil
+}
+
+// Text renders a text template with the data.
+func Text(name, text string, funcs template.FuncMap, data any) (string, error) {
+	tmpl, err := template.New(name).Funcs(funcs).Option("missingkey=error").Parse(text)
+	if err != nil {
+		return "", fmt.Errorf("invalid template for %s: %w", name, err)
+	}
+
+	var sb strings.Builder
+	if err := tmpl.Execute(&sb, data); err != nil {
+		return "", fmt.Errorf("failed to render %s: %w", name, err)
+	}
+
+	return sb.String(), nil
+}
+
+// Renderer renders many parameters with the same data. After the first
+// error, no further templates are rendered and the error is returned by Err.
+type Renderer struct {
+	data  any
+	funcs template.FuncMap
+	err   error
+}
+
+func New(data any, funcs template.FuncMap) *Renderer {
+	return &Renderer{data: data, funcs: funcs}
+}
+
+// String renders a template. Strings without an action are returned as is.
+func (r *Renderer) String(name, text string) string {
+	if r.err != nil || !strings.Contains(text, "{{") {
+		return text
+	}
+
+	s, err := Text(name, text, r.funcs, r.data)
+	if err != nil {
+		r.err = err
+
+		return ""
+	}
+
+	return s
+}
+
+// Pointer renders an optional template.
+func (r *Renderer) Pointer(name string, text *string) *string {
+	if text == nil {
+		return nil
+	}
+
+	s := r.String(name, *text)
+
+	return &s
+}
+
+// Value renders all strings in a JSON value, e.g. the state of a ticket.
+func (r *Renderer) Value(name string, value any) any {
+	switch v := value.(type) {
+	case string:
+		return r.String(name, v)
+	case map[string]any:
+		m := make(map[string]any, len(v))
+		for key, item := range v {
+			m[key] = r.Value(name+"."+key, item)
+		}
+
+		return m
+	case []any:
+		l := make([]any, 0, len(v))
+		for i, item := range v {
+			l = append(l, r.Value(fmt.Sprintf("%s[%d]", name, i), item))
+		}
+
+		return l
+	default:
+		return v
+	}
+}
+
+func (r *Renderer) Err() error {
+	return r.err
+}
@@ -0,0 +1,43 @@
+package render
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestRenderer(t *testing.T) {
+	t.Parallel()
+
+	data, err := Data([]byte(`{"record":{"id":"test-ticket","severity":"High"}}`))
+	require.NoError(t, err)
+
+	r := New(data, nil)
+
+	assert.Equal(t, "test-ticket", r.String("ticket", "{{.record.id}}"))
+	assert.Equal(t, "plain", r.String("name", "plain"))
+	assert.Equal(t, map[string]any{"severity": "High", "tags": []any{"test-ticket"}}, r.Value("state", map[string]any{
+		"severity": "{{.record.severity}}",
+		"tags":     []any{"{{.record.id}}"},
+	}))
+	assert.Nil(t, r.Pointer("owner", nil))
+	require.NoError(t, r.Err())
+
+	assert.Empty(t, r.String("owner", "{{.record.owner}}"))
+	require.ErrorContains(t, r.Err(), "failed to render owner")
+
+	// no further templates are rendered after an error
+	assert.Equal(t, "{{.record.id}}", r.String("ticket", "{{.record.id}}"))
+}
+
+func TestText(t *testing.T) {
+	t.Parallel()
+
+	got, err := Text("body", "{{shout .name}}", map[string]any{"shout": func(s string) string { return s + "!" }}, map[string]any{"name": "test"})
+	require.NoError(t, err)
+	assert.Equal(t, "test!", got)
+
+	_, err = Text("body", "{{.name", nil, nil)
+	require.ErrorContains(t, err, "invalid template for body")
+}
@@ -0,0 +1,100 @@
+package action
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"log/slog"
+	"os/exec"
+	"time"
+
+	"github.com/SecurityBrewery/catalyst/app/database/sqlc"
+	"github.com/SecurityBrewery/catalyst/app/pointer"
+)
+
+const (
+	RunStatusRunning = "running"
+	RunStatusSuccess = "success"
+	RunStatusFailed  = "failed"
+)
+
+// RunReaction runs the action of a reaction and records the run with its
+// input, output, error and duration in the reaction_runs table. The run is
+// nil if it could not be recorded.
+func (r *Runner) RunReaction(ctx context.Context, url string, reactionID, trigger, actionName string, actionData, payload json.RawMessage) (*sqlc.ReactionRun, []byte, error) {
+	run, err := StartRun(ctx, r.queries, reactionID, trigger, payload)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	output, err := r.Run(ReactionContext(ctx, reactionID), url, actionName, actionData, payload)
+
+	if finishErr := FinishRun(ctx, r.queries, run.ID, output, err); finishErr != nil {
+		slog.ErrorContext(ctx, "failed to finish reaction run", "error", finishErr, "reaction_id", reactionID, "run_id", run.ID)
+	}
+
+	return run, output, err
+}
+
+type reactionKey struct{}
+
+// ReactionContext marks the context as running the action of a reaction.
+// Hooks that the action publishes, e.g. by updating a ticket, do not trigger
+// the same reaction again, so a reaction cannot retrigger itself forever.
+func ReactionContext(ctx context.Context, reactionID string) context.Context {
+	return context.WithValue(ctx, reactionKey{}, reactionID)
+}
+
+// ReactionFromContext returns the reaction whose action runs in the context.
+func ReactionFromContext(ctx context.Context) (string, bool) {
+	reactionID, ok := ctx.Value(reactionKey{}).(string)
+
+	return reactionID, ok
+}
+
+// StartRun records a new running reaction run.
+func StartRun(ctx context.Context, queries *sqlc.Queries, reactionID, trigger string, payload json.RawMessage) (*sqlc.ReactionRun, error) {
+	if len(payload) == 0 {
+		payload = json.RawMessage("{}")
+	}
+
+	run, err := queries.CreateReactionRun(context.WithoutCancel(ctx), sqlc.CreateReactionRunParams{
+		Reaction: reactionID,
+		Trigger:  trigger,
+		Status:   RunStatusRunning,
+		Input:    payload,
+		Started:  time.Now().UTC(),
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to create reaction run: %w", err)
+	}
+
+	return &run, nil
+}
+
+// FinishRun stores the result of an action in a previously started reaction run.
+func FinishRun(ctx context.Context, queries *sqlc.Queries, runID string, output []byte, runErr error) error {
+	params := sqlc.FinishReactionRunParams{
+		ID:       runID,
+		Status:   RunStatusSuccess,
+		Output:   string(output),
+		Finished: pointer.Pointer(time.Now().UTC()),
+	}
+
+	if runErr != nil {
+		params.Status = RunStatusFailed
+		params.Error = pointer.Pointer(runErr.Error())
+
+		var ee *exec.ExitError
+		if errors.As(runErr, &ee) {
+			params.ExitCode = pointer.Pointer(int64(ee.ExitCode()))
+		}
+	}
+
+	if _, err := queries.FinishReactionRun(context.WithoutCancel(ctx), params); err != nil {
+		return fmt.Errorf("failed to finish reaction run: %w", err)
+	}
+
+	return nil
+}
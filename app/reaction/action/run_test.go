@@ -0,0 +1,84 @@
+package action_test
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/SecurityBrewery/catalyst/app/data"
+	"github.com/SecurityBrewery/catalyst/app/database/sqlc"
+	"github.com/SecurityBrewery/catalyst/app/reaction/action"
+	"github.com/SecurityBrewery/catalyst/app/secret"
+)
+
+func TestRunReaction_RecordsFailure(t *testing.T) {
+	t.Parallel()
+
+	queries := data.NewTestDB(t, t.TempDir())
+
+	recorded, _, err := action.NewRunner(queries, nil, nil, nil).RunReaction(t.Context(), "http://localhost", "r-test-webhook", "webhook", "unknown", json.RawMessage(`{}`), json.RawMessage(`{"test":true}`))
+	require.Error(t, err)
+
+	runs, err := queries.ListReactionRuns(t.Context(), sqlc.ListReactionRunsParams{Reaction: "r-test-webhook", Limit: 10})
+	require.NoError(t, err)
+	require.Len(t, runs, 2)
+
+	run := runs[0]
+	assert.Equal(t, recorded.ID, run.ID)
+	assert.Equal(t, action.RunStatusFailed, run.Status)
+	assert.Equal(t, "webhook", run.Trigger)
+	assert.JSONEq(t, `{"test":true}`, string(run.Input))
+	require.NotNil(t, run.Error)
+	assert.Contains(t, *run.Error, `action "unknown" not found`)
+	require.NotNil(t, run.Finished)
+	assert.False(t, run.Finished.Before(run.Started))
+}
+
+func TestFinishRun_Success(t *testing.T) {
+	t.Parallel()
+
+	queries := data.NewTestDB(t, t.TempDir())
+
+	run, err := action.StartRun(t.Context(), queries, "r-test-hook", "hook", nil)
+	require.NoError(t, err)
+	assert.Equal(t, action.RunStatusRunning, run.Status)
+	assert.JSONEq(t, `{}`, string(run.Input))
+
+	require.NoError(t, action.FinishRun(t.Context(), queries, run.ID, []byte("done"), nil))
+
+	finished, err := queries.GetReactionRun(t.Context(), sqlc.GetReactionRunParams{ID: run.ID, Reaction: "r-test-hook"})
+	require.NoError(t, err)
+	assert.Equal(t, action.RunStatusSuccess, finished.Status)
+	assert.Equal(t, "done", finished.Output)
+	assert.Nil(t, finished.Error)
+}
+
+func TestRunner_Run_Secrets(t *testing.T) {
+	t.Parallel()
+
+	queries := data.NewTestDB(t, t.TempDir())
+
+	secrets, err := secret.New("MDEyMzQ1Njc4OWFiY2RlZjAxMjM0NTY3ODlhYmNkZWY=")
+	require.NoError(t, err)
+
+	value, err := secrets.Encrypt([]byte("vt-key"))
+	require.NoError(t, err)
+
+	_, err = queries.CreateSecret(t.Context(), sqlc.CreateSecretParams{Name: "VT_API_KEY", Value: value})
+	require.NoError(t, err)
+
+	runner := action.NewRunner(queries, nil, nil, secrets)
+
+	output, err := runner.Run(t.Context(), "http://localhost", "python",
+		json.RawMessage(`{"script":"import os; print(os.environ['VT_API_KEY'], os.environ['CATALYST_TOKEN'] != '')","secrets":["VT_API_KEY"]}`),
+		json.RawMessage(`{}`))
+	require.NoError(t, err)
+	assert.Equal(t, "vt-key True\n", string(output))
+
+	_, err = action.NewRunner(queries, nil, nil, nil).Run(t.Context(), "http://localhost", "python",
+		json.RawMessage(`{"script":"print(1)","secrets":["VT_API_KEY"]}`),
+		json.RawMessage(`{}`))
+	require.ErrorIs(t, err, secret.ErrNoKey)
+}
@@ -4,16 +4,118 @@ import (
 	"bytes"
 	"context"
 	"encoding/json"
+	"fmt"
 	"net/http"
+	"slices"
+	"strings"
+	"text/template"
+	"time"
+
+	"github.com/SecurityBrewery/catalyst/app/cloudevents"
+	"github.com/SecurityBrewery/catalyst/app/reaction/action/render"
 )
 
+const (
+	defaultTimeout    = 30 * time.Second
+	defaultRetryDelay = time.Second
+	maxErrorBody      = 1 << 10
+
+	// maxRetries and maxRetryDelay bound the time a single run of the
+	// action can take, as the reaction queue retries failed runs itself.
+	maxRetries    = 5
+	maxRetryDelay = time.Minute
+)
+
+// Webhook sends an HTTP request. By default, the payload is POSTed as is.
+// If Body is set, it is rendered as a Go template with the payload, e.g.
+// {"text": {{json .record.name}}}. Requests that fail with a network
+// error, 429 or 5xx status are retried up to Retries times with
+// exponential backoff, starting at RetryDelay seconds and limited to a
+// minute between attempts. If ExpectedStatus is set, any other status fails
+// the action. Format cloudevents or cloudevents-binary wraps the body in a
+// CloudEvent with the type and subject of the record in the payload.
 type Webhook struct {
 	Headers map[string]string `json:"headers"`
 	URL     string            `json:"url"`
+
+	Method         string `json:"method,omitempty"`
+	Body           string `json:"body,omitempty"`
+	Timeout        int    `json:"timeout,omitempty"`
+	Retries        int    `json:"retries,omitempty"`
+	RetryDelay     int    `json:"retry_delay,omitempty"`
+	ExpectedStatus []int  `json:"expected_status,omitempty"`
+	Format         string `json:"format,omitempty"`
+
+	appURL string
+}
+
+func (a *Webhook) SetAppURL(url string) {
+	a.appURL = url
+}
+
+func (a *Webhook) Validate() error {
+	if a.Retries < 0 || a.Retries > maxRetries {
+		return fmt.Errorf("retries must be between 0 and %d", maxRetries)
+	}
+
+	if a.RetryDelay < 0 || time.Duration(a.RetryDelay)*time.Second > maxRetryDelay {
+		return fmt.Errorf("retry_delay must be between 0 and %d seconds", int(maxRetryDelay.Seconds()))
+	}
+
+	return cloudevents.ValidateFormat(a.Format)
 }
 
 func (a *Webhook) Run(ctx context.Context, payload json.RawMessage) ([]byte, error) {
-	req, err := http.NewRequestWithContext(ctx, http.MethodPost, a.URL, bytes.NewReader(payload))
+	body, err := a.render(payload)
+	if err != nil {
+		return nil, err
+	}
+
+	header := http.Header{}
+
+	body, err = a.encode(payload, body, header)
+	if err != nil {
+		return nil, err
+	}
+
+	client := &http.Client{Timeout: a.timeout()}
+
+	delay := a.retryDelay()
+
+	for attempt := 0; ; attempt++ {
+		res, err := a.send(ctx, client, body, header)
+
+		if attempt < a.Retries && retryable(res, err) {
+			select {
+			case <-ctx.Done():
+				return nil, ctx.Err()
+			case <-time.After(delay):
+			}
+
+			delay = min(delay*2, maxRetryDelay)
+
+			continue
+		}
+
+		if err != nil {
+			return nil, err
+		}
+
+		if len(a.ExpectedStatus) > 0 && !slices.Contains(a.ExpectedStatus, res.StatusCode) {
+			return nil, fmt.Errorf("unexpected status %d: %s", res.StatusCode, truncate(res.Body, maxErrorBody))
+		}
+
+		return json.Marshal(res)
+	}
+}
+
+func (a *Webhook) send(ctx context.Context, client *http.Client, body []byte, header http.Header) (*Response, error) {
+	method := a.Method
+	if method == "" {
+		method = http.MethodPost
+	}
+
+	req, err := http.NewRequestWithContext(ctx, strings.ToUpper(method), a.URL, bytes.NewReader(body))
 	if err != nil {
 		return nil, err
 	}
@@ -22,18 +124,104 @@ func (a *Webhook) Run(ctx context.Context, payload json.RawMessage) ([]byte, err
 		req.Header.Set(key, value)
 	}
 
-	res, err := http.DefaultClient.Do(req)
+	for key, values := range header {
+		req.Header[key] = values
+	}
+
+	res, err := client.Do(req)
 	if err != nil {
 		return nil, err
 	}
 	defer res.Body.Close()
 
-	body, isBase64Encoded := EncodeBody(res.Body)
+	responseBody, isBase64Encoded := EncodeBody(res.Body)
 
-	return json.Marshal(Response{
+	return &Response{
 		StatusCode:      res.StatusCode,
 		Headers:         res.Header,
-		Body:            body,
+		Body:            responseBody,
 		IsBase64Encoded: isBase64Encoded,
-	})
+	}, nil
+}
+
+// render returns the request body, which is either the payload or the
+// rendered body template.
+func (a *Webhook) render(payload json.RawMessage) ([]byte, error) {
+	if a.Body == "" {
+		return payload, nil
+	}
+
+	data, err := render.Data(payload)
+	if err != nil {
+		return nil, err
+	}
+
+	body, err := render.Text("body", a.Body, template.FuncMap{"json": toJSON}, data)
+	if err != nil {
+		return nil, err
+	}
+
+	return []byte(body), nil
+}
+
+// encode wraps the body in a CloudEvent if a CloudEvents format is set.
+// The event ID stays the same on retries, so receivers can deduplicate.
+func (a *Webhook) encode(payload json.RawMessage, body []byte, header http.Header) ([]byte, error) {
+	if a.Format == "" || a.Format == cloudevents.FormatCatalyst {
+		return body, nil
+	}
+
+	id, err := cloudevents.NewID()
+	if err != nil {
+		return nil, fmt.Errorf("failed to generate event id: %w", err)
+	}
+
+	event := cloudevents.New(id, a.appURL, time.Now(), payload)
+	event.Data = body
+
+	return event.Encode(a.Format, header)
+}
+
+func (a *Webhook) timeout() time.Duration {
+	if a.Timeout <= 0 {
+		return defaultTimeout
+	}
+
+	return time.Duration(a.Timeout) * time.Second
+}
+
+func (a *Webhook) retryDelay() time.Duration {
+	if a.RetryDelay <= 0 {
+		return defaultRetryDelay
+	}
+
+	return time.Duration(a.RetryDelay) * time.Second
+}
+
+// retryable reports whether a request failed with a network error or a
+// status that indicates a temporary failure.
+func retryable(res *Response, err error) bool {
+	if err != nil {
+		return true
+	}
+
+	return res.StatusCode == http.StatusTooManyRequests || res.StatusCode >= http.StatusInternalServerError
+}
+
+// toJSON encodes a value as JSON, so it can be embedded into a JSON body.
+func toJSON(v any) (string, error) {
+	b, err := json.Marshal(v)
+	if err != nil {
+		return "", err
+	}
+
+	return string(b), nil
+}
+
+func truncate(s string, n int) string {
+	if len(s) <= n {
+		return s
+	}
+
+	return s[:n] + "..."
 }
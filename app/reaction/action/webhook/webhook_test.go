@@ -2,7 +2,10 @@ package webhook_test
 
 import (
 	"encoding/json"
+	"io"
 	"net/http"
+	"net/http/httptest"
+	"sync"
 	"testing"
 	"time"
 
@@ -83,3 +86,177 @@ func TestWebhook_Run(t *testing.T) {
 		})
 	}
 }
+
+func TestWebhook_Run_Options(t *testing.T) {
+	t.Parallel()
+
+	type request struct {
+		method string
+		body   string
+	}
+
+	tests := []struct {
+		name        string
+		webhook     webhook.Webhook
+		statuses    []int
+		payload     string
+		wantErr     string
+		wantStatus  int
+		wantRequest request
+		wantCalls   int
+	}{
+		{
+			name:        "method and body template",
+			webhook:     webhook.Webhook{Method: "put", Body: `{"text": {{json .record.name}}}`},
+			statuses:    []int{http.StatusOK},
+			payload:     `{"record": {"name": "Phishing \"Mail\""}}`,
+			wantStatus:  http.StatusOK,
+			wantRequest: request{method: http.MethodPut, body: `{"text": "Phishing \"Mail\""}`},
+			wantCalls:   1,
+		},
+		{
+			name:        "missing template key",
+			webhook:     webhook.Webhook{Body: `{{.missing}}`},
+			payload:     `{}`,
+			wantErr:     `failed to render body`,
+			wantCalls:   0,
+			wantRequest: request{},
+		},
+		{
+			name:        "retry on 5xx",
+			webhook:     webhook.Webhook{Retries: 2},
+			statuses:    []int{http.StatusBadGateway, http.StatusCreated},
+			payload:     `{}`,
+			wantStatus:  http.StatusCreated,
+			wantRequest: request{method: http.MethodPost, body: `{}`},
+			wantCalls:   2,
+		},
+		{
+			name:        "no retry on 4xx",
+			webhook:     webhook.Webhook{Retries: 2},
+			statuses:    []int{http.StatusBadRequest},
+			payload:     `{}`,
+			wantStatus:  http.StatusBadRequest,
+			wantRequest: request{method: http.MethodPost, body: `{}`},
+			wantCalls:   1,
+		},
+		{
+			name:        "unexpected status",
+			webhook:     webhook.Webhook{ExpectedStatus: []int{http.StatusOK}},
+			statuses:    []int{http.StatusNotFound},
+			payload:     `{}`,
+			wantErr:     "unexpected status 404: error",
+			wantRequest: request{method: http.MethodPost, body: `{}`},
+			wantCalls:   1,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			var (
+				mu    sync.Mutex
+				calls int
+				last  request
+			)
+
+			server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				body, _ := io.ReadAll(r.Body)
+
+				mu.Lock()
+				status := tt.statuses[min(calls, len(tt.statuses)-1)]
+				calls++
+				last = request{method: r.Method, body: string(body)}
+				mu.Unlock()
+
+				w.WriteHeader(status)
+				_, _ = w.Write([]byte("error"))
+			}))
+			defer server.Close()
+
+			a := tt.webhook
+			a.URL = server.URL
+
+			got, err := a.Run(t.Context(), json.RawMessage(tt.payload))
+
+			mu.Lock()
+			defer mu.Unlock()
+
+			assert.Equal(t, tt.wantCalls, calls)
+			assert.Equal(t, tt.wantRequest, last)
+
+			if tt.wantErr != "" {
+				require.ErrorContains(t, err, tt.wantErr)
+
+				return
+			}
+
+			require.NoError(t, err)
+
+			var response webhook.Response
+			require.NoError(t, json.Unmarshal(got, &response))
+			assert.Equal(t, tt.wantStatus, response.StatusCode)
+		})
+	}
+}
+
+func TestWebhook_Run_CloudEvents(t *testing.T) {
+	t.Parallel()
+
+	var (
+		header http.Header
+		body   string
+	)
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		b, _ := io.ReadAll(r.Body)
+		body = string(b)
+		header = r.Header
+
+		w.WriteHeader(http.StatusOK)
+	}))
+	defer server.Close()
+
+	payload := `{"action": "create", "collection": "tickets", "record": {"id": "test-ticket"}}`
+
+	a := webhook.Webhook{URL: server.URL, Format: "cloudevents"}
+	a.SetAppURL("https://catalyst.example.com")
+
+	_, err := a.Run(t.Context(), json.RawMessage(payload))
+	require.NoError(t, err)
+
+	var event map[string]any
+	require.NoError(t, json.Unmarshal([]byte(body), &event))
+
+	assert.Equal(t, "application/cloudevents+json", header.Get("Content-Type"))
+	assert.Equal(t, "1.0", event["specversion"])
+	assert.Equal(t, "catalyst.tickets.create", event["type"])
+	assert.Equal(t, "https://catalyst.example.com", event["source"])
+	assert.Equal(t, "test-ticket", event["subject"])
+	assert.Equal(t, map[string]any{"id": "test-ticket"}, event["data"].(map[string]any)["record"])
+
+	a = webhook.Webhook{URL: server.URL, Format: "cloudevents-binary", Body: `{"id": {{json .record.id}}}`}
+	a.SetAppURL("https://catalyst.example.com")
+
+	_, err = a.Run(t.Context(), json.RawMessage(payload))
+	require.NoError(t, err)
+
+	assert.JSONEq(t, `{"id": "test-ticket"}`, body)
+	assert.Equal(t, "application/json", header.Get("Content-Type"))
+	assert.Equal(t, "catalyst.tickets.create", header.Get("Ce-Type"))
+	assert.Equal(t, "test-ticket", header.Get("Ce-Subject"))
+	assert.Equal(t, "https://catalyst.example.com", header.Get("Ce-Source"))
+	assert.NotEmpty(t, header.Get("Ce-Id"))
+}
+
+func TestWebhook_Validate(t *testing.T) {
+	t.Parallel()
+
+	require.NoError(t, (&webhook.Webhook{Format: "cloudevents-binary"}).Validate())
+	require.Error(t, (&webhook.Webhook{Format: "xml"}).Validate())
+	require.NoError(t, (&webhook.Webhook{Retries: 5, RetryDelay: 60}).Validate())
+	require.ErrorContains(t, (&webhook.Webhook{Retries: 6}).Validate(), "retries must be between 0 and 5")
+	require.ErrorContains(t, (&webhook.Webhook{Retries: -1}).Validate(), "retries must be between 0 and 5")
+	require.ErrorContains(t, (&webhook.Webhook{RetryDelay: 61}).Validate(), "retry_delay must be between 0 and 60 seconds")
+}
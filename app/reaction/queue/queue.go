@@ -0,0 +1,259 @@
+package queue
+
+import (
+	"context"
+	"database/sql"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"log/slog"
+	"time"
+
+	"github.com/SecurityBrewery/catalyst/app/database/sqlc"
+	"github.com/SecurityBrewery/catalyst/app/pointer"
+	"github.com/SecurityBrewery/catalyst/app/reaction/action"
+	"github.com/SecurityBrewery/catalyst/app/settings"
+	"github.com/SecurityBrewery/catalyst/app/worker"
+)
+
+const (
+	defaultWorkers = 4
+	pruneInterval  = time.Hour
+	retention      = 30 * 24 * time.Hour
+)
+
+// Queue is a SQLite backed job queue for reactions. Jobs are persisted in the
+// reaction_jobs table, so they survive restarts, and are drained by a fixed
+// pool of workers. Jobs that fail before their action runs, e.g. because
+// the database is busy, are retried with exponential backoff until they run
+// out of attempts. Failed actions are not retried, as they may have side
+// effects, and their jobs are marked as dead right away. Dead jobs are kept
+// for 30 days.
+//
+// Every job records a single reaction run, which is started by the first
+// attempt and finished by the last one.
+type Queue struct {
+	queries *sqlc.Queries
+	runner  *action.Runner
+	pool    *worker.Pool
+}
+
+func New(queries *sqlc.Queries, runner *action.Runner) *Queue {
+	q := &Queue{
+		queries: queries,
+		runner:  runner,
+	}
+
+	q.pool = worker.NewPool(q.next)
+
+	return q
+}
+
+// Start requeues jobs that were interrupted by a previous shutdown, fails
+// the runs that were interrupted and will not be resumed by a job, and
+// starts the workers.
+func (q *Queue) Start(ctx context.Context) error {
+	if err := q.queries.RequeueRunningReactionJobs(ctx, worker.Now()); err != nil {
+		return fmt.Errorf("failed to requeue running jobs: %w", err)
+	}
+
+	if err := q.queries.FailOrphanedReactionRuns(ctx, sqlc.FailOrphanedReactionRunsParams{
+		Error: pointer.Pointer("interrupted by a restart"),
+		Now:   pointer.Pointer(worker.Now()),
+	}); err != nil {
+		return fmt.Errorf("failed to fail interrupted runs: %w", err)
+	}
+
+	q.pool.Start(ctx, defaultWorkers, worker.Task{Interval: pruneInterval, Run: q.prune})
+
+	return nil
+}
+
+// Stop cancels all running jobs and waits for the workers to exit.
+func (q *Queue) Stop() {
+	q.pool.Stop()
+}
+
+// Enqueue persists a new job for the given reaction.
+func (q *Queue) Enqueue(ctx context.Context, reactionID, trigger string, payload json.RawMessage) (*sqlc.ReactionJob, error) {
+	return q.enqueue(ctx, reactionID, trigger, payload, nil)
+}
+
+// EnqueueRun records a new running reaction run and persists a job that
+// finishes it, so the run can be returned to the caller before the job is
+// processed.
+func (q *Queue) EnqueueRun(ctx context.Context, reactionID, trigger string, payload json.RawMessage) (*sqlc.ReactionRun, error) {
+	run, err := action.StartRun(ctx, q.queries, reactionID, trigger, payload)
+	if err != nil {
+		return nil, err
+	}
+
+	if _, err := q.enqueue(ctx, reactionID, trigger, payload, &run.ID); err != nil {
+		if finishErr := action.FinishRun(ctx, q.queries, run.ID, nil, err); finishErr != nil {
+			slog.ErrorContext(ctx, "failed to finish reaction run", "error", finishErr, "run_id", run.ID)
+		}
+
+		return nil, err
+	}
+
+	return run, nil
+}
+
+func (q *Queue) enqueue(ctx context.Context, reactionID, trigger string, payload json.RawMessage, runID *string) (*sqlc.ReactionJob, error) {
+	job, err := q.queries.CreateReactionJob(ctx, sqlc.CreateReactionJobParams{
+		Reaction: reactionID,
+		Trigger:  trigger,
+		Payload:  payload,
+		Run:      runID,
+		Now:      worker.Now(),
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to enqueue job: %w", err)
+	}
+
+	q.pool.Wake()
+
+	return &job, nil
+}
+
+// prune deletes the jobs that failed permanently before the retention
+// period.
+func (q *Queue) prune(ctx context.Context) {
+	if err := q.queries.DeleteDeadReactionJobs(ctx, worker.Now().Add(-retention)); err != nil && ctx.Err() == nil {
+		slog.ErrorContext(ctx, "failed to prune reaction jobs", "error", err)
+	}
+}
+
+// next claims and processes a single job. It reports whether a job was found.
+func (q *Queue) next(ctx context.Context) bool {
+	job, ok := worker.Claim(ctx, "reaction job", q.queries.ClaimReactionJob)
+	if !ok {
+		return false
+	}
+
+	q.process(ctx, &job)
+
+	return true
+}
+
+func (q *Queue) process(ctx context.Context, job *sqlc.ReactionJob) {
+	output, err := q.run(ctx, job)
+	if err == nil {
+		q.finishRun(ctx, job, output, nil)
+
+		if err := q.queries.DeleteReactionJob(context.WithoutCancel(ctx), job.ID); err != nil {
+			slog.ErrorContext(ctx, "failed to delete reaction job", "error", err, "job_id", job.ID)
+		}
+
+		return
+	}
+
+	if ctx.Err() != nil {
+		// the queue is shutting down, the job is requeued on the next start
+		return
+	}
+
+	var transient *transientError
+	if !errors.As(err, &transient) || job.Attempts >= job.MaxAttempts {
+		slog.ErrorContext(ctx, "reaction job failed permanently", "error", err, "job_id", job.ID, "reaction_id", job.Reaction, "attempts", job.Attempts)
+
+		q.finishRun(ctx, job, nil, err)
+
+		if err := q.queries.BuryReactionJob(ctx, sqlc.BuryReactionJobParams{
+			ID:        job.ID,
+			LastError: pointer.Pointer(err.Error()),
+			Now:       worker.Now(),
+		}); err != nil {
+			slog.ErrorContext(ctx, "failed to bury reaction job", "error", err, "job_id", job.ID)
+		}
+
+		return
+	}
+
+	runAfter := worker.Now().Add(worker.Backoff(job.Attempts))
+
+	slog.WarnContext(ctx, "reaction job failed, retrying", "error", err, "job_id", job.ID, "reaction_id", job.Reaction, "attempts", job.Attempts, "run_after", runAfter)
+
+	if err := q.queries.RetryReactionJob(ctx, sqlc.RetryReactionJobParams{
+		ID:        job.ID,
+		LastError: pointer.Pointer(err.Error()),
+		RunAfter:  runAfter,
+		Now:       worker.Now(),
+	}); err != nil {
+		slog.ErrorContext(ctx, "failed to retry reaction job", "error", err, "job_id", job.ID)
+	}
+}
+
+func (q *Queue) run(ctx context.Context, job *sqlc.ReactionJob) ([]byte, error) {
+	reaction, err := q.queries.GetReaction(ctx, job.Reaction)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, fmt.Errorf("reaction %s not found: %w", job.Reaction, err)
+		}
+
+		return nil, &transientError{fmt.Errorf("failed to get reaction %s: %w", job.Reaction, err)}
+	}
+
+	settings, err := settings.Load(ctx, q.queries)
+	if err != nil {
+		return nil, &transientError{fmt.Errorf("failed to load settings: %w", err)}
+	}
+
+	if job.Run == nil {
+		if err := q.startRun(ctx, job); err != nil {
+			return nil, &transientError{err}
+		}
+	}
+
+	return q.runner.Run(action.ReactionContext(ctx, reaction.ID), settings.Meta.AppURL, reaction.Action, reaction.Actiondata, job.Payload)
+}
+
+// startRun records the run of a job that was enqueued without one. The run
+// is stored in the job, so a restart resumes it instead of starting another.
+func (q *Queue) startRun(ctx context.Context, job *sqlc.ReactionJob) error {
+	run, err := action.StartRun(ctx, q.queries, job.Reaction, job.Trigger, job.Payload)
+	if err != nil {
+		return err
+	}
+
+	if err := q.queries.SetReactionJobRun(ctx, sqlc.SetReactionJobRunParams{
+		ID:  job.ID,
+		Run: &run.ID,
+		Now: worker.Now(),
+	}); err != nil {
+		if finishErr := action.FinishRun(ctx, q.queries, run.ID, nil, err); finishErr != nil {
+			slog.ErrorContext(ctx, "failed to finish reaction run", "error", finishErr, "run_id", run.ID)
+		}
+
+		return fmt.Errorf("failed to store reaction run: %w", err)
+	}
+
+	job.Run = &run.ID
+
+	return nil
+}
+
+// transientError marks failures that happen before the action runs. Only
+// these are retried.
+type transientError struct {
+	err error
+}
+
+func (e *transientError) Error() string {
+	return e.err.Error()
+}
+
+func (e *transientError) Unwrap() error {
+	return e.err
+}
+
+// finishRun stores the result in the run of the job, if the job has one.
+func (q *Queue) finishRun(ctx context.Context, job *sqlc.ReactionJob, output []byte, runErr error) {
+	if job.Run == nil {
+		return
+	}
+
+	if err := action.FinishRun(ctx, q.queries, *job.Run, output, runErr); err != nil {
+		slog.ErrorContext(ctx, "failed to finish reaction run", "error", err, "job_id", job.ID, "run_id", *job.Run)
+	}
+}
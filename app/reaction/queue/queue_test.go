@@ -0,0 +1,253 @@
+package queue
+
+import (
+	"database/sql"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/SecurityBrewery/catalyst/app/data"
+	"github.com/SecurityBrewery/catalyst/app/database/sqlc"
+	"github.com/SecurityBrewery/catalyst/app/pointer"
+	"github.com/SecurityBrewery/catalyst/app/reaction/action"
+	"github.com/SecurityBrewery/catalyst/app/worker"
+)
+
+func TestQueue_Success(t *testing.T) {
+	t.Parallel()
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		w.WriteHeader(http.StatusOK)
+	}))
+	t.Cleanup(server.Close)
+
+	queries := data.NewTestDB(t, t.TempDir())
+
+	reaction, err := queries.CreateReaction(t.Context(), sqlc.CreateReactionParams{
+		Name:        "test",
+		Action:      "webhook",
+		Actiondata:  []byte(`{"url":"` + server.URL + `"}`),
+		Trigger:     "hook",
+		Triggerdata: []byte(`{}`),
+	})
+	require.NoError(t, err)
+
+	q := New(queries, action.NewRunner(queries, nil, nil, nil))
+
+	job, err := q.Enqueue(t.Context(), reaction.ID, reaction.Trigger, json.RawMessage(`{}`))
+	require.NoError(t, err)
+
+	require.True(t, q.next(t.Context()))
+	require.False(t, q.next(t.Context()))
+
+	_, err = queries.GetReactionJob(t.Context(), job.ID)
+	require.ErrorIs(t, err, sql.ErrNoRows)
+
+	runs, err := queries.ListReactionRuns(t.Context(), sqlc.ListReactionRunsParams{Reaction: reaction.ID, Limit: 10})
+	require.NoError(t, err)
+	require.Len(t, runs, 1)
+	assert.Equal(t, "success", runs[0].Status)
+}
+
+func TestQueue_RetryAndBury(t *testing.T) {
+	t.Parallel()
+
+	queries := data.NewTestDB(t, t.TempDir())
+
+	reaction, err := queries.CreateReaction(t.Context(), sqlc.CreateReactionParams{
+		Name:        "test",
+		Action:      "unknown",
+		Actiondata:  []byte(`{}`),
+		Trigger:     "hook",
+		Triggerdata: []byte(`{}`),
+	})
+	require.NoError(t, err)
+
+	// the settings cannot be loaded, so the job fails before its action runs
+	_, err = queries.WriteDB.ExecContext(t.Context(), "INSERT OR REPLACE INTO _params (key, value) VALUES ('settings', '\"invalid\"')")
+	require.NoError(t, err)
+
+	q := New(queries, action.NewRunner(queries, nil, nil, nil))
+
+	job, err := q.Enqueue(t.Context(), reaction.ID, reaction.Trigger, json.RawMessage(`{}`))
+	require.NoError(t, err)
+
+	require.True(t, q.next(t.Context()))
+
+	retried, err := queries.GetReactionJob(t.Context(), job.ID)
+	require.NoError(t, err)
+	assert.Equal(t, "pending", retried.Status)
+	assert.Equal(t, int64(1), retried.Attempts)
+	assert.True(t, retried.RunAfter.After(time.Now()))
+	require.NotNil(t, retried.LastError)
+	assert.Contains(t, *retried.LastError, "failed to load settings")
+
+	// the job is not due yet
+	require.False(t, q.next(t.Context()))
+
+	retried.Attempts = retried.MaxAttempts
+	q.process(t.Context(), &retried)
+
+	buried, err := queries.GetReactionJob(t.Context(), job.ID)
+	require.NoError(t, err)
+	assert.Equal(t, "dead", buried.Status)
+
+	runs, err := queries.ListReactionRuns(t.Context(), sqlc.ListReactionRunsParams{Reaction: reaction.ID, Limit: 10})
+	require.NoError(t, err)
+	assert.Empty(t, runs, "the action never ran")
+}
+
+func TestQueue_FailedActionIsNotRetried(t *testing.T) {
+	t.Parallel()
+
+	queries := data.NewTestDB(t, t.TempDir())
+
+	reaction, err := queries.CreateReaction(t.Context(), sqlc.CreateReactionParams{
+		Name:        "test",
+		Action:      "unknown",
+		Actiondata:  []byte(`{}`),
+		Trigger:     "hook",
+		Triggerdata: []byte(`{}`),
+	})
+	require.NoError(t, err)
+
+	q := New(queries, action.NewRunner(queries, nil, nil, nil))
+
+	job, err := q.Enqueue(t.Context(), reaction.ID, reaction.Trigger, json.RawMessage(`{}`))
+	require.NoError(t, err)
+
+	require.True(t, q.next(t.Context()))
+	require.False(t, q.next(t.Context()))
+
+	buried, err := queries.GetReactionJob(t.Context(), job.ID)
+	require.NoError(t, err)
+	assert.Equal(t, "dead", buried.Status)
+	assert.Equal(t, int64(1), buried.Attempts)
+	require.NotNil(t, buried.Run)
+
+	runs, err := queries.ListReactionRuns(t.Context(), sqlc.ListReactionRunsParams{Reaction: reaction.ID, Limit: 10})
+	require.NoError(t, err)
+	require.Len(t, runs, 1)
+	assert.Equal(t, *buried.Run, runs[0].ID)
+	assert.Equal(t, action.RunStatusFailed, runs[0].Status)
+	require.NotNil(t, runs[0].Error)
+	assert.Contains(t, *runs[0].Error, `action "unknown" not found`)
+}
+
+func TestQueue_EnqueueRun(t *testing.T) {
+	t.Parallel()
+
+	queries := data.NewTestDB(t, t.TempDir())
+
+	reaction, err := queries.CreateReaction(t.Context(), sqlc.CreateReactionParams{
+		Name:        "test",
+		Action:      "unknown",
+		Actiondata:  []byte(`{}`),
+		Trigger:     "webhook",
+		Triggerdata: []byte(`{}`),
+	})
+	require.NoError(t, err)
+
+	q := New(queries, action.NewRunner(queries, nil, nil, nil))
+
+	run, err := q.EnqueueRun(t.Context(), reaction.ID, reaction.Trigger, json.RawMessage(`{}`))
+	require.NoError(t, err)
+	assert.Equal(t, action.RunStatusRunning, run.Status)
+
+	// the failed action finishes the run, no further runs are recorded
+	require.True(t, q.next(t.Context()))
+	require.False(t, q.next(t.Context()))
+
+	got, err := queries.GetReactionRun(t.Context(), sqlc.GetReactionRunParams{ID: run.ID, Reaction: reaction.ID})
+	require.NoError(t, err)
+	assert.Equal(t, action.RunStatusFailed, got.Status)
+	require.NotNil(t, got.Error)
+	assert.Contains(t, *got.Error, `action "unknown" not found`)
+
+	runs, err := queries.ListReactionRuns(t.Context(), sqlc.ListReactionRunsParams{Reaction: reaction.ID, Limit: 10})
+	require.NoError(t, err)
+	assert.Len(t, runs, 1)
+}
+
+func TestQueue_StartRequeuesRunningJobs(t *testing.T) {
+	t.Parallel()
+
+	queries := data.NewTestDB(t, t.TempDir())
+
+	q := New(queries, action.NewRunner(queries, nil, nil, nil))
+
+	job, err := q.Enqueue(t.Context(), "r-test-hook", "hook", json.RawMessage(`{}`))
+	require.NoError(t, err)
+
+	_, err = queries.ClaimReactionJob(t.Context(), worker.Now())
+	require.NoError(t, err)
+
+	require.NoError(t, queries.RequeueRunningReactionJobs(t.Context(), worker.Now()))
+
+	requeued, err := queries.GetReactionJob(t.Context(), job.ID)
+	require.NoError(t, err)
+	assert.Equal(t, "pending", requeued.Status)
+}
+
+func TestQueue_StartFailsOrphanedRuns(t *testing.T) {
+	t.Parallel()
+
+	queries := data.NewTestDB(t, t.TempDir())
+
+	q := New(queries, action.NewRunner(queries, nil, nil, nil))
+
+	// a synchronous run that was interrupted by a restart
+	orphaned, err := action.StartRun(t.Context(), queries, "r-test-webhook", "webhook", json.RawMessage(`{}`))
+	require.NoError(t, err)
+
+	// an asynchronous run that is resumed by its job
+	queued, err := q.EnqueueRun(t.Context(), "r-test-webhook", "webhook", json.RawMessage(`{}`))
+	require.NoError(t, err)
+
+	require.NoError(t, q.Start(t.Context()))
+	q.Stop()
+
+	got, err := queries.GetReactionRun(t.Context(), sqlc.GetReactionRunParams{ID: orphaned.ID, Reaction: "r-test-webhook"})
+	require.NoError(t, err)
+	assert.Equal(t, action.RunStatusFailed, got.Status)
+	require.NotNil(t, got.Error)
+	assert.Equal(t, "interrupted by a restart", *got.Error)
+	assert.NotNil(t, got.Finished)
+
+	got, err = queries.GetReactionRun(t.Context(), sqlc.GetReactionRunParams{ID: queued.ID, Reaction: "r-test-webhook"})
+	require.NoError(t, err)
+	assert.NotEqual(t, "interrupted by a restart", pointer.Dereference(got.Error))
+}
+
+func TestQueue_prune(t *testing.T) {
+	t.Parallel()
+
+	queries := data.NewTestDB(t, t.TempDir())
+
+	q := New(queries, action.NewRunner(queries, nil, nil, nil))
+
+	ids := map[string]string{}
+
+	for _, status := range []string{"dead", "pending"} {
+		job, err := q.Enqueue(t.Context(), "r-test-hook", "hook", json.RawMessage(`{}`))
+		require.NoError(t, err)
+
+		_, err = queries.WriteDB.ExecContext(t.Context(), "UPDATE reaction_jobs SET status = ?, updated = ? WHERE id = ?", status, worker.Now().Add(-retention-time.Hour), job.ID)
+		require.NoError(t, err)
+
+		ids[status] = job.ID
+	}
+
+	q.prune(t.Context())
+
+	_, err := queries.GetReactionJob(t.Context(), ids["dead"])
+	require.ErrorIs(t, err, sql.ErrNoRows, "dead jobs are pruned")
+
+	_, err = queries.GetReactionJob(t.Context(), ids["pending"])
+	require.NoError(t, err, "pending jobs are kept")
+}
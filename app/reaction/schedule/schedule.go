@@ -8,23 +8,33 @@ import (
 	"log/slog"
 
 	"github.com/go-co-op/gocron/v2"
+	"github.com/robfig/cron/v3"
 
 	"github.com/SecurityBrewery/catalyst/app/database"
 	"github.com/SecurityBrewery/catalyst/app/database/sqlc"
-	"github.com/SecurityBrewery/catalyst/app/reaction/action"
-	"github.com/SecurityBrewery/catalyst/app/settings"
+	"github.com/SecurityBrewery/catalyst/app/reaction/queue"
 )
 
 type Scheduler struct {
 	scheduler gocron.Scheduler
 	queries   *sqlc.Queries
+	queue     *queue.Queue
 }
 
 type Schedule struct {
 	Expression string `json:"expression"`
 }
 
-func New(ctx context.Context, queries *sqlc.Queries) (*Scheduler, error) {
+// Validate checks that the expression is a valid cron expression.
+func (s *Schedule) Validate() error {
+	if _, err := cron.ParseStandard(s.Expression); err != nil {
+		return fmt.Errorf("invalid schedule expression: %w", err)
+	}
+
+	return nil
+}
+
+func New(ctx context.Context, queries *sqlc.Queries, queue *queue.Queue) (*Scheduler, error) {
 	innerScheduler, err := gocron.NewScheduler()
 	if err != nil {
 		return nil, fmt.Errorf("failed to create scheduler: %w", err)
@@ -33,6 +43,7 @@ func New(ctx context.Context, queries *sqlc.Queries) (*Scheduler, error) {
 	scheduler := &Scheduler{
 		scheduler: innerScheduler,
 		queries:   queries,
+		queue:     queue,
 	}
 
 	if err := scheduler.loadJobs(ctx); err != nil {
@@ -54,16 +65,8 @@ func (s *Scheduler) AddReaction(reaction *sqlc.Reaction) error {
 		gocron.CronJob(schedule.Expression, false),
 		gocron.NewTask(
 			func(ctx context.Context) {
-				settings, err := settings.Load(ctx, s.queries)
-				if err != nil {
-					slog.ErrorContext(ctx, "Failed to load settings", "error", err)
-
-					return
-				}
-
-				_, err = action.Run(ctx, settings.Meta.AppURL, s.queries, reaction.Action, reaction.Actiondata, json.RawMessage("{}"))
-				if err != nil {
-					slog.ErrorContext(ctx, "Failed to run schedule reaction", "error", err, "reaction_id", reaction.ID)
+				if _, err := s.queue.Enqueue(ctx, reaction.ID, reaction.Trigger, json.RawMessage("{}")); err != nil {
+					slog.ErrorContext(ctx, "Failed to enqueue schedule reaction", "error", err, "reaction_id", reaction.ID)
 				}
 			},
 		),
@@ -1,17 +1,62 @@
 package reaction
 
 import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+
 	"github.com/go-chi/chi/v5"
 
 	"github.com/SecurityBrewery/catalyst/app/database/sqlc"
 	"github.com/SecurityBrewery/catalyst/app/hook"
+	"github.com/SecurityBrewery/catalyst/app/reaction/action"
+	"github.com/SecurityBrewery/catalyst/app/reaction/queue"
+	"github.com/SecurityBrewery/catalyst/app/reaction/schedule"
 	reactionHook "github.com/SecurityBrewery/catalyst/app/reaction/trigger/hook"
 	"github.com/SecurityBrewery/catalyst/app/reaction/trigger/webhook"
+	"github.com/SecurityBrewery/catalyst/app/secret"
 )
 
-func BindHooks(hooks *hook.Hooks, router chi.Router, queries *sqlc.Queries, test bool) error {
-	reactionHook.BindHooks(hooks, queries, test)
-	webhook.BindHooks(router, queries)
+func BindHooks(hooks *hook.Hooks, router chi.Router, queries *sqlc.Queries, secrets *secret.Cipher, queue *queue.Queue, runner *action.Runner) error {
+	reactionHook.BindHooks(hooks, queries, queue, runner)
+	webhook.BindHooks(router, queries, secrets, queue, runner)
 
 	return nil
 }
+
+// ValidateTrigger checks that the trigger exists and that its data can be
+// decoded without unknown fields and is valid.
+func ValidateTrigger(trigger string, triggerData json.RawMessage) error {
+	switch trigger {
+	case "hook":
+		var hook reactionHook.Hook
+		if err := decodeStrict(triggerData, &hook); err != nil {
+			return fmt.Errorf("invalid hook trigger data: %w", err)
+		}
+
+		return hook.Validate()
+	case "schedule":
+		var schedule schedule.Schedule
+		if err := decodeStrict(triggerData, &schedule); err != nil {
+			return fmt.Errorf("invalid schedule trigger data: %w", err)
+		}
+
+		return schedule.Validate()
+	case "webhook":
+		var webhook webhook.Webhook
+		if err := decodeStrict(triggerData, &webhook); err != nil {
+			return fmt.Errorf("invalid webhook trigger data: %w", err)
+		}
+
+		return webhook.Validate()
+	default:
+		return fmt.Errorf("trigger %q not found", trigger)
+	}
+}
+
+func decodeStrict(data json.RawMessage, v any) error {
+	decoder := json.NewDecoder(bytes.NewReader(data))
+	decoder.DisallowUnknownFields()
+
+	return decoder.Decode(v)
+}
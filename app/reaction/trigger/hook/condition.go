@@ -0,0 +1,33 @@
+package hook
+
+import (
+	"strings"
+
+	"github.com/SecurityBrewery/catalyst/app/condition"
+)
+
+// match reports whether the condition of the hook is true for the given
+// variables. Hooks without a condition always match.
+func (h *Hook) match(vars map[string]any) (bool, error) {
+	return condition.Match(h.Condition, vars)
+}
+
+// changed reports whether one of the fields of the hook changed. Hooks
+// without fields match any change.
+func (h *Hook) changed(vars map[string]any) bool {
+	if len(h.Fields) == 0 {
+		return true
+	}
+
+	changes, _ := vars["changes"].(map[string]any)
+
+	for path := range changes {
+		for _, field := range h.Fields {
+			if path == field || strings.HasPrefix(path, field+".") {
+				return true
+			}
+		}
+	}
+
+	return false
+}
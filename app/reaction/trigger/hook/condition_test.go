@@ -0,0 +1,180 @@
+package hook
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/SecurityBrewery/catalyst/app/condition"
+	"github.com/SecurityBrewery/catalyst/app/data"
+	"github.com/SecurityBrewery/catalyst/app/database/sqlc"
+)
+
+func TestHook_match(t *testing.T) {
+	t.Parallel()
+
+	vars, err := condition.Vars("tickets", "update", json.RawMessage(`{
+		"record": {"type": "alert", "state": {"severity": "High"}},
+		"auth": {"username": "u_bob_analyst"}
+	}`))
+	require.NoError(t, err)
+
+	tests := []struct {
+		name      string
+		condition string
+		want      bool
+		wantErr   bool
+	}{
+		{name: "empty", condition: "", want: true},
+		{name: "match", condition: `record.type == "alert" && record.state.severity == "High"`, want: true},
+		{name: "no match", condition: `record.type == "incident"`, want: false},
+		{name: "auth", condition: `auth.username == "u_bob_analyst"`, want: true},
+		{name: "event", condition: `collection == "tickets" && event == "update"`, want: true},
+		{name: "old record", condition: `old_record == null`, want: true},
+		{name: "has", condition: `has(record.owner) && record.owner == "u_test"`, want: false},
+		{name: "missing key", condition: `record.owner == "u_test"`, wantErr: true},
+		{name: "not a bool", condition: `record.type`, wantErr: true},
+		{name: "invalid", condition: `record.type ==`, wantErr: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			hook := &Hook{Condition: tt.condition}
+
+			got, err := hook.match(vars)
+			if tt.wantErr {
+				require.Error(t, err)
+
+				return
+			}
+
+			require.NoError(t, err)
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
+
+func Test_findByHookTrigger(t *testing.T) {
+	t.Parallel()
+
+	queries := data.NewTestDB(t, t.TempDir())
+
+	for name, condition := range map[string]string{
+		"alert":    `record.type == "alert"`,
+		"incident": `record.type == "incident"`,
+		"broken":   `record.type ==`,
+	} {
+		_, err := queries.CreateReaction(t.Context(), sqlc.CreateReactionParams{
+			Name:        name,
+			Action:      "webhook",
+			Actiondata:  []byte(`{}`),
+			Trigger:     "hook",
+			Triggerdata: []byte(`{"collections":["tickets"],"events":["create"],"condition":` + quote(condition) + `}`),
+		})
+		require.NoError(t, err)
+	}
+
+	reactions, err := findByHookTrigger(t.Context(), queries, "tickets", "create", false, json.RawMessage(`{"record":{"type":"alert"}}`))
+	require.NoError(t, err)
+
+	var names []string
+	for _, reaction := range reactions {
+		names = append(names, reaction.Name)
+	}
+
+	assert.ElementsMatch(t, []string{"alert", "Hook"}, names)
+}
+
+func Test_findByHookTrigger_beforeConditionFails(t *testing.T) {
+	t.Parallel()
+
+	queries := data.NewTestDB(t, t.TempDir())
+
+	_, err := queries.CreateReaction(t.Context(), sqlc.CreateReactionParams{
+		Name:        "Policy",
+		Action:      "webhook",
+		Actiondata:  []byte(`{}`),
+		Trigger:     "hook",
+		Triggerdata: []byte(`{"collections":["tickets"],"events":["create"],"condition":"record.owner == \"u_test\"","before":true}`),
+	})
+	require.NoError(t, err)
+
+	// the record has no owner, so the condition fails to evaluate
+	_, err = findByHookTrigger(t.Context(), queries, "tickets", "create", true, json.RawMessage(`{"record":{"type":"alert"}}`))
+	require.EqualError(t, err, "condition of reaction Policy failed")
+}
+
+func TestHook_changed(t *testing.T) {
+	t.Parallel()
+
+	vars, err := condition.Vars("tickets", "update", json.RawMessage(`{
+		"record": {"owner": "u_admin", "state": {"severity": "High"}},
+		"changes": {
+			"owner": {"old": "u_bob_analyst", "new": "u_admin"},
+			"state.severity": {"old": "Low", "new": "High"}
+		}
+	}`))
+	require.NoError(t, err)
+
+	tests := []struct {
+		name   string
+		fields []string
+		want   bool
+	}{
+		{name: "no fields", fields: nil, want: true},
+		{name: "field", fields: []string{"owner"}, want: true},
+		{name: "nested field", fields: []string{"state.severity"}, want: true},
+		{name: "parent field", fields: []string{"state"}, want: true},
+		{name: "any field", fields: []string{"name", "owner"}, want: true},
+		{name: "unchanged", fields: []string{"name"}, want: false},
+		{name: "prefix only", fields: []string{"own"}, want: false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			hook := &Hook{Fields: tt.fields}
+
+			assert.Equal(t, tt.want, hook.changed(vars))
+		})
+	}
+
+	hook := &Hook{Condition: `changes.owner.new == "u_admin" && "state.severity" in changes`}
+
+	matched, err := hook.match(vars)
+	require.NoError(t, err)
+	assert.True(t, matched)
+}
+
+func Test_findByHookTrigger_fields(t *testing.T) {
+	t.Parallel()
+
+	queries := data.NewTestDB(t, t.TempDir())
+
+	_, err := queries.CreateReaction(t.Context(), sqlc.CreateReactionParams{
+		Name:        "owner changed",
+		Action:      "webhook",
+		Actiondata:  []byte(`{}`),
+		Trigger:     "hook",
+		Triggerdata: []byte(`{"collections":["tickets"],"events":["update"],"fields":["owner"]}`),
+	})
+	require.NoError(t, err)
+
+	reactions, err := findByHookTrigger(t.Context(), queries, "tickets", "update", false, json.RawMessage(`{"changes":{"name":{"old":"a","new":"b"}}}`))
+	require.NoError(t, err)
+	assert.Empty(t, reactions)
+
+	reactions, err = findByHookTrigger(t.Context(), queries, "tickets", "update", false, json.RawMessage(`{"changes":{"owner":{"old":null,"new":"u_admin"}}}`))
+	require.NoError(t, err)
+	require.Len(t, reactions, 1)
+	assert.Equal(t, "owner changed", reactions[0].Name)
+}
+
+func quote(s string) string {
+	b, _ := json.Marshal(s)
+
+	return string(b)
+}
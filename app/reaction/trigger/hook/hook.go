@@ -9,10 +9,12 @@ import (
 	"slices"
 
 	"github.com/SecurityBrewery/catalyst/app/auth/usercontext"
+	"github.com/SecurityBrewery/catalyst/app/condition"
 	"github.com/SecurityBrewery/catalyst/app/database"
 	"github.com/SecurityBrewery/catalyst/app/database/sqlc"
 	"github.com/SecurityBrewery/catalyst/app/hook"
 	"github.com/SecurityBrewery/catalyst/app/reaction/action"
+	"github.com/SecurityBrewery/catalyst/app/reaction/queue"
 	"github.com/SecurityBrewery/catalyst/app/settings"
 	"github.com/SecurityBrewery/catalyst/app/webhook"
 )
@@ -20,21 +22,58 @@ import (
 type Hook struct {
 	Collections []string `json:"collections"`
 	Events      []string `json:"events"`
+	// Condition is an optional CEL expression, the reaction is only run if
+	// it evaluates to true, e.g. `record.type == "alert"`.
+	Condition string `json:"condition,omitempty"`
+	// Fields restricts update events to changes of the given fields, e.g.
+	// "owner" or "state.severity". A field also matches changes of its
+	// nested fields, so "state" matches any change of the state.
+	Fields []string `json:"fields,omitempty"`
+	// Before runs the reaction synchronously before the record is written.
+	// The request is rejected with a client error if the action returns an
+	// object with an error, e.g. {"error": "resolution required", "status": 422},
+	// and fails with an internal error if the action itself fails or the
+	// condition cannot be evaluated.
+	Before bool `json:"before,omitempty"`
 }
 
-func BindHooks(hooks *hook.Hooks, queries *sqlc.Queries, test bool) {
+// rejection is the output of a before hook reaction that rejects a record.
+type rejection struct {
+	Error  string `json:"error"`
+	Status int    `json:"status"`
+}
+
+// Validate checks that the condition compiles.
+func (h *Hook) Validate() error {
+	if h.Condition == "" {
+		return nil
+	}
+
+	return condition.Validate(h.Condition)
+}
+
+func BindHooks(hooks *hook.Hooks, queries *sqlc.Queries, queue *queue.Queue, runner *action.Runner) {
+	hooks.OnRecordBeforeCreateRequest.SubscribeWithError(func(ctx context.Context, table string, record any) error {
+		return runBeforeHooks(ctx, queries, runner, database.CreateAction, table, record)
+	})
+	hooks.OnRecordBeforeUpdateRequest.SubscribeWithError(func(ctx context.Context, table string, record any) error {
+		return runBeforeHooks(ctx, queries, runner, database.UpdateAction, table, record)
+	})
+	hooks.OnRecordBeforeDeleteRequest.SubscribeWithError(func(ctx context.Context, table string, record any) error {
+		return runBeforeHooks(ctx, queries, runner, database.DeleteAction, table, record)
+	})
 	hooks.OnRecordAfterCreateRequest.Subscribe(func(ctx context.Context, table string, record any) {
-		bindHook(ctx, queries, database.CreateAction, table, record, test)
+		bindHook(ctx, queries, queue, database.CreateAction, table, record)
 	})
 	hooks.OnRecordAfterUpdateRequest.Subscribe(func(ctx context.Context, table string, record any) {
-		bindHook(ctx, queries, database.UpdateAction, table, record, test)
+		bindHook(ctx, queries, queue, database.UpdateAction, table, record)
 	})
 	hooks.OnRecordAfterDeleteRequest.Subscribe(func(ctx context.Context, table string, record any) {
-		bindHook(ctx, queries, database.DeleteAction, table, record, test)
+		bindHook(ctx, queries, queue, database.DeleteAction, table, record)
 	})
 }
 
-func bindHook(ctx context.Context, queries *sqlc.Queries, event, collection string, record any, test bool) {
+func bindHook(ctx context.Context, queries *sqlc.Queries, queue *queue.Queue, event, collection string, record any) {
 	user, ok := usercontext.UserFromContext(ctx)
 	if !ok {
 		slog.ErrorContext(ctx, "failed to get user from session")
@@ -42,32 +81,25 @@ func bindHook(ctx context.Context, queries *sqlc.Queries, event, collection stri
 		return
 	}
 
-	if !test {
-		go mustRunHook(context.Background(), queries, collection, event, record, user) //nolint:contextcheck
-	} else {
-		mustRunHook(ctx, queries, collection, event, record, user)
+	if err := enqueueHook(ctx, queries, queue, collection, event, record, user); err != nil {
+		slog.ErrorContext(ctx, fmt.Sprintf("failed to enqueue hook reaction: %v", err))
 	}
 }
 
-func mustRunHook(ctx context.Context, queries *sqlc.Queries, collection, event string, record any, auth *sqlc.User) {
-	if err := runHook(ctx, queries, collection, event, record, auth); err != nil {
-		slog.ErrorContext(ctx, fmt.Sprintf("failed to run hook reaction: %v", err))
+// runBeforeHooks runs the matching before hook reactions synchronously and
+// returns a hook.RejectedError if one of them rejects the record.
+func runBeforeHooks(ctx context.Context, queries *sqlc.Queries, runner *action.Runner, event, collection string, record any) error {
+	user, ok := usercontext.UserFromContext(ctx)
+	if !ok {
+		return errors.New("failed to get user from session")
 	}
-}
 
-func runHook(ctx context.Context, queries *sqlc.Queries, collection, event string, record any, auth *sqlc.User) error {
-	payload, err := json.Marshal(&webhook.Payload{
-		Action:     event,
-		Collection: collection,
-		Record:     record,
-		Auth:       webhook.SanitizeUser(auth),
-		Admin:      nil,
-	})
+	payload, err := marshalPayload(collection, event, record, user)
 	if err != nil {
-		return fmt.Errorf("failed to marshal webhook payload: %w", err)
+		return err
 	}
 
-	hooks, err := findByHookTrigger(ctx, queries, collection, event)
+	hooks, err := findByHookTrigger(ctx, queries, collection, event, true, payload)
 	if err != nil {
 		return fmt.Errorf("failed to find hook by trigger: %w", err)
 	}
@@ -81,19 +113,77 @@ func runHook(ctx context.Context, queries *sqlc.Queries, collection, event strin
 		return fmt.Errorf("failed to load settings: %w", err)
 	}
 
+	for _, hook := range hooks {
+		_, output, err := runner.RunReaction(ctx, settings.Meta.AppURL, hook.ID, hook.Trigger, hook.Action, hook.Actiondata, payload)
+		if err := checkOutput(ctx, hook, output, err); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// checkOutput returns a hook.RejectedError if the before hook reaction
+// returned a rejection. If the action failed, e.g. because its environment
+// could not be installed, the details are only logged and stored in the run,
+// as they are not meant for the client.
+func checkOutput(ctx context.Context, reaction *sqlc.ListReactionsByTriggerRow, output []byte, err error) error {
+	var r rejection
+	if json.Unmarshal(output, &r) == nil && r.Error != "" {
+		return hook.Reject(r.Status, r.Error)
+	}
+
+	if err != nil {
+		slog.ErrorContext(ctx, "before hook reaction failed", "error", err, "reaction_id", reaction.ID)
+
+		return fmt.Errorf("reaction %s failed", reaction.Name)
+	}
+
+	return nil
+}
+
+func enqueueHook(ctx context.Context, queries *sqlc.Queries, queue *queue.Queue, collection, event string, record any, auth *sqlc.User) error {
+	payload, err := marshalPayload(collection, event, record, auth)
+	if err != nil {
+		return err
+	}
+
+	hooks, err := findByHookTrigger(ctx, queries, collection, event, false, payload)
+	if err != nil {
+		return fmt.Errorf("failed to find hook by trigger: %w", err)
+	}
+
 	var errs []error
 
 	for _, hook := range hooks {
-		_, err = action.Run(ctx, settings.Meta.AppURL, queries, hook.Action, hook.Actiondata, payload)
-		if err != nil {
-			errs = append(errs, fmt.Errorf("failed to run hook reaction: %w", err))
+		if _, err := queue.Enqueue(ctx, hook.ID, hook.Trigger, payload); err != nil {
+			errs = append(errs, fmt.Errorf("failed to enqueue hook reaction %s: %w", hook.ID, err))
 		}
 	}
 
 	return errors.Join(errs...)
 }
 
-func findByHookTrigger(ctx context.Context, queries *sqlc.Queries, collection, event string) ([]*sqlc.ListReactionsByTriggerRow, error) {
+func marshalPayload(collection, event string, record any, auth *sqlc.User) (json.RawMessage, error) {
+	p, err := webhook.NewPayload(event, collection, record, auth)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create webhook payload: %w", err)
+	}
+
+	payload, err := json.Marshal(p)
+	if err != nil {
+		return nil, fmt.Errorf("failed to marshal webhook payload: %w", err)
+	}
+
+	return payload, nil
+}
+
+// findByHookTrigger returns the reactions with a hook trigger for the
+// collection and event, whose condition matches the payload. If before is
+// set, only before hooks are returned, otherwise only after hooks. The
+// reaction whose action published the hook is never returned. After hooks
+// whose condition fails are skipped, while before hooks fail the lookup.
+func findByHookTrigger(ctx context.Context, queries *sqlc.Queries, collection, event string, before bool, payload json.RawMessage) ([]*sqlc.ListReactionsByTriggerRow, error) {
 	reactions, err := database.PaginateItems(ctx, func(ctx context.Context, offset, limit int64) ([]sqlc.ListReactionsByTriggerRow, error) {
 		return queries.ListReactionsByTrigger(ctx, sqlc.ListReactionsByTriggerParams{Trigger: "hook", Limit: limit, Offset: offset})
 	})
@@ -105,15 +195,55 @@ func findByHookTrigger(ctx context.Context, queries *sqlc.Queries, collection, e
 		return nil, nil
 	}
 
-	var matchedRecords []*sqlc.ListReactionsByTriggerRow
+	var (
+		matchedRecords []*sqlc.ListReactionsByTriggerRow
+		vars           map[string]any
+	)
+
+	// the record was written by the action of a reaction, which must not
+	// trigger itself again
+	running, _ := action.ReactionFromContext(ctx)
 
 	for _, reaction := range reactions {
+		if reaction.ID == running {
+			continue
+		}
+
 		var hook Hook
 		if err := json.Unmarshal(reaction.Triggerdata, &hook); err != nil {
 			return nil, err
 		}
 
-		if slices.Contains(hook.Collections, collection) && slices.Contains(hook.Events, event) {
+		if hook.Before != before || !slices.Contains(hook.Collections, collection) || !slices.Contains(hook.Events, event) {
+			continue
+		}
+
+		if vars == nil {
+			if vars, err = condition.Vars(collection, event, payload); err != nil {
+				return nil, err
+			}
+		}
+
+		if event == database.UpdateAction && !hook.changed(vars) {
+			continue
+		}
+
+		matched, err := hook.match(vars)
+		if err != nil {
+			// a before hook usually enforces a policy, so it must not let
+			// the write through if its condition cannot be checked
+			if before {
+				slog.ErrorContext(ctx, "failed to check before hook condition", "error", err, "reaction_id", reaction.ID)
+
+				return nil, fmt.Errorf("condition of reaction %s failed", reaction.Name)
+			}
+
+			slog.WarnContext(ctx, "failed to check hook condition", "error", err, "reaction_id", reaction.ID)
+
+			continue
+		}
+
+		if matched {
 			matchedRecords = append(matchedRecords, &reaction)
 		}
 	}
@@ -0,0 +1,152 @@
+package hook
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/SecurityBrewery/catalyst/app/auth/usercontext"
+	"github.com/SecurityBrewery/catalyst/app/data"
+	"github.com/SecurityBrewery/catalyst/app/database/sqlc"
+	"github.com/SecurityBrewery/catalyst/app/hook"
+	"github.com/SecurityBrewery/catalyst/app/openapi"
+	"github.com/SecurityBrewery/catalyst/app/pointer"
+	"github.com/SecurityBrewery/catalyst/app/reaction/action"
+	"github.com/SecurityBrewery/catalyst/app/reaction/action/catalyst"
+	"github.com/SecurityBrewery/catalyst/app/reaction/queue"
+)
+
+const resolutionPolicy = `import json, sys
+
+record = json.loads(sys.argv[1])["record"]
+if not record.get("resolution"):
+    print(json.dumps({"error": "incidents cannot be closed without a resolution", "status": 422}))
+`
+
+func TestBindHooks_Before(t *testing.T) {
+	t.Parallel()
+
+	queries := data.NewTestDB(t, t.TempDir())
+
+	actionData, err := json.Marshal(map[string]any{"script": resolutionPolicy})
+	require.NoError(t, err)
+
+	_, err = queries.CreateReaction(t.Context(), sqlc.CreateReactionParams{
+		Name:        "Resolution Policy",
+		Action:      "python",
+		Actiondata:  actionData,
+		Trigger:     "hook",
+		Triggerdata: []byte(`{"collections":["tickets"],"events":["update"],"condition":"has(record.open) && record.open == false","before":true}`),
+	})
+	require.NoError(t, err)
+
+	hooks := hook.NewHooks()
+	BindHooks(hooks, queries, nil, action.NewRunner(queries, nil, nil, nil))
+
+	user, err := queries.GetUser(t.Context(), "u_bob_analyst")
+	require.NoError(t, err)
+
+	ctx := usercontext.UserContext(t.Context(), &user)
+
+	tests := []struct {
+		name       string
+		record     map[string]any
+		wantStatus int
+	}{
+		{name: "close without resolution", record: map[string]any{"open": false}, wantStatus: http.StatusUnprocessableEntity},
+		{name: "close with resolution", record: map[string]any{"open": false, "resolution": "false positive"}},
+		{name: "condition not matched", record: map[string]any{"name": "renamed"}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			err := hooks.OnRecordBeforeUpdateRequest.PublishWithError(ctx, "tickets", tt.record)
+			if tt.wantStatus == 0 {
+				require.NoError(t, err)
+
+				return
+			}
+
+			var rejected *hook.RejectedError
+			require.ErrorAs(t, err, &rejected)
+			assert.Equal(t, tt.wantStatus, rejected.Status)
+			assert.Equal(t, "incidents cannot be closed without a resolution", rejected.Message)
+		})
+	}
+
+	// after hooks are not triggered by before events
+	require.NoError(t, hooks.OnRecordBeforeCreateRequest.PublishWithError(ctx, "tickets", map[string]any{}))
+}
+
+func TestBindHooks_SelfTrigger(t *testing.T) {
+	t.Parallel()
+
+	queries := data.NewTestDB(t, t.TempDir())
+
+	reaction, err := queries.CreateReaction(t.Context(), sqlc.CreateReactionParams{
+		Name:        "Touch",
+		Action:      "update_ticket",
+		Actiondata:  []byte(`{"ticket":"{{.record.id}}","description":"touched","permissions":["ticket:write"]}`),
+		Trigger:     "hook",
+		Triggerdata: []byte(`{"collections":["tickets"],"events":["update"]}`),
+	})
+	require.NoError(t, err)
+
+	hooks := hook.NewHooks()
+	runner := action.NewRunner(queries, nil, nil, nil)
+	runner.SetRecords(&ticketRecords{hooks: hooks})
+	BindHooks(hooks, queries, queue.New(queries, runner), runner)
+
+	_, _, err = runner.RunReaction(t.Context(), "", reaction.ID, "hook", reaction.Action, reaction.Actiondata, json.RawMessage(`{"record":{"id":"test-ticket"}}`))
+	require.NoError(t, err)
+
+	// the update of the reaction does not enqueue the reaction again
+	var jobs int
+	require.NoError(t, queries.WriteDB.QueryRowContext(t.Context(), "SELECT COUNT(*) FROM reaction_jobs WHERE reaction = ?", reaction.ID).Scan(&jobs))
+	assert.Equal(t, 0, jobs)
+}
+
+// ticketRecords updates tickets like the service, i.e. it publishes the
+// after update hook with the context of the action.
+type ticketRecords struct {
+	catalyst.Records
+
+	hooks *hook.Hooks
+}
+
+func (r *ticketRecords) UpdateTicket(ctx context.Context, request openapi.UpdateTicketRequestObject) (openapi.UpdateTicketResponseObject, error) {
+	old := openapi.Ticket{Id: request.Id}
+	updated := openapi.Ticket{Id: request.Id, Description: pointer.Dereference(request.Body.Description)}
+
+	r.hooks.OnRecordAfterUpdateRequest.Publish(ctx, "tickets", &hook.Update{Old: old, New: updated})
+
+	return openapi.UpdateTicket200JSONResponse(updated), nil
+}
+
+func Test_checkOutput(t *testing.T) {
+	t.Parallel()
+
+	reaction := &sqlc.ListReactionsByTriggerRow{Name: "Policy"}
+
+	require.NoError(t, checkOutput(t.Context(), reaction, []byte(`{"ok":true}`), nil))
+	require.NoError(t, checkOutput(t.Context(), reaction, []byte(`not json`), nil))
+
+	// failures of the action are internal errors without details
+	err := checkOutput(t.Context(), reaction, nil, errors.New("pip install failed: connection refused"))
+
+	var rejected *hook.RejectedError
+	require.NotErrorAs(t, err, &rejected)
+	require.EqualError(t, err, "reaction Policy failed")
+
+	err = checkOutput(t.Context(), reaction, []byte(`{"error":"denied","status":500}`), nil)
+	require.ErrorAs(t, err, &rejected)
+	assert.Equal(t, http.StatusBadRequest, rejected.Status, "only client errors can be returned")
+	assert.Equal(t, "denied", rejected.Message)
+}
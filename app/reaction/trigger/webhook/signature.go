@@ -0,0 +1,238 @@
+package webhook
+
+import (
+	"crypto/hmac"
+	"crypto/sha1" //nolint:gosec // used by some webhook sources, e.g. GitHub's X-Hub-Signature
+	"crypto/sha256"
+	"crypto/sha512"
+	"encoding/base64"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"hash"
+	"math"
+	"net/http"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/SecurityBrewery/catalyst/app/secret"
+)
+
+const (
+	SchemeHMAC   = "hmac"
+	SchemeStripe = "stripe"
+
+	stripeHeader = "Stripe-Signature"
+)
+
+// Signature configures the verification of a request body signed with
+// HMAC. The key is not stored in the trigger data, SecretName is the name of
+// an encrypted secret that contains it.
+//
+// With the hmac scheme (default), Header contains the signature of the body,
+// optionally after a Prefix like "sha256=". If TimestampHeader is set, the
+// signed message is "<timestamp>.<body>".
+//
+// With the stripe scheme, Header (default Stripe-Signature) contains
+// "t=<timestamp>,v1=<signature>" and the signed message is
+// "<timestamp>.<body>".
+//
+// If Tolerance is set, requests with a timestamp that differs from the
+// current time by more than Tolerance seconds are rejected to prevent
+// replay attacks. With the hmac scheme, a tolerance requires a
+// TimestampHeader.
+type Signature struct {
+	Scheme          string `json:"scheme,omitempty"`
+	Header          string `json:"header,omitempty"`
+	Algorithm       string `json:"algorithm,omitempty"`
+	Encoding        string `json:"encoding,omitempty"`
+	Prefix          string `json:"prefix,omitempty"`
+	SecretName      string `json:"secret_name"`
+	TimestampHeader string `json:"timestamp_header,omitempty"`
+	Tolerance       int    `json:"tolerance,omitempty"`
+}
+
+// Validate checks the signature configuration.
+func (s *Signature) Validate() error {
+	if s.SecretName == "" {
+		return errors.New("signature secret_name is required")
+	}
+
+	if err := secret.ValidateName(s.SecretName); err != nil {
+		return err
+	}
+
+	switch s.Scheme {
+	case "", SchemeHMAC:
+		if s.Header == "" {
+			return errors.New("signature header is required")
+		}
+
+		// without a signed timestamp, the tolerance would not be checked
+		if s.Tolerance > 0 && s.TimestampHeader == "" {
+			return errors.New("signature timestamp_header is required for a tolerance")
+		}
+	case SchemeStripe:
+	default:
+		return fmt.Errorf("unknown signature scheme %q", s.Scheme)
+	}
+
+	if _, err := s.hash(); err != nil {
+		return err
+	}
+
+	switch s.Encoding {
+	case "", "hex", "base64":
+	default:
+		return fmt.Errorf("unknown signature encoding %q", s.Encoding)
+	}
+
+	if s.Tolerance < 0 {
+		return errors.New("signature tolerance must not be negative")
+	}
+
+	return nil
+}
+
+// verify checks the signature of the raw request body with the key.
+func (s *Signature) verify(header http.Header, body, key []byte, now time.Time) error {
+	timestamp, signatures, err := s.parse(header)
+	if err != nil {
+		return err
+	}
+
+	if timestamp != "" && s.Tolerance > 0 {
+		if err := checkTimestamp(timestamp, now, s.Tolerance); err != nil {
+			return err
+		}
+	}
+
+	message := body
+	if timestamp != "" {
+		message = append([]byte(timestamp+"."), body...)
+	}
+
+	expected, err := s.sign(key, message)
+	if err != nil {
+		return err
+	}
+
+	for _, signature := range signatures {
+		decoded, err := s.decode(signature)
+		if err != nil {
+			continue
+		}
+
+		if hmac.Equal(decoded, expected) {
+			return nil
+		}
+	}
+
+	return errors.New("invalid signature")
+}
+
+// parse returns the timestamp and the signatures of the request.
+func (s *Signature) parse(header http.Header) (string, []string, error) {
+	if s.Scheme == SchemeStripe {
+		name := s.Header
+		if name == "" {
+			name = stripeHeader
+		}
+
+		value := header.Get(name)
+		if value == "" {
+			return "", nil, errors.New("missing signature")
+		}
+
+		var (
+			timestamp  string
+			signatures []string
+		)
+
+		for part := range strings.SplitSeq(value, ",") {
+			key, val, _ := strings.Cut(strings.TrimSpace(part), "=")
+
+			switch key {
+			case "t":
+				timestamp = val
+			case "v1":
+				signatures = append(signatures, val)
+			}
+		}
+
+		if timestamp == "" || len(signatures) == 0 {
+			return "", nil, errors.New("malformed signature")
+		}
+
+		return timestamp, signatures, nil
+	}
+
+	value := header.Get(s.Header)
+	if value == "" {
+		return "", nil, errors.New("missing signature")
+	}
+
+	if !strings.HasPrefix(value, s.Prefix) {
+		return "", nil, errors.New("malformed signature")
+	}
+
+	var timestamp string
+
+	if s.TimestampHeader != "" {
+		timestamp = header.Get(s.TimestampHeader)
+		if timestamp == "" {
+			return "", nil, errors.New("missing signature timestamp")
+		}
+	}
+
+	return timestamp, []string{strings.TrimPrefix(value, s.Prefix)}, nil
+}
+
+func (s *Signature) sign(key, message []byte) ([]byte, error) {
+	h, err := s.hash()
+	if err != nil {
+		return nil, err
+	}
+
+	mac := hmac.New(h, key)
+	mac.Write(message)
+
+	return mac.Sum(nil), nil
+}
+
+func (s *Signature) hash() (func() hash.Hash, error) {
+	switch s.Algorithm {
+	case "", "sha256":
+		return sha256.New, nil
+	case "sha1":
+		return sha1.New, nil
+	case "sha512":
+		return sha512.New, nil
+	default:
+		return nil, fmt.Errorf("unknown signature algorithm %q", s.Algorithm)
+	}
+}
+
+func (s *Signature) decode(signature string) ([]byte, error) {
+	if s.Encoding == "base64" {
+		return base64.StdEncoding.DecodeString(signature)
+	}
+
+	return hex.DecodeString(strings.ToLower(signature))
+}
+
+// checkTimestamp checks that a unix timestamp is within tolerance seconds
+// of now.
+func checkTimestamp(timestamp string, now time.Time, tolerance int) error {
+	seconds, err := strconv.ParseInt(timestamp, 10, 64)
+	if err != nil {
+		return errors.New("malformed signature timestamp")
+	}
+
+	if math.Abs(float64(now.Unix()-seconds)) > float64(tolerance) {
+		return errors.New("signature timestamp outside of tolerance")
+	}
+
+	return nil
+}
@@ -0,0 +1,178 @@
+package webhook
+
+import (
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/hex"
+	"net/http"
+	"strconv"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func sign(secret, message string) []byte {
+	mac := hmac.New(sha256.New, []byte(secret))
+	mac.Write([]byte(message))
+
+	return mac.Sum(nil)
+}
+
+func TestSignature_verify(t *testing.T) {
+	t.Parallel()
+
+	now := time.Unix(1700000000, 0)
+	ts := strconv.FormatInt(now.Unix(), 10)
+	old := strconv.FormatInt(now.Add(-10*time.Minute).Unix(), 10)
+	body := `{"action":"opened"}`
+
+	type args struct {
+		signature Signature
+		header    http.Header
+	}
+
+	tests := []struct {
+		name    string
+		args    args
+		wantErr string
+	}{
+		{
+			name: "github",
+			args: args{
+				signature: Signature{Header: "X-Hub-Signature-256", Prefix: "sha256=", SecretName: "SIGNING_KEY"},
+				header:    http.Header{"X-Hub-Signature-256": {"sha256=" + hex.EncodeToString(sign("secret", body))}},
+			},
+		},
+		{
+			name: "base64",
+			args: args{
+				signature: Signature{Header: "X-Signature", Encoding: "base64", SecretName: "SIGNING_KEY"},
+				header:    http.Header{"X-Signature": {base64.StdEncoding.EncodeToString(sign("secret", body))}},
+			},
+		},
+		{
+			name: "wrong secret",
+			args: args{
+				signature: Signature{Header: "X-Hub-Signature-256", Prefix: "sha256=", SecretName: "SIGNING_KEY"},
+				header:    http.Header{"X-Hub-Signature-256": {"sha256=" + hex.EncodeToString(sign("other", body))}},
+			},
+			wantErr: "invalid signature",
+		},
+		{
+			name: "missing header",
+			args: args{
+				signature: Signature{Header: "X-Hub-Signature-256", SecretName: "SIGNING_KEY"},
+				header:    http.Header{},
+			},
+			wantErr: "missing signature",
+		},
+		{
+			name: "missing prefix",
+			args: args{
+				signature: Signature{Header: "X-Hub-Signature-256", Prefix: "sha256=", SecretName: "SIGNING_KEY"},
+				header:    http.Header{"X-Hub-Signature-256": {hex.EncodeToString(sign("secret", body))}},
+			},
+			wantErr: "malformed signature",
+		},
+		{
+			name: "timestamp header",
+			args: args{
+				signature: Signature{Header: "X-Signature", TimestampHeader: "X-Timestamp", SecretName: "SIGNING_KEY", Tolerance: 300},
+				header: http.Header{
+					"X-Signature": {hex.EncodeToString(sign("secret", ts+"."+body))},
+					"X-Timestamp": {ts},
+				},
+			},
+		},
+		{
+			name: "missing timestamp",
+			args: args{
+				signature: Signature{Header: "X-Signature", TimestampHeader: "X-Timestamp", SecretName: "SIGNING_KEY"},
+				header:    http.Header{"X-Signature": {hex.EncodeToString(sign("secret", body))}},
+			},
+			wantErr: "missing signature timestamp",
+		},
+		{
+			name: "stripe",
+			args: args{
+				signature: Signature{Scheme: SchemeStripe, SecretName: "SIGNING_KEY", Tolerance: 300},
+				header:    http.Header{"Stripe-Signature": {"t=" + ts + ",v1=deadbeef,v1=" + hex.EncodeToString(sign("secret", ts+"."+body))}},
+			},
+		},
+		{
+			name: "stripe replay",
+			args: args{
+				signature: Signature{Scheme: SchemeStripe, SecretName: "SIGNING_KEY", Tolerance: 300},
+				header:    http.Header{"Stripe-Signature": {"t=" + old + ",v1=" + hex.EncodeToString(sign("secret", old+"."+body))}},
+			},
+			wantErr: "signature timestamp outside of tolerance",
+		},
+		{
+			name: "stripe without tolerance",
+			args: args{
+				signature: Signature{Scheme: SchemeStripe, SecretName: "SIGNING_KEY"},
+				header:    http.Header{"Stripe-Signature": {"t=" + old + ",v1=" + hex.EncodeToString(sign("secret", old+"."+body))}},
+			},
+		},
+		{
+			name: "stripe malformed",
+			args: args{
+				signature: Signature{Scheme: SchemeStripe, SecretName: "SIGNING_KEY"},
+				header:    http.Header{"Stripe-Signature": {"v1=" + hex.EncodeToString(sign("secret", body))}},
+			},
+			wantErr: "malformed signature",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			err := tt.args.signature.verify(tt.args.header, []byte(body), []byte("secret"), now)
+			if tt.wantErr != "" {
+				assert.EqualError(t, err, tt.wantErr)
+
+				return
+			}
+
+			assert.NoError(t, err)
+		})
+	}
+}
+
+func TestSignature_Validate(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name      string
+		signature Signature
+		wantErr   bool
+	}{
+		{name: "valid", signature: Signature{Header: "X-Signature", SecretName: "SIGNING_KEY"}},
+		{name: "stripe", signature: Signature{Scheme: SchemeStripe, SecretName: "SIGNING_KEY"}},
+		{name: "missing secret", signature: Signature{Header: "X-Signature"}, wantErr: true},
+		{name: "invalid secret name", signature: Signature{Header: "X-Signature", SecretName: "signing key"}, wantErr: true},
+		{name: "missing header", signature: Signature{SecretName: "SIGNING_KEY"}, wantErr: true},
+		{name: "unknown scheme", signature: Signature{Scheme: "foo", SecretName: "SIGNING_KEY"}, wantErr: true},
+		{name: "unknown algorithm", signature: Signature{Header: "X-Signature", SecretName: "SIGNING_KEY", Algorithm: "md5"}, wantErr: true},
+		{name: "unknown encoding", signature: Signature{Header: "X-Signature", SecretName: "SIGNING_KEY", Encoding: "raw"}, wantErr: true},
+		{name: "tolerance with timestamp", signature: Signature{Header: "X-Signature", SecretName: "SIGNING_KEY", TimestampHeader: "X-Timestamp", Tolerance: 300}},
+		{name: "tolerance without timestamp", signature: Signature{Header: "X-Signature", SecretName: "SIGNING_KEY", Tolerance: 300}, wantErr: true},
+		{name: "stripe tolerance", signature: Signature{Scheme: SchemeStripe, SecretName: "SIGNING_KEY", Tolerance: 300}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			err := tt.signature.Validate()
+			if tt.wantErr {
+				assert.Error(t, err)
+			} else {
+				assert.NoError(t, err)
+			}
+		})
+	}
+}
@@ -1,13 +1,18 @@
 package webhook
 
 import (
+	"bytes"
 	"context"
 	"encoding/base64"
 	"encoding/json"
+	"errors"
 	"fmt"
+	"io"
 	"log/slog"
 	"net/http"
+	"net/url"
 	"strings"
+	"time"
 
 	"github.com/go-chi/chi/v5"
 
@@ -15,29 +20,67 @@ import (
 	"github.com/SecurityBrewery/catalyst/app/database/sqlc"
 	"github.com/SecurityBrewery/catalyst/app/reaction/action"
 	"github.com/SecurityBrewery/catalyst/app/reaction/action/webhook"
+	"github.com/SecurityBrewery/catalyst/app/reaction/queue"
+	"github.com/SecurityBrewery/catalyst/app/secret"
 	"github.com/SecurityBrewery/catalyst/app/settings"
 )
 
 type Webhook struct {
-	Token string `json:"token"`
-	Path  string `json:"path"`
+	Token     string     `json:"token"`
+	Path      string     `json:"path"`
+	Signature *Signature `json:"signature,omitempty"`
+	// Async runs the action through the reaction queue. The request is
+	// answered immediately with 202 Accepted and the location of the run.
+	Async bool `json:"async,omitempty"`
 }
 
-const prefix = "/reaction/"
+// AsyncResponse is the response to a request to an asynchronous webhook.
+type AsyncResponse struct {
+	Run    string `json:"run"`
+	Status string `json:"status"`
+}
+
+// Validate checks the webhook configuration.
+func (w *Webhook) Validate() error {
+	if w.Signature != nil {
+		return w.Signature.Validate()
+	}
 
-func BindHooks(router chi.Router, queries *sqlc.Queries) {
-	router.HandleFunc(prefix+"*", handle(queries))
+	return nil
 }
 
-func handle(queries *sqlc.Queries) http.HandlerFunc {
+const (
+	prefix = "/reaction/"
+
+	maxBodySize = 10 << 20 // 10 MiB
+)
+
+func BindHooks(router chi.Router, queries *sqlc.Queries, secrets *secret.Cipher, queue *queue.Queue, runner *action.Runner) {
+	router.HandleFunc(prefix+"*", handle(queries, secrets, queue, runner))
+}
+
+func handle(queries *sqlc.Queries, secrets *secret.Cipher, queue *queue.Queue, runner *action.Runner) http.HandlerFunc {
 	return func(w http.ResponseWriter, r *http.Request) {
-		reaction, payload, status, err := parseRequest(queries, r)
+		reaction, trigger, payload, status, err := parseRequest(queries, secrets, r)
 		if err != nil {
 			http.Error(w, err.Error(), status)
 
 			return
 		}
 
+		if trigger.Async {
+			run, err := queue.EnqueueRun(r.Context(), reaction.ID, reaction.Trigger, payload)
+			if err != nil {
+				http.Error(w, err.Error(), http.StatusInternalServerError)
+
+				return
+			}
+
+			writeAccepted(w, run)
+
+			return
+		}
+
 		settings, err := settings.Load(r.Context(), queries)
 		if err != nil {
 			http.Error(w, "failed to load settings: "+err.Error(), http.StatusInternalServerError)
@@ -45,7 +88,7 @@ func handle(queries *sqlc.Queries) http.HandlerFunc {
 			return
 		}
 
-		output, err := action.Run(r.Context(), settings.Meta.AppURL, queries, reaction.Action, reaction.Actiondata, payload)
+		_, output, err := runner.RunReaction(r.Context(), settings.Meta.AppURL, reaction.ID, reaction.Trigger, reaction.Action, reaction.Actiondata, payload)
 		if err != nil {
 			http.Error(w, err.Error(), http.StatusInternalServerError)
 
@@ -58,35 +101,53 @@ func handle(queries *sqlc.Queries) http.HandlerFunc {
 	}
 }
 
-func parseRequest(queries *sqlc.Queries, r *http.Request) (*sqlc.ListReactionsByTriggerRow, []byte, int, error) {
+func parseRequest(queries *sqlc.Queries, secrets *secret.Cipher, r *http.Request) (*sqlc.ListReactionsByTriggerRow, *Webhook, []byte, int, error) {
 	if !strings.HasPrefix(r.URL.Path, prefix) {
-		return nil, nil, http.StatusNotFound, fmt.Errorf("wrong prefix")
+		return nil, nil, nil, http.StatusNotFound, fmt.Errorf("wrong prefix")
 	}
 
 	reactionName := strings.TrimPrefix(r.URL.Path, prefix)
 
 	reaction, trigger, found, err := findByWebhookTrigger(r.Context(), queries, reactionName)
 	if err != nil {
-		return nil, nil, http.StatusNotFound, err
+		return nil, nil, nil, http.StatusNotFound, err
 	}
 
 	if !found {
-		return nil, nil, http.StatusNotFound, fmt.Errorf("reaction not found")
+		return nil, nil, nil, http.StatusNotFound, fmt.Errorf("reaction not found")
 	}
 
 	if trigger.Token != "" {
 		auth := r.Header.Get("Authorization")
 
 		if !strings.HasPrefix(auth, "Bearer ") {
-			return nil, nil, http.StatusUnauthorized, fmt.Errorf("missing token")
+			return nil, nil, nil, http.StatusUnauthorized, fmt.Errorf("missing token")
 		}
 
 		if trigger.Token != strings.TrimPrefix(auth, "Bearer ") {
-			return nil, nil, http.StatusUnauthorized, fmt.Errorf("invalid token")
+			return nil, nil, nil, http.StatusUnauthorized, fmt.Errorf("invalid token")
 		}
 	}
 
-	body, isBase64Encoded := webhook.EncodeBody(r.Body)
+	raw, err := io.ReadAll(http.MaxBytesReader(nil, r.Body, maxBodySize))
+	if err != nil {
+		return nil, nil, nil, http.StatusBadRequest, fmt.Errorf("failed to read body: %w", err)
+	}
+
+	if trigger.Signature != nil {
+		key, err := secrets.Value(r.Context(), queries, trigger.Signature.SecretName)
+		if err != nil {
+			slog.ErrorContext(r.Context(), "failed to get signature secret", "error", err, "reaction_id", reaction.ID)
+
+			return nil, nil, nil, http.StatusInternalServerError, errors.New("failed to get signature secret")
+		}
+
+		if err := trigger.Signature.verify(r.Header, raw, key, time.Now()); err != nil {
+			return nil, nil, nil, http.StatusUnauthorized, err
+		}
+	}
+
+	body, isBase64Encoded := webhook.EncodeBody(bytes.NewReader(raw))
 
 	payload, err := json.Marshal(&Request{
 		Method:          r.Method,
@@ -97,10 +158,10 @@ func parseRequest(queries *sqlc.Queries, r *http.Request) (*sqlc.ListReactionsBy
 		IsBase64Encoded: isBase64Encoded,
 	})
 	if err != nil {
-		return nil, nil, http.StatusInternalServerError, err
+		return nil, nil, nil, http.StatusInternalServerError, err
 	}
 
-	return reaction, payload, http.StatusOK, nil
+	return reaction, trigger, payload, http.StatusOK, nil
 }
 
 func findByWebhookTrigger(ctx context.Context, queries *sqlc.Queries, path string) (*sqlc.ListReactionsByTriggerRow, *Webhook, bool, error) {
@@ -129,6 +190,16 @@ func findByWebhookTrigger(ctx context.Context, queries *sqlc.Queries, path strin
 	return nil, nil, false, nil
 }
 
+// writeAccepted answers an asynchronous request with the run, which can be
+// polled at the location of the run in the API.
+func writeAccepted(w http.ResponseWriter, run *sqlc.ReactionRun) {
+	w.Header().Set("Content-Type", "application/json")
+	w.Header().Set("Location", fmt.Sprintf("/api/reactions/%s/runs/%s", url.PathEscape(run.Reaction), url.PathEscape(run.ID)))
+	w.WriteHeader(http.StatusAccepted)
+
+	_ = json.NewEncoder(w).Encode(&AsyncResponse{Run: run.ID, Status: run.Status})
+}
+
 func writeOutput(w http.ResponseWriter, output []byte) error {
 	var catalystResponse webhook.Response
 	if err := json.Unmarshal(output, &catalystResponse); err == nil && catalystResponse.StatusCode != 0 {
@@ -0,0 +1,149 @@
+package webhook
+
+import (
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/hex"
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/SecurityBrewery/catalyst/app/data"
+	"github.com/SecurityBrewery/catalyst/app/database/sqlc"
+	"github.com/SecurityBrewery/catalyst/app/reaction/action"
+	"github.com/SecurityBrewery/catalyst/app/reaction/queue"
+	"github.com/SecurityBrewery/catalyst/app/secret"
+)
+
+func Test_parseRequest_signature(t *testing.T) {
+	t.Parallel()
+
+	queries := data.NewTestDB(t, t.TempDir())
+
+	secrets, err := secret.New(base64.StdEncoding.EncodeToString([]byte(strings.Repeat("k", 32))))
+	require.NoError(t, err)
+
+	value, err := secrets.Encrypt([]byte("secret"))
+	require.NoError(t, err)
+
+	_, err = queries.CreateSecret(t.Context(), sqlc.CreateSecretParams{Name: "SIGNING_KEY", Value: value})
+	require.NoError(t, err)
+
+	triggerData, err := json.Marshal(&Webhook{
+		Path: "signed",
+		Signature: &Signature{
+			Header:     "X-Hub-Signature-256",
+			Prefix:     "sha256=",
+			SecretName: "SIGNING_KEY",
+		},
+	})
+	require.NoError(t, err)
+
+	_, err = queries.CreateReaction(t.Context(), sqlc.CreateReactionParams{
+		Name:        "Signed",
+		Action:      "python",
+		Actiondata:  []byte(`{"requirements":"","script":"print('ok')"}`),
+		Trigger:     "webhook",
+		Triggerdata: triggerData,
+	})
+	require.NoError(t, err)
+
+	body := `{"action":"opened"}`
+
+	mac := hmac.New(sha256.New, []byte("secret"))
+	mac.Write([]byte(body))
+
+	tests := []struct {
+		name       string
+		signature  string
+		wantStatus int
+	}{
+		{name: "valid", signature: "sha256=" + hex.EncodeToString(mac.Sum(nil)), wantStatus: http.StatusOK},
+		{name: "invalid", signature: "sha256=" + strings.Repeat("00", sha256.Size), wantStatus: http.StatusUnauthorized},
+		{name: "missing", wantStatus: http.StatusUnauthorized},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			r := httptest.NewRequest(http.MethodPost, "/reaction/signed", strings.NewReader(body))
+			if tt.signature != "" {
+				r.Header.Set("X-Hub-Signature-256", tt.signature)
+			}
+
+			_, _, payload, status, err := parseRequest(queries, secrets, r)
+			assert.Equal(t, tt.wantStatus, status)
+
+			if tt.wantStatus != http.StatusOK {
+				assert.Error(t, err)
+
+				return
+			}
+
+			require.NoError(t, err)
+
+			var request Request
+			require.NoError(t, json.Unmarshal(payload, &request))
+			assert.Equal(t, body, request.Body)
+		})
+	}
+}
+
+func Test_handle_async(t *testing.T) {
+	t.Parallel()
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		_, _ = io.Copy(w, r.Body)
+	}))
+	t.Cleanup(server.Close)
+
+	queries := data.NewTestDB(t, t.TempDir())
+
+	reaction, err := queries.CreateReaction(t.Context(), sqlc.CreateReactionParams{
+		Name:        "Async",
+		Action:      "webhook",
+		Actiondata:  []byte(`{"url":"` + server.URL + `"}`),
+		Trigger:     "webhook",
+		Triggerdata: []byte(`{"path":"async","async":true}`),
+	})
+	require.NoError(t, err)
+
+	runner := action.NewRunner(queries, nil, nil, nil)
+	q := queue.New(queries, runner)
+
+	rec := httptest.NewRecorder()
+	handle(queries, nil, q, runner)(rec, httptest.NewRequest(http.MethodPost, "/reaction/async", strings.NewReader("Async Ticket")))
+
+	require.Equal(t, http.StatusAccepted, rec.Code)
+
+	var response AsyncResponse
+	require.NoError(t, json.Unmarshal(rec.Body.Bytes(), &response))
+
+	assert.NotEmpty(t, response.Run)
+	assert.Equal(t, action.RunStatusRunning, response.Status)
+	assert.Equal(t, "/api/reactions/"+reaction.ID+"/runs/"+response.Run, rec.Header().Get("Location"))
+
+	// the run is persisted as a job of the reaction queue
+	var jobs int
+	require.NoError(t, queries.WriteDB.QueryRowContext(t.Context(), "SELECT COUNT(*) FROM reaction_jobs WHERE run = ?", response.Run).Scan(&jobs))
+	assert.Equal(t, 1, jobs)
+
+	require.NoError(t, q.Start(t.Context()))
+	t.Cleanup(q.Stop)
+
+	require.EventuallyWithT(t, func(c *assert.CollectT) {
+		run, err := queries.GetReactionRun(t.Context(), sqlc.GetReactionRunParams{ID: response.Run, Reaction: reaction.ID})
+		require.NoError(c, err)
+		assert.Equal(c, action.RunStatusSuccess, run.Status)
+		assert.Contains(c, run.Output, "Async Ticket")
+	}, 5*time.Second, 50*time.Millisecond)
+}
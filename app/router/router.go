@@ -13,10 +13,11 @@ import (
 	"github.com/SecurityBrewery/catalyst/app/database/sqlc"
 	"github.com/SecurityBrewery/catalyst/app/mail"
 	"github.com/SecurityBrewery/catalyst/app/service"
+	"github.com/SecurityBrewery/catalyst/app/stream"
 	"github.com/SecurityBrewery/catalyst/app/upload"
 )
 
-func New(service *service.Service, queries *sqlc.Queries, uploader *upload.Uploader, mailer *mail.Mailer) (*chi.Mux, error) {
+func New(service *service.Service, broker *stream.Broker, queries *sqlc.Queries, uploader *upload.Uploader, mailer *mail.Mailer) (*chi.Mux, error) {
 	r := chi.NewRouter()
 
 	// middleware for the router
@@ -27,7 +28,7 @@ func New(service *service.Service, queries *sqlc.Queries, uploader *upload.Uploa
 	r.Use(middleware.RequestID)
 	r.Use(middleware.RealIP)
 	r.Use(middleware.Logger)
-	r.Use(middleware.Timeout(time.Second * 60))
+	r.Use(timeout(time.Second * 60))
 	r.Use(middleware.Recoverer)
 
 	// base routes
@@ -38,9 +39,10 @@ func New(service *service.Service, queries *sqlc.Queries, uploader *upload.Uploa
 	r.Get("/health", healthHandler(queries))
 
 	// auth routes
-	r.Mount("/auth", auth.Server(queries, mailer))
+	r.Mount("/auth", auth.Server(queries, mailer, service))
 
 	// API routes
+	r.With(auth.Middleware(queries)).Get(stream.Path, broker.ServeHTTP)
 	r.With(auth.Middleware(queries)).Mount("/api", http.StripPrefix("/api", service))
 
 	uploadHandler, err := tusRoutes(queries, uploader)
@@ -53,6 +55,24 @@ func New(service *service.Service, queries *sqlc.Queries, uploader *upload.Uploa
 	return r, nil
 }
 
+// timeout limits the duration of requests, except for the event stream,
+// which stays open until the client disconnects.
+func timeout(d time.Duration) func(next http.Handler) http.Handler {
+	return func(next http.Handler) http.Handler {
+		limited := middleware.Timeout(d)(next)
+
+		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			if r.URL.Path == stream.Path {
+				next.ServeHTTP(w, r)
+
+				return
+			}
+
+			limited.ServeHTTP(w, r)
+		})
+	}
+}
+
 func healthHandler(queries *sqlc.Queries) func(w http.ResponseWriter, r *http.Request) {
 	return func(w http.ResponseWriter, r *http.Request) {
 		if _, err := queries.ListFeatures(r.Context(), sqlc.ListFeaturesParams{Offset: 0, Limit: 100}); err != nil {
@@ -0,0 +1,184 @@
+// Package secret stores values like API keys encrypted at rest, so they can
+// be used by reactions without exposing them in the reaction data.
+package secret
+
+import (
+	"context"
+	"crypto/aes"
+	"crypto/cipher"
+	"crypto/rand"
+	"encoding/base64"
+	"errors"
+	"fmt"
+	"os"
+	"regexp"
+	"slices"
+	"strings"
+
+	"github.com/SecurityBrewery/catalyst/app/database/sqlc"
+)
+
+// KeyEnv is the environment variable that contains the base64 encoded
+// 32 byte key to encrypt secrets.
+const KeyEnv = "CATALYST_SECRET_KEY"
+
+// ErrNoKey is returned if secrets are used without a configured key.
+var ErrNoKey = errors.New("secrets are not configured, set " + KeyEnv)
+
+var nameRegex = regexp.MustCompile(`^[A-Za-z_][A-Za-z0-9_]*$`)
+
+// reservedNames are environment variables that change how the interpreter
+// or the dynamic loader of a reaction behave, or that are set by the
+// sandbox.
+var reservedNames = []string{"PATH", "HOME", "TMPDIR", "SHELL", "IFS", "ENV", "BASH_ENV"}
+
+// reservedPrefixes are prefixes of environment variables that change how
+// the interpreter or the dynamic loader behave, e.g. PYTHONPATH or
+// LD_PRELOAD, and the prefix of the variables set by Catalyst.
+var reservedPrefixes = []string{"CATALYST_", "PYTHON", "LD_", "DYLD_"}
+
+// Cipher encrypts and decrypts secrets with AES-256-GCM. A nil Cipher
+// returns ErrNoKey for all operations.
+type Cipher struct {
+	aead cipher.AEAD
+}
+
+// FromEnv returns the cipher for the key in KeyEnv or nil if it is not set.
+func FromEnv() (*Cipher, error) {
+	key := os.Getenv(KeyEnv)
+	if key == "" {
+		return nil, nil //nolint:nilnil
+	}
+
+	c, err := New(key)
+	if err != nil {
+		return nil, fmt.Errorf("invalid %s: %w", KeyEnv, err)
+	}
+
+	return c, nil
+}
+
+// New returns a cipher for a base64 encoded 32 byte key.
+func New(key string) (*Cipher, error) {
+	k, err := base64.StdEncoding.DecodeString(key)
+	if err != nil {
+		return nil, fmt.Errorf("failed to decode key: %w", err)
+	}
+
+	if len(k) != 32 {
+		return nil, fmt.Errorf("key must be 32 bytes, got %d", len(k))
+	}
+
+	block, err := aes.NewCipher(k)
+	if err != nil {
+		return nil, err
+	}
+
+	aead, err := cipher.NewGCM(block)
+	if err != nil {
+		return nil, err
+	}
+
+	return &Cipher{aead: aead}, nil
+}
+
+// Encrypt encrypts a value. The random nonce is prepended to the result.
+func (c *Cipher) Encrypt(plaintext []byte) ([]byte, error) {
+	if c == nil {
+		return nil, ErrNoKey
+	}
+
+	nonce := make([]byte, c.aead.NonceSize())
+	if _, err := rand.Read(nonce); err != nil {
+		return nil, err
+	}
+
+	return c.aead.Seal(nonce, nonce, plaintext, nil), nil
+}
+
+// Decrypt decrypts a value encrypted with Encrypt.
+func (c *Cipher) Decrypt(ciphertext []byte) ([]byte, error) {
+	if c == nil {
+		return nil, ErrNoKey
+	}
+
+	if len(ciphertext) < c.aead.NonceSize() {
+		return nil, errors.New("ciphertext too short")
+	}
+
+	nonce, ciphertext := ciphertext[:c.aead.NonceSize()], ciphertext[c.aead.NonceSize():]
+
+	return c.aead.Open(nil, nonce, ciphertext, nil)
+}
+
+// Env returns the secrets with the given names as environment variables.
+func (c *Cipher) Env(ctx context.Context, queries *sqlc.Queries, names []string) ([]string, error) {
+	if len(names) == 0 {
+		return nil, nil
+	}
+
+	if c == nil {
+		return nil, ErrNoKey
+	}
+
+	env := make([]string, 0, len(names))
+
+	for _, name := range names {
+		// secrets that were created before a name was reserved must not
+		// override it either
+		if err := ValidateName(name); err != nil {
+			return nil, err
+		}
+
+		value, err := c.Value(ctx, queries, name)
+		if err != nil {
+			return nil, err
+		}
+
+		env = append(env, name+"="+string(value))
+	}
+
+	return env, nil
+}
+
+// Value returns the decrypted value of the secret with the given name.
+func (c *Cipher) Value(ctx context.Context, queries *sqlc.Queries, name string) ([]byte, error) {
+	if c == nil {
+		return nil, ErrNoKey
+	}
+
+	s, err := queries.GetSecretByName(ctx, name)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get secret %s: %w", name, err)
+	}
+
+	value, err := c.Decrypt(s.Value)
+	if err != nil {
+		return nil, fmt.Errorf("failed to decrypt secret %s: %w", name, err)
+	}
+
+	return value, nil
+}
+
+// ValidateName checks that a secret name can be used as an environment
+// variable and does not override the variables set by Catalyst or the
+// variables that control the interpreter of a reaction.
+func ValidateName(name string) error {
+	if !nameRegex.MatchString(name) {
+		return fmt.Errorf("invalid secret name %q, must only contain letters, digits and underscores", name)
+	}
+
+	upper := strings.ToUpper(name)
+
+	if slices.Contains(reservedNames, upper) {
+		return fmt.Errorf("invalid secret name %q, the name is reserved", name)
+	}
+
+	for _, prefix := range reservedPrefixes {
+		if strings.HasPrefix(upper, prefix) {
+			return fmt.Errorf("invalid secret name %q, the %s prefix is reserved", name, prefix)
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,130 @@
+package secret_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/SecurityBrewery/catalyst/app/data"
+	"github.com/SecurityBrewery/catalyst/app/database/sqlc"
+	"github.com/SecurityBrewery/catalyst/app/secret"
+)
+
+const testKey = "MDEyMzQ1Njc4OWFiY2RlZjAxMjM0NTY3ODlhYmNkZWY="
+
+func TestCipher_Encrypt(t *testing.T) {
+	t.Parallel()
+
+	c, err := secret.New(testKey)
+	require.NoError(t, err)
+
+	ciphertext, err := c.Encrypt([]byte("api-key"))
+	require.NoError(t, err)
+	assert.NotContains(t, string(ciphertext), "api-key")
+
+	other, err := c.Encrypt([]byte("api-key"))
+	require.NoError(t, err)
+	assert.NotEqual(t, ciphertext, other, "nonce must be random")
+
+	plaintext, err := c.Decrypt(ciphertext)
+	require.NoError(t, err)
+	assert.Equal(t, "api-key", string(plaintext))
+
+	ciphertext[len(ciphertext)-1] ^= 1
+
+	_, err = c.Decrypt(ciphertext)
+	assert.Error(t, err)
+}
+
+func TestCipher_Nil(t *testing.T) {
+	t.Parallel()
+
+	var c *secret.Cipher
+
+	_, err := c.Encrypt([]byte("api-key"))
+	require.ErrorIs(t, err, secret.ErrNoKey)
+
+	_, err = c.Decrypt([]byte("api-key"))
+	require.ErrorIs(t, err, secret.ErrNoKey)
+
+	env, err := c.Env(t.Context(), nil, nil)
+	require.NoError(t, err)
+	assert.Empty(t, env)
+}
+
+func TestNew(t *testing.T) {
+	t.Parallel()
+
+	_, err := secret.New("not base64")
+	require.Error(t, err)
+
+	_, err = secret.New("c2hvcnQ=")
+	assert.EqualError(t, err, "key must be 32 bytes, got 5")
+}
+
+func TestCipher_Env(t *testing.T) {
+	t.Parallel()
+
+	queries := data.NewTestDB(t, t.TempDir())
+
+	c, err := secret.New(testKey)
+	require.NoError(t, err)
+
+	value, err := c.Encrypt([]byte("vt-key"))
+	require.NoError(t, err)
+
+	_, err = queries.CreateSecret(t.Context(), sqlc.CreateSecretParams{Name: "VT_API_KEY", Value: value})
+	require.NoError(t, err)
+
+	env, err := c.Env(t.Context(), queries, []string{"VT_API_KEY"})
+	require.NoError(t, err)
+	assert.Equal(t, []string{"VT_API_KEY=vt-key"}, env)
+
+	_, err = c.Env(t.Context(), queries, []string{"MISSING"})
+	assert.ErrorContains(t, err, "failed to get secret MISSING")
+
+	// secrets created before their name was reserved are not exported
+	_, err = queries.CreateSecret(t.Context(), sqlc.CreateSecretParams{Name: "LD_PRELOAD", Value: value})
+	require.NoError(t, err)
+
+	_, err = c.Env(t.Context(), queries, []string{"LD_PRELOAD"})
+	assert.ErrorContains(t, err, "the LD_ prefix is reserved")
+}
+
+func TestValidateName(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name    string
+		wantErr bool
+	}{
+		{name: "VT_API_KEY"},
+		{name: "_jira_token2"},
+		{name: "", wantErr: true},
+		{name: "1KEY", wantErr: true},
+		{name: "API-KEY", wantErr: true},
+		{name: "KEY=VALUE", wantErr: true},
+		{name: "CATALYST_TOKEN", wantErr: true},
+		{name: "catalyst_app_url", wantErr: true},
+		{name: "PATH", wantErr: true},
+		{name: "home", wantErr: true},
+		{name: "LD_PRELOAD", wantErr: true},
+		{name: "PYTHONPATH", wantErr: true},
+		{name: "PYTHONHOME", wantErr: true},
+		{name: "PATH_TO_KEY"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			err := secret.ValidateName(tt.name)
+			if tt.wantErr {
+				assert.Error(t, err)
+			} else {
+				assert.NoError(t, err)
+			}
+		})
+	}
+}
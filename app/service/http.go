@@ -1,11 +1,15 @@
 package service
 
 import (
+	"database/sql"
 	"encoding/json"
+	"errors"
+	"io/fs"
 	"log/slog"
 	"net/http"
 
 	"github.com/SecurityBrewery/catalyst/app/auth"
+	"github.com/SecurityBrewery/catalyst/app/hook"
 	"github.com/SecurityBrewery/catalyst/app/openapi"
 )
 
@@ -20,11 +24,29 @@ func (s *Service) ServeHTTP(w http.ResponseWriter, r *http.Request) {
 }
 
 func jsonError(w http.ResponseWriter, r *http.Request, err error) {
-	b, err := json.Marshal(openapi.Error{
+	response := openapi.Error{
 		Status:  http.StatusInternalServerError,
 		Error:   "An internal error occurred",
 		Message: err.Error(),
-	})
+	}
+
+	// invalid requests and requests that are rejected by a hook, e.g. a
+	// reaction that enforces a policy, fail with a client error
+	var (
+		statusErr *statusError
+		rejected  *hook.RejectedError
+	)
+
+	switch {
+	case errors.As(err, &statusErr):
+		response.Status = statusErr.status
+		response.Error = http.StatusText(statusErr.status)
+	case errors.As(err, &rejected):
+		response.Status = rejected.Status
+		response.Error = http.StatusText(rejected.Status)
+	}
+
+	b, err := json.Marshal(response)
 	if err != nil {
 		slog.ErrorContext(r.Context(), "Failed to marshal error response", "error", err)
 		http.Error(w, "Internal Server Error", http.StatusInternalServerError)
@@ -33,6 +55,39 @@ func jsonError(w http.ResponseWriter, r *http.Request, err error) {
 	}
 
 	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(http.StatusInternalServerError)
+	w.WriteHeader(response.Status)
 	_, _ = w.Write(b)
 }
+
+// statusError is an error of the service that fails the request with a
+// client error status instead of an internal error.
+type statusError struct {
+	status  int
+	message string
+}
+
+func (e *statusError) Error() string {
+	return e.message
+}
+
+// badRequest marks an invalid request, so it fails with 400 Bad Request
+// instead of an internal error.
+func badRequest(err error) error {
+	return &statusError{status: http.StatusBadRequest, message: err.Error()}
+}
+
+// forbidden marks a request that the caller is not allowed to make, so it
+// fails with 403 Forbidden instead of an internal error.
+func forbidden(err error) error {
+	return &statusError{status: http.StatusForbidden, message: err.Error()}
+}
+
+// notFound marks a missing record or file, so it fails with 404 Not Found
+// instead of an internal error.
+func notFound(err error) error {
+	if errors.Is(err, sql.ErrNoRows) || errors.Is(err, fs.ErrNotExist) {
+		return &statusError{status: http.StatusNotFound, message: "record not found"}
+	}
+
+	return err
+}
@@ -1,7 +1,9 @@
 package service
 
 import (
+	"database/sql"
 	"errors"
+	"fmt"
 	"io"
 	"net/http"
 	"net/http/httptest"
@@ -27,3 +29,21 @@ func TestJsonError(t *testing.T) {
 		t.Errorf("expected error message in body, got %s", string(body))
 	}
 }
+
+func TestJsonError_NotFound(t *testing.T) {
+	t.Parallel()
+
+	rec := httptest.NewRecorder()
+	r := httptest.NewRequest(http.MethodGet, "/", nil)
+	jsonError(rec, r, fmt.Errorf("failed to get record: %w", notFound(sql.ErrNoRows)))
+
+	resp := rec.Result()
+	if resp.StatusCode != http.StatusNotFound {
+		t.Errorf("expected status 404, got %d", resp.StatusCode)
+	}
+
+	body, _ := io.ReadAll(resp.Body)
+	if !strings.Contains(string(body), "record not found") {
+		t.Errorf("expected error message in body, got %s", string(body))
+	}
+}
@@ -2,6 +2,7 @@ package service
 
 import (
 	"context"
+	"database/sql"
 	"encoding/json"
 	"errors"
 	"fmt"
@@ -10,19 +11,30 @@ import (
 
 	"github.com/SecurityBrewery/catalyst/app/auth"
 	"github.com/SecurityBrewery/catalyst/app/auth/password"
+	"github.com/SecurityBrewery/catalyst/app/auth/usercontext"
+	"github.com/SecurityBrewery/catalyst/app/changes"
+	"github.com/SecurityBrewery/catalyst/app/cloudevents"
 	"github.com/SecurityBrewery/catalyst/app/database"
 	"github.com/SecurityBrewery/catalyst/app/database/sqlc"
 	"github.com/SecurityBrewery/catalyst/app/hook"
 	"github.com/SecurityBrewery/catalyst/app/openapi"
 	"github.com/SecurityBrewery/catalyst/app/pointer"
+	"github.com/SecurityBrewery/catalyst/app/reaction"
+	"github.com/SecurityBrewery/catalyst/app/reaction/action"
+	"github.com/SecurityBrewery/catalyst/app/reaction/action/python"
 	"github.com/SecurityBrewery/catalyst/app/reaction/schedule"
+	"github.com/SecurityBrewery/catalyst/app/secret"
 	"github.com/SecurityBrewery/catalyst/app/settings"
 	"github.com/SecurityBrewery/catalyst/app/upload"
+	"github.com/SecurityBrewery/catalyst/app/webhook"
 )
 
 const (
 	defaultLimit  = 100
 	defaultOffset = 0
+
+	// manualTrigger is recorded as the trigger of runs started by the API.
+	manualTrigger = "manual"
 )
 
 var _ openapi.StrictServerInterface = (*Service)(nil)
@@ -32,14 +44,20 @@ type Service struct {
 	hooks     *hook.Hooks
 	uploader  *upload.Uploader
 	scheduler *schedule.Scheduler
+	venvs     *python.Cache
+	runner    *action.Runner
+	secrets   *secret.Cipher
 }
 
-func New(queries *sqlc.Queries, hooks *hook.Hooks, uploader *upload.Uploader, scheduler *schedule.Scheduler) *Service {
+func New(queries *sqlc.Queries, hooks *hook.Hooks, uploader *upload.Uploader, scheduler *schedule.Scheduler, venvs *python.Cache, runner *action.Runner, secrets *secret.Cipher) *Service {
 	return &Service{
 		queries:   queries,
 		hooks:     hooks,
 		uploader:  uploader,
 		scheduler: scheduler,
+		venvs:     venvs,
+		runner:    runner,
+		secrets:   secrets,
 	}
 }
 
@@ -82,40 +100,57 @@ func (s *Service) ListComments(ctx context.Context, request openapi.ListComments
 }
 
 func (s *Service) CreateComment(ctx context.Context, request openapi.CreateCommentRequestObject) (openapi.CreateCommentResponseObject, error) {
-	s.hooks.OnRecordBeforeCreateRequest.Publish(ctx, database.CommentsTable.ID, request.Body)
+	if err := s.hooks.OnRecordBeforeCreateRequest.PublishWithError(ctx, database.CommentsTable.ID, request.Body); err != nil {
+		return nil, err
+	}
+
+	response, err := changes.Write(ctx, s.queries, database.CreateAction, database.CommentsTable.ID, func(queries *sqlc.Queries) (openapi.Comment, error) {
+		comment, err := queries.CreateComment(ctx, sqlc.CreateCommentParams{
+			Author:  request.Body.Author,
+			Message: request.Body.Message,
+			Ticket:  request.Body.Ticket,
+		})
+		if err != nil {
+			return openapi.Comment{}, err
+		}
 
-	comment, err := s.queries.CreateComment(ctx, sqlc.CreateCommentParams{
-		Author:  request.Body.Author,
-		Message: request.Body.Message,
-		Ticket:  request.Body.Ticket,
+		return openapi.Comment{
+			Author:  comment.Author,
+			Created: comment.Created,
+			Id:      comment.ID,
+			Message: comment.Message,
+			Ticket:  comment.Ticket,
+			Updated: comment.Updated,
+		}, nil
 	})
 	if err != nil {
 		return nil, err
 	}
 
-	response := openapi.Comment{
-		Author:  comment.Author,
-		Created: comment.Created,
-		Id:      comment.ID,
-		Message: comment.Message,
-		Ticket:  comment.Ticket,
-		Updated: comment.Updated,
-	}
-
 	s.hooks.OnRecordAfterCreateRequest.Publish(ctx, database.CommentsTable.ID, response)
 
 	return openapi.CreateComment200JSONResponse(response), nil
 }
 
 func (s *Service) DeleteComment(ctx context.Context, request openapi.DeleteCommentRequestObject) (openapi.DeleteCommentResponseObject, error) {
-	s.hooks.OnRecordBeforeDeleteRequest.Publish(ctx, database.CommentsTable.ID, request.Id)
-
-	err := s.queries.DeleteComment(ctx, request.Id)
+	found, err := s.queries.GetComment(ctx, request.Id)
 	if err != nil {
+		return nil, notFound(err)
+	}
+
+	record := mapExtendedComment(found)
+
+	if err := s.hooks.OnRecordBeforeDeleteRequest.PublishWithError(ctx, database.CommentsTable.ID, record); err != nil {
+		return nil, err
+	}
+
+	if _, err := changes.Write(ctx, s.queries, database.DeleteAction, database.CommentsTable.ID, func(queries *sqlc.Queries) (any, error) {
+		return record, queries.DeleteComment(ctx, request.Id)
+	}); err != nil {
 		return nil, err
 	}
 
-	s.hooks.OnRecordAfterDeleteRequest.Publish(ctx, database.CommentsTable.ID, request.Id)
+	s.hooks.OnRecordAfterDeleteRequest.Publish(ctx, database.CommentsTable.ID, record)
 
 	return openapi.DeleteComment204Response{}, nil
 }
@@ -126,15 +161,7 @@ func (s *Service) GetComment(ctx context.Context, request openapi.GetCommentRequ
 		return nil, err
 	}
 
-	response := openapi.ExtendedComment{
-		Author:     comment.Author,
-		AuthorName: pointer.Dereference(comment.AuthorName),
-		Created:    comment.Created,
-		Id:         comment.ID,
-		Message:    comment.Message,
-		Ticket:     comment.Ticket,
-		Updated:    comment.Updated,
-	}
+	response := mapExtendedComment(comment)
 
 	s.hooks.OnRecordViewRequest.Publish(ctx, database.CommentsTable.ID, response)
 
@@ -142,26 +169,47 @@ func (s *Service) GetComment(ctx context.Context, request openapi.GetCommentRequ
 }
 
 func (s *Service) UpdateComment(ctx context.Context, request openapi.UpdateCommentRequestObject) (openapi.UpdateCommentResponseObject, error) {
-	s.hooks.OnRecordBeforeUpdateRequest.Publish(ctx, database.CommentsTable.ID, request.Body)
-
-	comment, err := s.queries.UpdateComment(ctx, sqlc.UpdateCommentParams{
-		Message: request.Body.Message,
-		ID:      request.Id,
-	})
+	found, err := s.queries.GetComment(ctx, request.Id)
 	if err != nil {
+		return nil, notFound(err)
+	}
+
+	old := mapComment(found)
+
+	if err := s.hooks.OnRecordBeforeUpdateRequest.PublishWithError(ctx, database.CommentsTable.ID, &hook.Update{Old: old, New: request.Body}); err != nil {
 		return nil, err
 	}
 
-	response := openapi.Comment{
-		Author:  comment.Author,
-		Created: comment.Created,
-		Id:      comment.ID,
-		Message: comment.Message,
-		Ticket:  comment.Ticket,
-		Updated: comment.Updated,
+	response, err := changes.Write(ctx, s.queries, database.UpdateAction, database.CommentsTable.ID, func(queries *sqlc.Queries) (openapi.Comment, error) {
+		current, err := queries.GetComment(ctx, request.Id)
+		if err != nil {
+			return openapi.Comment{}, notFound(err)
+		}
+
+		old = mapComment(current)
+
+		comment, err := queries.UpdateComment(ctx, sqlc.UpdateCommentParams{
+			Message: request.Body.Message,
+			ID:      request.Id,
+		})
+		if err != nil {
+			return openapi.Comment{}, err
+		}
+
+		return openapi.Comment{
+			Author:  comment.Author,
+			Created: comment.Created,
+			Id:      comment.ID,
+			Message: comment.Message,
+			Ticket:  comment.Ticket,
+			Updated: comment.Updated,
+		}, nil
+	})
+	if err != nil {
+		return nil, err
 	}
 
-	s.hooks.OnRecordAfterUpdateRequest.Publish(ctx, database.CommentsTable.ID, response)
+	s.hooks.OnRecordAfterUpdateRequest.Publish(ctx, database.CommentsTable.ID, &hook.Update{Old: old, New: response})
 
 	return openapi.UpdateComment200JSONResponse(response), nil
 }
@@ -223,7 +271,9 @@ func (s *Service) ListFiles(ctx context.Context, request openapi.ListFilesReques
 }
 
 func (s *Service) CreateFile(ctx context.Context, request openapi.CreateFileRequestObject) (openapi.CreateFileResponseObject, error) {
-	s.hooks.OnRecordBeforeCreateRequest.Publish(ctx, database.FilesTable.ID, request.Body)
+	if err := s.hooks.OnRecordBeforeCreateRequest.PublishWithError(ctx, database.FilesTable.ID, request.Body); err != nil {
+		return nil, err
+	}
 
 	id := database.GenerateID("b")
 
@@ -232,14 +282,16 @@ func (s *Service) CreateFile(ctx context.Context, request openapi.CreateFileRequ
 		return nil, err
 	}
 
-	file, err := s.queries.InsertFile(ctx, sqlc.InsertFileParams{
-		ID:      id,
-		Name:    request.Body.Name,
-		Blob:    uniqName,
-		Size:    float64(len(request.Body.Blob)),
-		Ticket:  request.Body.Ticket,
-		Created: time.Now().UTC(),
-		Updated: time.Now().UTC(),
+	file, err := changes.Write(ctx, s.queries, database.CreateAction, database.FilesTable.ID, func(queries *sqlc.Queries) (sqlc.File, error) {
+		return queries.InsertFile(ctx, sqlc.InsertFileParams{
+			ID:      id,
+			Name:    request.Body.Name,
+			Blob:    uniqName,
+			Size:    float64(len(request.Body.Blob)),
+			Ticket:  request.Body.Ticket,
+			Created: time.Now().UTC(),
+			Updated: time.Now().UTC(),
+		})
 	})
 	if err != nil {
 		return nil, err
@@ -258,10 +310,14 @@ func (s *Service) CreateFile(ctx context.Context, request openapi.CreateFileRequ
 }
 
 func (s *Service) DeleteFile(ctx context.Context, request openapi.DeleteFileRequestObject) (openapi.DeleteFileResponseObject, error) {
-	s.hooks.OnRecordBeforeDeleteRequest.Publish(ctx, database.FilesTable.ID, request.Id)
-
 	f, err := s.queries.GetFile(ctx, request.Id)
 	if err != nil {
+		return nil, notFound(err)
+	}
+
+	record := mapFile(f)
+
+	if err := s.hooks.OnRecordBeforeDeleteRequest.PublishWithError(ctx, database.FilesTable.ID, record); err != nil {
 		return nil, err
 	}
 
@@ -269,11 +325,13 @@ func (s *Service) DeleteFile(ctx context.Context, request openapi.DeleteFileRequ
 		return nil, fmt.Errorf("failed to delete file from uploader: %w", err)
 	}
 
-	if err := s.queries.DeleteFile(ctx, request.Id); err != nil {
+	if _, err := changes.Write(ctx, s.queries, database.DeleteAction, database.FilesTable.ID, func(queries *sqlc.Queries) (any, error) {
+		return record, queries.DeleteFile(ctx, request.Id)
+	}); err != nil {
 		return nil, err
 	}
 
-	s.hooks.OnRecordAfterDeleteRequest.Publish(ctx, database.FilesTable.ID, request.Id)
+	s.hooks.OnRecordAfterDeleteRequest.Publish(ctx, database.FilesTable.ID, record)
 
 	return openapi.DeleteFile204Response{}, nil
 }
@@ -284,14 +342,7 @@ func (s *Service) GetFile(ctx context.Context, request openapi.GetFileRequestObj
 		return nil, err
 	}
 
-	response := openapi.File{
-		Created: file.Created,
-		Id:      file.ID,
-		Name:    file.Name,
-		Size:    file.Size,
-		Ticket:  file.Ticket,
-		Updated: file.Updated,
-	}
+	response := mapFile(file)
 
 	s.hooks.OnRecordViewRequest.Publish(ctx, database.FilesTable.ID, response)
 
@@ -357,40 +408,57 @@ func (s *Service) DownloadFile(ctx context.Context, request openapi.DownloadFile
 }
 
 func (s *Service) CreateLink(ctx context.Context, request openapi.CreateLinkRequestObject) (openapi.CreateLinkResponseObject, error) {
-	s.hooks.OnRecordBeforeCreateRequest.Publish(ctx, database.LinksTable.ID, request.Body)
+	if err := s.hooks.OnRecordBeforeCreateRequest.PublishWithError(ctx, database.LinksTable.ID, request.Body); err != nil {
+		return nil, err
+	}
+
+	response, err := changes.Write(ctx, s.queries, database.CreateAction, database.LinksTable.ID, func(queries *sqlc.Queries) (openapi.Link, error) {
+		link, err := queries.CreateLink(ctx, sqlc.CreateLinkParams{
+			Name:   request.Body.Name,
+			Url:    request.Body.Url,
+			Ticket: request.Body.Ticket,
+		})
+		if err != nil {
+			return openapi.Link{}, err
+		}
 
-	link, err := s.queries.CreateLink(ctx, sqlc.CreateLinkParams{
-		Name:   request.Body.Name,
-		Url:    request.Body.Url,
-		Ticket: request.Body.Ticket,
+		return openapi.Link{
+			Created: link.Created,
+			Id:      link.ID,
+			Name:    link.Name,
+			Ticket:  link.Ticket,
+			Updated: link.Updated,
+			Url:     link.Url,
+		}, nil
 	})
 	if err != nil {
 		return nil, err
 	}
 
-	response := openapi.Link{
-		Created: link.Created,
-		Id:      link.ID,
-		Name:    link.Name,
-		Ticket:  link.Ticket,
-		Updated: link.Updated,
-		Url:     link.Url,
-	}
-
 	s.hooks.OnRecordAfterCreateRequest.Publish(ctx, database.LinksTable.ID, response)
 
 	return openapi.CreateLink200JSONResponse(response), nil
 }
 
 func (s *Service) DeleteLink(ctx context.Context, request openapi.DeleteLinkRequestObject) (openapi.DeleteLinkResponseObject, error) {
-	s.hooks.OnRecordBeforeDeleteRequest.Publish(ctx, database.LinksTable.ID, request.Id)
-
-	err := s.queries.DeleteLink(ctx, request.Id)
+	found, err := s.queries.GetLink(ctx, request.Id)
 	if err != nil {
+		return nil, notFound(err)
+	}
+
+	record := mapLink(found)
+
+	if err := s.hooks.OnRecordBeforeDeleteRequest.PublishWithError(ctx, database.LinksTable.ID, record); err != nil {
+		return nil, err
+	}
+
+	if _, err := changes.Write(ctx, s.queries, database.DeleteAction, database.LinksTable.ID, func(queries *sqlc.Queries) (any, error) {
+		return record, queries.DeleteLink(ctx, request.Id)
+	}); err != nil {
 		return nil, err
 	}
 
-	s.hooks.OnRecordAfterDeleteRequest.Publish(ctx, database.LinksTable.ID, request.Id)
+	s.hooks.OnRecordAfterDeleteRequest.Publish(ctx, database.LinksTable.ID, record)
 
 	return openapi.DeleteLink204Response{}, nil
 }
@@ -416,27 +484,41 @@ func (s *Service) GetLink(ctx context.Context, request openapi.GetLinkRequestObj
 }
 
 func (s *Service) UpdateLink(ctx context.Context, request openapi.UpdateLinkRequestObject) (openapi.UpdateLinkResponseObject, error) {
-	s.hooks.OnRecordBeforeUpdateRequest.Publish(ctx, database.LinksTable.ID, request.Body)
-
-	link, err := s.queries.UpdateLink(ctx, sqlc.UpdateLinkParams{
-		ID:   request.Id,
-		Name: request.Body.Name,
-		Url:  request.Body.Url,
-	})
+	found, err := s.queries.GetLink(ctx, request.Id)
 	if err != nil {
+		return nil, notFound(err)
+	}
+
+	old := mapLink(found)
+
+	if err := s.hooks.OnRecordBeforeUpdateRequest.PublishWithError(ctx, database.LinksTable.ID, &hook.Update{Old: old, New: request.Body}); err != nil {
 		return nil, err
 	}
 
-	response := openapi.Link{
-		Created: link.Created,
-		Id:      link.ID,
-		Name:    link.Name,
-		Ticket:  link.Ticket,
-		Updated: link.Updated,
-		Url:     link.Url,
+	response, err := changes.Write(ctx, s.queries, database.UpdateAction, database.LinksTable.ID, func(queries *sqlc.Queries) (openapi.Link, error) {
+		current, err := queries.GetLink(ctx, request.Id)
+		if err != nil {
+			return openapi.Link{}, notFound(err)
+		}
+
+		old = mapLink(current)
+
+		link, err := queries.UpdateLink(ctx, sqlc.UpdateLinkParams{
+			ID:   request.Id,
+			Name: request.Body.Name,
+			Url:  request.Body.Url,
+		})
+		if err != nil {
+			return openapi.Link{}, err
+		}
+
+		return mapLink(link), nil
+	})
+	if err != nil {
+		return nil, err
 	}
 
-	s.hooks.OnRecordAfterUpdateRequest.Publish(ctx, database.LinksTable.ID, response)
+	s.hooks.OnRecordAfterUpdateRequest.Publish(ctx, database.LinksTable.ID, &hook.Update{Old: old, New: response})
 
 	return openapi.UpdateLink200JSONResponse(response), nil
 }
@@ -480,14 +562,31 @@ func (s *Service) ListReactions(ctx context.Context, request openapi.ListReactio
 }
 
 func (s *Service) CreateReaction(ctx context.Context, request openapi.CreateReactionRequestObject) (openapi.CreateReactionResponseObject, error) {
-	s.hooks.OnRecordBeforeCreateRequest.Publish(ctx, database.ReactionsTable.ID, request.Body)
-
-	reaction, err := s.queries.CreateReaction(ctx, sqlc.CreateReactionParams{
-		Name:        request.Body.Name,
-		Action:      request.Body.Action,
-		Trigger:     request.Body.Trigger,
-		Actiondata:  marshal(request.Body.Actiondata),
-		Triggerdata: marshal(request.Body.Triggerdata),
+	if err := validateReaction(request.Body.Action, marshal(request.Body.Actiondata), request.Body.Trigger, marshal(request.Body.Triggerdata)); err != nil {
+		return nil, badRequest(err)
+	}
+
+	if err := s.hooks.OnRecordBeforeCreateRequest.PublishWithError(ctx, database.ReactionsTable.ID, request.Body); err != nil {
+		return nil, err
+	}
+
+	var reaction sqlc.Reaction
+
+	response, err := changes.Write(ctx, s.queries, database.CreateAction, database.ReactionsTable.ID, func(queries *sqlc.Queries) (openapi.Reaction, error) {
+		var err error
+
+		reaction, err = queries.CreateReaction(ctx, sqlc.CreateReactionParams{
+			Name:        request.Body.Name,
+			Action:      request.Body.Action,
+			Trigger:     request.Body.Trigger,
+			Actiondata:  marshal(request.Body.Actiondata),
+			Triggerdata: marshal(request.Body.Triggerdata),
+		})
+		if err != nil {
+			return openapi.Reaction{}, err
+		}
+
+		return mapReaction(reaction), nil
 	})
 	if err != nil {
 		return nil, err
@@ -497,33 +596,32 @@ func (s *Service) CreateReaction(ctx context.Context, request openapi.CreateReac
 		slog.ErrorContext(ctx, "Failed to add reaction to scheduler", "error", err)
 	}
 
-	response := openapi.Reaction{
-		Action:      reaction.Action,
-		Actiondata:  unmarshal(reaction.Actiondata),
-		Created:     reaction.Created,
-		Id:          reaction.ID,
-		Name:        reaction.Name,
-		Trigger:     reaction.Trigger,
-		Triggerdata: unmarshal(reaction.Triggerdata),
-		Updated:     reaction.Updated,
-	}
-
 	s.hooks.OnRecordAfterCreateRequest.Publish(ctx, database.ReactionsTable.ID, response)
 
 	return openapi.CreateReaction200JSONResponse(response), nil
 }
 
 func (s *Service) DeleteReaction(ctx context.Context, request openapi.DeleteReactionRequestObject) (openapi.DeleteReactionResponseObject, error) {
-	s.hooks.OnRecordBeforeDeleteRequest.Publish(ctx, database.ReactionsTable.ID, request.Id)
-
-	err := s.queries.DeleteReaction(ctx, request.Id)
+	found, err := s.queries.GetReaction(ctx, request.Id)
 	if err != nil {
+		return nil, notFound(err)
+	}
+
+	record := mapReaction(found)
+
+	if err := s.hooks.OnRecordBeforeDeleteRequest.PublishWithError(ctx, database.ReactionsTable.ID, record); err != nil {
+		return nil, err
+	}
+
+	if _, err := changes.Write(ctx, s.queries, database.DeleteAction, database.ReactionsTable.ID, func(queries *sqlc.Queries) (any, error) {
+		return record, queries.DeleteReaction(ctx, request.Id)
+	}); err != nil {
 		return nil, err
 	}
 
 	s.scheduler.RemoveReaction(request.Id)
 
-	s.hooks.OnRecordAfterDeleteRequest.Publish(ctx, database.ReactionsTable.ID, request.Id)
+	s.hooks.OnRecordAfterDeleteRequest.Publish(ctx, database.ReactionsTable.ID, record)
 
 	return openapi.DeleteReaction204Response{}, nil
 }
@@ -551,15 +649,62 @@ func (s *Service) GetReaction(ctx context.Context, request openapi.GetReactionRe
 }
 
 func (s *Service) UpdateReaction(ctx context.Context, request openapi.UpdateReactionRequestObject) (openapi.UpdateReactionResponseObject, error) {
-	s.hooks.OnRecordBeforeUpdateRequest.Publish(ctx, database.ReactionsTable.ID, request.Body)
-
-	reaction, err := s.queries.UpdateReaction(ctx, sqlc.UpdateReactionParams{
-		ID:          request.Id,
-		Name:        request.Body.Name,
-		Action:      request.Body.Action,
-		Trigger:     request.Body.Trigger,
-		Actiondata:  marshalPointer(request.Body.Actiondata),
-		Triggerdata: marshalPointer(request.Body.Triggerdata),
+	found, err := s.queries.GetReaction(ctx, request.Id)
+	if err != nil {
+		return nil, notFound(err)
+	}
+
+	actionName, actionData := found.Action, found.Actiondata
+	if request.Body.Action != nil {
+		actionName = *request.Body.Action
+	}
+
+	if request.Body.Actiondata != nil {
+		actionData = marshal(*request.Body.Actiondata)
+	}
+
+	trigger, triggerData := found.Trigger, found.Triggerdata
+	if request.Body.Trigger != nil {
+		trigger = *request.Body.Trigger
+	}
+
+	if request.Body.Triggerdata != nil {
+		triggerData = marshal(*request.Body.Triggerdata)
+	}
+
+	if err := validateReaction(actionName, actionData, trigger, triggerData); err != nil {
+		return nil, badRequest(err)
+	}
+
+	old := mapReaction(found)
+
+	if err := s.hooks.OnRecordBeforeUpdateRequest.PublishWithError(ctx, database.ReactionsTable.ID, &hook.Update{Old: old, New: request.Body}); err != nil {
+		return nil, err
+	}
+
+	var reaction sqlc.Reaction
+
+	response, err := changes.Write(ctx, s.queries, database.UpdateAction, database.ReactionsTable.ID, func(queries *sqlc.Queries) (openapi.Reaction, error) {
+		current, err := queries.GetReaction(ctx, request.Id)
+		if err != nil {
+			return openapi.Reaction{}, notFound(err)
+		}
+
+		old = mapReaction(current)
+
+		reaction, err = queries.UpdateReaction(ctx, sqlc.UpdateReactionParams{
+			ID:          request.Id,
+			Name:        request.Body.Name,
+			Action:      request.Body.Action,
+			Trigger:     request.Body.Trigger,
+			Actiondata:  marshalPointer(request.Body.Actiondata),
+			Triggerdata: marshalPointer(request.Body.Triggerdata),
+		})
+		if err != nil {
+			return openapi.Reaction{}, err
+		}
+
+		return mapReaction(reaction), nil
 	})
 	if err != nil {
 		return nil, err
@@ -571,20 +716,159 @@ func (s *Service) UpdateReaction(ctx context.Context, request openapi.UpdateReac
 		slog.ErrorContext(ctx, "Failed to add reaction to scheduler", "error", err)
 	}
 
-	response := openapi.Reaction{
-		Action:      reaction.Action,
-		Actiondata:  unmarshal(reaction.Actiondata),
-		Created:     reaction.Created,
-		Id:          reaction.ID,
-		Name:        reaction.Name,
-		Trigger:     reaction.Trigger,
-		Triggerdata: unmarshal(reaction.Triggerdata),
-		Updated:     reaction.Updated,
+	s.hooks.OnRecordAfterUpdateRequest.Publish(ctx, database.ReactionsTable.ID, &hook.Update{Old: old, New: response})
+
+	return openapi.UpdateReaction200JSONResponse(response), nil
+}
+
+func (s *Service) RunReaction(ctx context.Context, request openapi.RunReactionRequestObject) (openapi.RunReactionResponseObject, error) {
+	record, err := s.queries.GetReaction(ctx, request.Id)
+	if err != nil {
+		return nil, notFound(err)
 	}
 
-	s.hooks.OnRecordAfterUpdateRequest.Publish(ctx, database.ReactionsTable.ID, response)
+	if request.Params.DryRun != nil && *request.Params.DryRun {
+		response := openapi.ReactionRunResult{DryRun: true}
 
-	return openapi.UpdateReaction200JSONResponse(response), nil
+		if err := validateReaction(record.Action, record.Actiondata, record.Trigger, record.Triggerdata); err != nil {
+			response.Error = pointer.Pointer(err.Error())
+		}
+
+		return openapi.RunReaction200JSONResponse(response), nil
+	}
+
+	settings, err := settings.Load(ctx, s.queries)
+	if err != nil {
+		return nil, err
+	}
+
+	// the run stores the payload, so a missing body is passed to the action
+	// as the empty object that is stored
+	payload := marshalPointer(request.Body)
+	if len(payload) == 0 || string(payload) == "null" {
+		payload = json.RawMessage("{}")
+	}
+
+	run, output, runErr := s.runner.RunReaction(ctx, settings.Meta.AppURL, record.ID, manualTrigger, record.Action, record.Actiondata, payload)
+	if run == nil {
+		return nil, runErr
+	}
+
+	response := openapi.ReactionRunResult{
+		Run:    &run.ID,
+		Output: string(output),
+	}
+
+	if runErr != nil {
+		response.Error = pointer.Pointer(runErr.Error())
+	}
+
+	return openapi.RunReaction200JSONResponse(response), nil
+}
+
+// validateReaction checks the action and the trigger of a reaction, so
+// reactions that cannot run are not saved.
+func validateReaction(actionName string, actionData json.RawMessage, trigger string, triggerData json.RawMessage) error {
+	return errors.Join(
+		action.Validate(actionName, actionData),
+		reaction.ValidateTrigger(trigger, triggerData),
+	)
+}
+
+func (s *Service) ListReactionRuns(ctx context.Context, request openapi.ListReactionRunsRequestObject) (openapi.ListReactionRunsResponseObject, error) {
+	runs, err := s.queries.ListReactionRuns(ctx, sqlc.ListReactionRunsParams{
+		Reaction: request.Id,
+		Offset:   toInt64(request.Params.Offset, defaultOffset),
+		Limit:    toInt64(request.Params.Limit, defaultLimit),
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	response := make([]openapi.ReactionRun, 0, len(runs))
+	for _, run := range runs {
+		response = append(response, mapReactionRun(sqlc.ReactionRun{
+			ID:       run.ID,
+			Reaction: run.Reaction,
+			Trigger:  run.Trigger,
+			Status:   run.Status,
+			Input:    run.Input,
+			Output:   run.Output,
+			Error:    run.Error,
+			ExitCode: run.ExitCode,
+			Started:  run.Started,
+			Finished: run.Finished,
+		}))
+	}
+
+	s.hooks.OnRecordsListRequest.Publish(ctx, database.ReactionRunsTable.ID, response)
+
+	totalCount := 0
+	if len(runs) > 0 {
+		totalCount = int(runs[0].TotalCount)
+	}
+
+	return openapi.ListReactionRuns200JSONResponse{
+		Body: response,
+		Headers: openapi.ListReactionRuns200ResponseHeaders{
+			XTotalCount: totalCount,
+		},
+	}, nil
+}
+
+func (s *Service) GetReactionRun(ctx context.Context, request openapi.GetReactionRunRequestObject) (openapi.GetReactionRunResponseObject, error) {
+	run, err := s.queries.GetReactionRun(ctx, sqlc.GetReactionRunParams{
+		ID:       request.RunId,
+		Reaction: request.Id,
+	})
+	if err != nil {
+		return nil, notFound(err)
+	}
+
+	response := mapReactionRun(run)
+
+	s.hooks.OnRecordViewRequest.Publish(ctx, database.ReactionRunsTable.ID, response)
+
+	return openapi.GetReactionRun200JSONResponse(response), nil
+}
+
+func (s *Service) ListPythonEnvironments(_ context.Context, _ openapi.ListPythonEnvironmentsRequestObject) (openapi.ListPythonEnvironmentsResponseObject, error) {
+	environments, err := s.venvs.List()
+	if err != nil {
+		return nil, err
+	}
+
+	response := make([]openapi.PythonEnvironment, 0, len(environments))
+	for _, environment := range environments {
+		response = append(response, mapPythonEnvironment(environment))
+	}
+
+	return openapi.ListPythonEnvironments200JSONResponse(response), nil
+}
+
+func (s *Service) PrewarmPythonEnvironment(ctx context.Context, request openapi.PrewarmPythonEnvironmentRequestObject) (openapi.PrewarmPythonEnvironmentResponseObject, error) {
+	environment, err := s.venvs.Prewarm(ctx, request.Body.Requirements)
+	if err != nil {
+		return nil, err
+	}
+
+	return openapi.PrewarmPythonEnvironment200JSONResponse(mapPythonEnvironment(environment)), nil
+}
+
+func (s *Service) PurgePythonEnvironments(ctx context.Context, _ openapi.PurgePythonEnvironmentsRequestObject) (openapi.PurgePythonEnvironmentsResponseObject, error) {
+	if _, err := s.venvs.Purge(ctx); err != nil {
+		return nil, err
+	}
+
+	return openapi.PurgePythonEnvironments204Response{}, nil
+}
+
+func (s *Service) DeletePythonEnvironment(_ context.Context, request openapi.DeletePythonEnvironmentRequestObject) (openapi.DeletePythonEnvironmentResponseObject, error) {
+	if err := s.venvs.Delete(request.Id); err != nil {
+		return nil, notFound(err)
+	}
+
+	return openapi.DeletePythonEnvironment204Response{}, nil
 }
 
 func (s *Service) GetSidebar(ctx context.Context, _ openapi.GetSidebarRequestObject) (openapi.GetSidebarResponseObject, error) {
@@ -651,42 +935,59 @@ func (s *Service) ListTasks(ctx context.Context, request openapi.ListTasksReques
 }
 
 func (s *Service) CreateTask(ctx context.Context, request openapi.CreateTaskRequestObject) (openapi.CreateTaskResponseObject, error) {
-	s.hooks.OnRecordBeforeCreateRequest.Publish(ctx, database.TasksTable.ID, request.Body)
+	if err := s.hooks.OnRecordBeforeCreateRequest.PublishWithError(ctx, database.TasksTable.ID, request.Body); err != nil {
+		return nil, err
+	}
 
-	task, err := s.queries.CreateTask(ctx, sqlc.CreateTaskParams{
-		Name:   request.Body.Name,
-		Open:   request.Body.Open,
-		Owner:  request.Body.Owner,
-		Ticket: request.Body.Ticket,
+	response, err := changes.Write(ctx, s.queries, database.CreateAction, database.TasksTable.ID, func(queries *sqlc.Queries) (openapi.Task, error) {
+		task, err := queries.CreateTask(ctx, sqlc.CreateTaskParams{
+			Name:   request.Body.Name,
+			Open:   request.Body.Open,
+			Owner:  request.Body.Owner,
+			Ticket: request.Body.Ticket,
+		})
+		if err != nil {
+			return openapi.Task{}, err
+		}
+
+		return openapi.Task{
+			Created: task.Created,
+			Id:      task.ID,
+			Name:    task.Name,
+			Open:    task.Open,
+			Owner:   task.Owner,
+			Ticket:  task.Ticket,
+			Updated: task.Updated,
+		}, nil
 	})
 	if err != nil {
 		return nil, err
 	}
 
-	response := openapi.Task{
-		Created: task.Created,
-		Id:      task.ID,
-		Name:    task.Name,
-		Open:    task.Open,
-		Owner:   task.Owner,
-		Ticket:  task.Ticket,
-		Updated: task.Updated,
-	}
-
 	s.hooks.OnRecordAfterCreateRequest.Publish(ctx, database.TasksTable.ID, response)
 
 	return openapi.CreateTask200JSONResponse(response), nil
 }
 
 func (s *Service) DeleteTask(ctx context.Context, request openapi.DeleteTaskRequestObject) (openapi.DeleteTaskResponseObject, error) {
-	s.hooks.OnRecordBeforeDeleteRequest.Publish(ctx, database.TasksTable.ID, request.Id)
-
-	err := s.queries.DeleteTask(ctx, request.Id)
+	found, err := s.queries.GetTask(ctx, request.Id)
 	if err != nil {
+		return nil, notFound(err)
+	}
+
+	record := mapExtendedTask(found)
+
+	if err := s.hooks.OnRecordBeforeDeleteRequest.PublishWithError(ctx, database.TasksTable.ID, record); err != nil {
+		return nil, err
+	}
+
+	if _, err := changes.Write(ctx, s.queries, database.DeleteAction, database.TasksTable.ID, func(queries *sqlc.Queries) (any, error) {
+		return record, queries.DeleteTask(ctx, request.Id)
+	}); err != nil {
 		return nil, err
 	}
 
-	s.hooks.OnRecordAfterDeleteRequest.Publish(ctx, database.TasksTable.ID, request.Id)
+	s.hooks.OnRecordAfterDeleteRequest.Publish(ctx, database.TasksTable.ID, record)
 
 	return openapi.DeleteTask204Response{}, nil
 }
@@ -697,18 +998,7 @@ func (s *Service) GetTask(ctx context.Context, request openapi.GetTaskRequestObj
 		return nil, err
 	}
 
-	response := openapi.ExtendedTask{
-		Id:         task.ID,
-		Name:       task.Name,
-		Created:    task.Created,
-		Updated:    task.Updated,
-		Open:       task.Open,
-		Owner:      task.Owner,
-		Ticket:     task.Ticket,
-		OwnerName:  task.OwnerName,
-		TicketName: pointer.Dereference(task.TicketName),
-		TicketType: pointer.Dereference(task.TicketType),
-	}
+	response := mapExtendedTask(task)
 
 	s.hooks.OnRecordViewRequest.Publish(ctx, database.TasksTable.ID, response)
 
@@ -716,29 +1006,50 @@ func (s *Service) GetTask(ctx context.Context, request openapi.GetTaskRequestObj
 }
 
 func (s *Service) UpdateTask(ctx context.Context, request openapi.UpdateTaskRequestObject) (openapi.UpdateTaskResponseObject, error) {
-	s.hooks.OnRecordBeforeUpdateRequest.Publish(ctx, database.TasksTable.ID, request.Body)
-
-	task, err := s.queries.UpdateTask(ctx, sqlc.UpdateTaskParams{
-		ID:    request.Id,
-		Name:  request.Body.Name,
-		Open:  request.Body.Open,
-		Owner: request.Body.Owner,
-	})
+	found, err := s.queries.GetTask(ctx, request.Id)
 	if err != nil {
+		return nil, notFound(err)
+	}
+
+	old := mapTask(found)
+
+	if err := s.hooks.OnRecordBeforeUpdateRequest.PublishWithError(ctx, database.TasksTable.ID, &hook.Update{Old: old, New: request.Body}); err != nil {
 		return nil, err
 	}
 
-	response := openapi.Task{
-		Created: task.Created,
-		Id:      task.ID,
-		Name:    task.Name,
-		Open:    task.Open,
-		Owner:   task.Owner,
-		Ticket:  task.Ticket,
-		Updated: task.Updated,
+	response, err := changes.Write(ctx, s.queries, database.UpdateAction, database.TasksTable.ID, func(queries *sqlc.Queries) (openapi.Task, error) {
+		current, err := queries.GetTask(ctx, request.Id)
+		if err != nil {
+			return openapi.Task{}, notFound(err)
+		}
+
+		old = mapTask(current)
+
+		task, err := queries.UpdateTask(ctx, sqlc.UpdateTaskParams{
+			ID:    request.Id,
+			Name:  request.Body.Name,
+			Open:  request.Body.Open,
+			Owner: request.Body.Owner,
+		})
+		if err != nil {
+			return openapi.Task{}, err
+		}
+
+		return openapi.Task{
+			Created: task.Created,
+			Id:      task.ID,
+			Name:    task.Name,
+			Open:    task.Open,
+			Owner:   task.Owner,
+			Ticket:  task.Ticket,
+			Updated: task.Updated,
+		}, nil
+	})
+	if err != nil {
+		return nil, err
 	}
 
-	s.hooks.OnRecordAfterUpdateRequest.Publish(ctx, database.TasksTable.ID, response)
+	s.hooks.OnRecordAfterUpdateRequest.Publish(ctx, database.TasksTable.ID, &hook.Update{Old: old, New: response})
 
 	return openapi.UpdateTask200JSONResponse(response), nil
 }
@@ -830,33 +1141,40 @@ func (s *Service) ListTickets(ctx context.Context, request openapi.ListTicketsRe
 }
 
 func (s *Service) CreateTicket(ctx context.Context, request openapi.CreateTicketRequestObject) (openapi.CreateTicketResponseObject, error) {
-	s.hooks.OnRecordBeforeCreateRequest.Publish(ctx, database.TicketsTable.ID, request.Body)
-
-	ticket, err := s.queries.CreateTicket(ctx, sqlc.CreateTicketParams{
-		Name:        request.Body.Name,
-		Description: request.Body.Description,
-		Owner:       request.Body.Owner,
-		Open:        request.Body.Open,
-		Resolution:  request.Body.Resolution,
-		Type:        request.Body.Type,
-		State:       marshal(request.Body.State),
-	})
-	if err != nil {
+	if err := s.hooks.OnRecordBeforeCreateRequest.PublishWithError(ctx, database.TicketsTable.ID, request.Body); err != nil {
 		return nil, err
 	}
 
-	response := openapi.Ticket{
-		Created:     ticket.Created,
-		Description: ticket.Description,
-		Id:          ticket.ID,
-		Name:        ticket.Name,
-		Open:        ticket.Open,
-		Owner:       ticket.Owner,
-		Resolution:  ticket.Resolution,
-		Schema:      unmarshal(ticket.Schema),
-		State:       unmarshal(ticket.State),
-		Type:        ticket.Type,
-		Updated:     ticket.Updated,
+	response, err := changes.Write(ctx, s.queries, database.CreateAction, database.TicketsTable.ID, func(queries *sqlc.Queries) (openapi.Ticket, error) {
+		ticket, err := queries.CreateTicket(ctx, sqlc.CreateTicketParams{
+			Name:        request.Body.Name,
+			Description: request.Body.Description,
+			Owner:       request.Body.Owner,
+			Open:        request.Body.Open,
+			Resolution:  request.Body.Resolution,
+			Type:        request.Body.Type,
+			State:       marshal(request.Body.State),
+		})
+		if err != nil {
+			return openapi.Ticket{}, err
+		}
+
+		return openapi.Ticket{
+			Created:     ticket.Created,
+			Description: ticket.Description,
+			Id:          ticket.ID,
+			Name:        ticket.Name,
+			Open:        ticket.Open,
+			Owner:       ticket.Owner,
+			Resolution:  ticket.Resolution,
+			Schema:      unmarshal(ticket.Schema),
+			State:       unmarshal(ticket.State),
+			Type:        ticket.Type,
+			Updated:     ticket.Updated,
+		}, nil
+	})
+	if err != nil {
+		return nil, err
 	}
 
 	s.hooks.OnRecordAfterCreateRequest.Publish(ctx, database.TicketsTable.ID, response)
@@ -865,14 +1183,42 @@ func (s *Service) CreateTicket(ctx context.Context, request openapi.CreateTicket
 }
 
 func (s *Service) DeleteTicket(ctx context.Context, request openapi.DeleteTicketRequestObject) (openapi.DeleteTicketResponseObject, error) {
-	s.hooks.OnRecordBeforeDeleteRequest.Publish(ctx, database.TicketsTable.ID, request.Id)
+	found, err := s.queries.Ticket(ctx, request.Id)
+	if err != nil {
+		return nil, notFound(err)
+	}
 
-	err := s.queries.DeleteTicket(ctx, request.Id)
+	counts, err := s.queries.TicketChildCounts(ctx, request.Id)
 	if err != nil {
 		return nil, err
 	}
 
-	s.hooks.OnRecordAfterDeleteRequest.Publish(ctx, database.TicketsTable.ID, request.Id)
+	record := deletedTicket{
+		ExtendedTicket: mapExtendedTicket(found),
+		Children: ticketChildren{
+			Comments: int(counts.Comments),
+			Tasks:    int(counts.Tasks),
+			Files:    int(counts.Files),
+			Links:    int(counts.Links),
+			Timeline: int(counts.Timeline),
+		},
+	}
+
+	if err := s.hooks.OnRecordBeforeDeleteRequest.PublishWithError(ctx, database.TicketsTable.ID, record); err != nil {
+		return nil, err
+	}
+
+	if _, err := changes.Write(ctx, s.queries, database.DeleteAction, database.TicketsTable.ID, func(queries *sqlc.Queries) (any, error) {
+		if err := recordDeletedChildren(ctx, queries, request.Id); err != nil {
+			return nil, err
+		}
+
+		return record, queries.DeleteTicket(ctx, request.Id)
+	}); err != nil {
+		return nil, err
+	}
+
+	s.hooks.OnRecordAfterDeleteRequest.Publish(ctx, database.TicketsTable.ID, record)
 
 	return openapi.DeleteTicket204Response{}, nil
 }
@@ -883,22 +1229,7 @@ func (s *Service) GetTicket(ctx context.Context, request openapi.GetTicketReques
 		return nil, err
 	}
 
-	response := openapi.ExtendedTicket{
-		Created:      ticket.Created,
-		Description:  ticket.Description,
-		Id:           ticket.ID,
-		Name:         ticket.Name,
-		Open:         ticket.Open,
-		Owner:        ticket.Owner,
-		OwnerName:    ticket.OwnerName,
-		Resolution:   ticket.Resolution,
-		Schema:       unmarshal(ticket.Schema),
-		State:        unmarshal(ticket.State),
-		Type:         ticket.Type,
-		TypePlural:   pointer.Dereference(ticket.TypePlural),
-		TypeSingular: pointer.Dereference(ticket.TypeSingular),
-		Updated:      ticket.Updated,
-	}
+	response := mapExtendedTicket(ticket)
 
 	s.hooks.OnRecordViewRequest.Publish(ctx, database.TicketsTable.ID, response)
 
@@ -906,38 +1237,59 @@ func (s *Service) GetTicket(ctx context.Context, request openapi.GetTicketReques
 }
 
 func (s *Service) UpdateTicket(ctx context.Context, request openapi.UpdateTicketRequestObject) (openapi.UpdateTicketResponseObject, error) {
-	s.hooks.OnRecordBeforeUpdateRequest.Publish(ctx, database.TicketsTable.ID, request.Body)
-
-	ticket, err := s.queries.UpdateTicket(ctx, sqlc.UpdateTicketParams{
-		Name:        request.Body.Name,
-		Description: request.Body.Description,
-		Open:        request.Body.Open,
-		Owner:       request.Body.Owner,
-		Resolution:  request.Body.Resolution,
-		Schema:      marshalPointer(request.Body.Schema),
-		State:       marshalPointer(request.Body.State),
-		Type:        request.Body.Type,
-		ID:          request.Id,
-	})
+	found, err := s.queries.Ticket(ctx, request.Id)
 	if err != nil {
+		return nil, notFound(err)
+	}
+
+	old := mapTicket(found)
+
+	if err := s.hooks.OnRecordBeforeUpdateRequest.PublishWithError(ctx, database.TicketsTable.ID, &hook.Update{Old: old, New: request.Body}); err != nil {
 		return nil, err
 	}
 
-	response := openapi.Ticket{
-		Created:     ticket.Created,
-		Description: ticket.Description,
-		Id:          ticket.ID,
-		Name:        ticket.Name,
-		Open:        ticket.Open,
-		Owner:       ticket.Owner,
-		Resolution:  ticket.Resolution,
-		Schema:      unmarshal(ticket.Schema),
-		State:       unmarshal(ticket.State),
-		Type:        ticket.Type,
-		Updated:     ticket.Updated,
+	response, err := changes.Write(ctx, s.queries, database.UpdateAction, database.TicketsTable.ID, func(queries *sqlc.Queries) (openapi.Ticket, error) {
+		current, err := queries.Ticket(ctx, request.Id)
+		if err != nil {
+			return openapi.Ticket{}, notFound(err)
+		}
+
+		old = mapTicket(current)
+
+		ticket, err := queries.UpdateTicket(ctx, sqlc.UpdateTicketParams{
+			Name:        request.Body.Name,
+			Description: request.Body.Description,
+			Open:        request.Body.Open,
+			Owner:       request.Body.Owner,
+			Resolution:  request.Body.Resolution,
+			Schema:      marshalPointer(request.Body.Schema),
+			State:       marshalPointer(request.Body.State),
+			Type:        request.Body.Type,
+			ID:          request.Id,
+		})
+		if err != nil {
+			return openapi.Ticket{}, err
+		}
+
+		return openapi.Ticket{
+			Created:     ticket.Created,
+			Description: ticket.Description,
+			Id:          ticket.ID,
+			Name:        ticket.Name,
+			Open:        ticket.Open,
+			Owner:       ticket.Owner,
+			Resolution:  ticket.Resolution,
+			Schema:      unmarshal(ticket.Schema),
+			State:       unmarshal(ticket.State),
+			Type:        ticket.Type,
+			Updated:     ticket.Updated,
+		}, nil
+	})
+	if err != nil {
+		return nil, err
 	}
 
-	s.hooks.OnRecordAfterUpdateRequest.Publish(ctx, database.TicketsTable.ID, response)
+	s.hooks.OnRecordAfterUpdateRequest.Publish(ctx, database.TicketsTable.ID, &hook.Update{Old: old, New: response})
 
 	return openapi.UpdateTicket200JSONResponse(response), nil
 }
@@ -980,40 +1332,57 @@ func (s *Service) ListTimeline(ctx context.Context, request openapi.ListTimeline
 }
 
 func (s *Service) CreateTimeline(ctx context.Context, request openapi.CreateTimelineRequestObject) (openapi.CreateTimelineResponseObject, error) {
-	s.hooks.OnRecordBeforeCreateRequest.Publish(ctx, database.TimelinesTable.ID, request.Body)
+	if err := s.hooks.OnRecordBeforeCreateRequest.PublishWithError(ctx, database.TimelinesTable.ID, request.Body); err != nil {
+		return nil, err
+	}
+
+	response, err := changes.Write(ctx, s.queries, database.CreateAction, database.TimelinesTable.ID, func(queries *sqlc.Queries) (openapi.TimelineEntry, error) {
+		timeline, err := queries.CreateTimeline(ctx, sqlc.CreateTimelineParams{
+			Message: request.Body.Message,
+			Time:    request.Body.Time,
+			Ticket:  request.Body.Ticket,
+		})
+		if err != nil {
+			return openapi.TimelineEntry{}, err
+		}
 
-	timeline, err := s.queries.CreateTimeline(ctx, sqlc.CreateTimelineParams{
-		Message: request.Body.Message,
-		Time:    request.Body.Time,
-		Ticket:  request.Body.Ticket,
+		return openapi.TimelineEntry{
+			Created: timeline.Created,
+			Id:      timeline.ID,
+			Message: timeline.Message,
+			Ticket:  timeline.Ticket,
+			Time:    timeline.Time,
+			Updated: timeline.Updated,
+		}, nil
 	})
 	if err != nil {
 		return nil, err
 	}
 
-	response := openapi.TimelineEntry{
-		Created: timeline.Created,
-		Id:      timeline.ID,
-		Message: timeline.Message,
-		Ticket:  timeline.Ticket,
-		Time:    timeline.Time,
-		Updated: timeline.Updated,
-	}
-
 	s.hooks.OnRecordAfterCreateRequest.Publish(ctx, database.TimelinesTable.ID, response)
 
 	return openapi.CreateTimeline200JSONResponse(response), nil
 }
 
 func (s *Service) DeleteTimeline(ctx context.Context, request openapi.DeleteTimelineRequestObject) (openapi.DeleteTimelineResponseObject, error) {
-	s.hooks.OnRecordBeforeDeleteRequest.Publish(ctx, database.TimelinesTable.ID, request.Id)
-
-	err := s.queries.DeleteTimeline(ctx, request.Id)
+	found, err := s.queries.GetTimeline(ctx, request.Id)
 	if err != nil {
+		return nil, notFound(err)
+	}
+
+	record := mapTimeline(found)
+
+	if err := s.hooks.OnRecordBeforeDeleteRequest.PublishWithError(ctx, database.TimelinesTable.ID, record); err != nil {
 		return nil, err
 	}
 
-	s.hooks.OnRecordAfterDeleteRequest.Publish(ctx, database.TimelinesTable.ID, request.Id)
+	if _, err := changes.Write(ctx, s.queries, database.DeleteAction, database.TimelinesTable.ID, func(queries *sqlc.Queries) (any, error) {
+		return record, queries.DeleteTimeline(ctx, request.Id)
+	}); err != nil {
+		return nil, err
+	}
+
+	s.hooks.OnRecordAfterDeleteRequest.Publish(ctx, database.TimelinesTable.ID, record)
 
 	return openapi.DeleteTimeline204Response{}, nil
 }
@@ -1039,27 +1408,41 @@ func (s *Service) GetTimeline(ctx context.Context, request openapi.GetTimelineRe
 }
 
 func (s *Service) UpdateTimeline(ctx context.Context, request openapi.UpdateTimelineRequestObject) (openapi.UpdateTimelineResponseObject, error) {
-	s.hooks.OnRecordBeforeUpdateRequest.Publish(ctx, database.TimelinesTable.ID, request.Body)
-
-	timeline, err := s.queries.UpdateTimeline(ctx, sqlc.UpdateTimelineParams{
-		ID:      request.Id,
-		Message: request.Body.Message,
-		Time:    request.Body.Time,
-	})
+	found, err := s.queries.GetTimeline(ctx, request.Id)
 	if err != nil {
+		return nil, notFound(err)
+	}
+
+	old := mapTimeline(found)
+
+	if err := s.hooks.OnRecordBeforeUpdateRequest.PublishWithError(ctx, database.TimelinesTable.ID, &hook.Update{Old: old, New: request.Body}); err != nil {
 		return nil, err
 	}
 
-	response := openapi.TimelineEntry{
-		Created: timeline.Created,
-		Id:      timeline.ID,
-		Message: timeline.Message,
-		Ticket:  timeline.Ticket,
-		Time:    timeline.Time,
-		Updated: timeline.Updated,
+	response, err := changes.Write(ctx, s.queries, database.UpdateAction, database.TimelinesTable.ID, func(queries *sqlc.Queries) (openapi.TimelineEntry, error) {
+		current, err := queries.GetTimeline(ctx, request.Id)
+		if err != nil {
+			return openapi.TimelineEntry{}, notFound(err)
+		}
+
+		old = mapTimeline(current)
+
+		timeline, err := queries.UpdateTimeline(ctx, sqlc.UpdateTimelineParams{
+			ID:      request.Id,
+			Message: request.Body.Message,
+			Time:    request.Body.Time,
+		})
+		if err != nil {
+			return openapi.TimelineEntry{}, err
+		}
+
+		return mapTimeline(timeline), nil
+	})
+	if err != nil {
+		return nil, err
 	}
 
-	s.hooks.OnRecordAfterUpdateRequest.Publish(ctx, database.TimelinesTable.ID, response)
+	s.hooks.OnRecordAfterUpdateRequest.Publish(ctx, database.TimelinesTable.ID, &hook.Update{Old: old, New: response})
 
 	return openapi.UpdateTimeline200JSONResponse(response), nil
 }
@@ -1102,41 +1485,59 @@ func (s *Service) ListTypes(ctx context.Context, request openapi.ListTypesReques
 }
 
 func (s *Service) CreateType(ctx context.Context, request openapi.CreateTypeRequestObject) (openapi.CreateTypeResponseObject, error) {
-	s.hooks.OnRecordBeforeCreateRequest.Publish(ctx, database.TypesTable.ID, request.Body)
+	if err := s.hooks.OnRecordBeforeCreateRequest.PublishWithError(ctx, database.TypesTable.ID, request.Body); err != nil {
+		return nil, err
+	}
+
+	response, err := changes.Write(ctx, s.queries, database.CreateAction, database.TypesTable.ID, func(queries *sqlc.Queries) (openapi.Type, error) {
+		t, err := queries.CreateType(ctx, sqlc.CreateTypeParams{
+			Icon:     request.Body.Icon,
+			Plural:   request.Body.Plural,
+			Singular: request.Body.Singular,
+			Schema:   marshal(request.Body.Schema),
+		})
+		if err != nil {
+			return openapi.Type{}, err
+		}
 
-	t, err := s.queries.CreateType(ctx, sqlc.CreateTypeParams{
-		Icon:     request.Body.Icon,
-		Plural:   request.Body.Plural,
-		Singular: request.Body.Singular,
-		Schema:   marshal(request.Body.Schema),
+		return openapi.Type{
+			Created:  t.Created,
+			Icon:     t.Icon,
+			Id:       t.ID,
+			Plural:   t.Plural,
+			Schema:   unmarshal(t.Schema),
+			Singular: t.Singular,
+			Updated:  t.Updated,
+		}, nil
 	})
 	if err != nil {
 		return nil, err
 	}
 
-	response := openapi.Type{
-		Created:  t.Created,
-		Icon:     t.Icon,
-		Id:       t.ID,
-		Plural:   t.Plural,
-		Schema:   unmarshal(t.Schema),
-		Singular: t.Singular,
-		Updated:  t.Updated,
-	}
-
 	s.hooks.OnRecordAfterCreateRequest.Publish(ctx, database.TypesTable.ID, response)
 
 	return openapi.CreateType200JSONResponse(response), nil
 }
 
 func (s *Service) DeleteType(ctx context.Context, request openapi.DeleteTypeRequestObject) (openapi.DeleteTypeResponseObject, error) {
-	s.hooks.OnRecordBeforeDeleteRequest.Publish(ctx, database.TypesTable.ID, request.Id)
+	found, err := s.queries.GetType(ctx, request.Id)
+	if err != nil {
+		return nil, notFound(err)
+	}
+
+	record := mapType(found)
 
-	if err := s.queries.DeleteType(ctx, request.Id); err != nil {
+	if err := s.hooks.OnRecordBeforeDeleteRequest.PublishWithError(ctx, database.TypesTable.ID, record); err != nil {
+		return nil, err
+	}
+
+	if _, err := changes.Write(ctx, s.queries, database.DeleteAction, database.TypesTable.ID, func(queries *sqlc.Queries) (any, error) {
+		return record, queries.DeleteType(ctx, request.Id)
+	}); err != nil {
 		return nil, fmt.Errorf("failed to delete type: %w", err)
 	}
 
-	s.hooks.OnRecordAfterDeleteRequest.Publish(ctx, database.TypesTable.ID, request.Id)
+	s.hooks.OnRecordAfterDeleteRequest.Publish(ctx, database.TypesTable.ID, record)
 
 	return openapi.DeleteType204Response{}, nil
 }
@@ -1163,30 +1564,43 @@ func (s *Service) GetType(ctx context.Context, request openapi.GetTypeRequestObj
 }
 
 func (s *Service) UpdateType(ctx context.Context, request openapi.UpdateTypeRequestObject) (openapi.UpdateTypeResponseObject, error) {
-	s.hooks.OnRecordBeforeUpdateRequest.Publish(ctx, database.TypesTable.ID, request.Body)
-
-	t, err := s.queries.UpdateType(ctx, sqlc.UpdateTypeParams{
-		ID:       request.Id,
-		Icon:     request.Body.Icon,
-		Plural:   request.Body.Plural,
-		Singular: request.Body.Singular,
-		Schema:   marshalPointer(request.Body.Schema),
-	})
+	found, err := s.queries.GetType(ctx, request.Id)
 	if err != nil {
+		return nil, notFound(err)
+	}
+
+	old := mapType(found)
+
+	if err := s.hooks.OnRecordBeforeUpdateRequest.PublishWithError(ctx, database.TypesTable.ID, &hook.Update{Old: old, New: request.Body}); err != nil {
 		return nil, err
 	}
 
-	response := openapi.Type{
-		Created:  t.Created,
-		Icon:     t.Icon,
-		Id:       t.ID,
-		Plural:   t.Plural,
-		Schema:   unmarshal(t.Schema),
-		Singular: t.Singular,
-		Updated:  t.Updated,
+	response, err := changes.Write(ctx, s.queries, database.UpdateAction, database.TypesTable.ID, func(queries *sqlc.Queries) (openapi.Type, error) {
+		current, err := queries.GetType(ctx, request.Id)
+		if err != nil {
+			return openapi.Type{}, notFound(err)
+		}
+
+		old = mapType(current)
+
+		t, err := queries.UpdateType(ctx, sqlc.UpdateTypeParams{
+			ID:       request.Id,
+			Icon:     request.Body.Icon,
+			Plural:   request.Body.Plural,
+			Singular: request.Body.Singular,
+			Schema:   marshalPointer(request.Body.Schema),
+		})
+		if err != nil {
+			return openapi.Type{}, err
+		}
+
+		return mapType(t), nil
+	})
+	if err != nil {
+		return nil, err
 	}
 
-	s.hooks.OnRecordAfterUpdateRequest.Publish(ctx, database.TypesTable.ID, response)
+	s.hooks.OnRecordAfterUpdateRequest.Publish(ctx, database.TypesTable.ID, &hook.Update{Old: old, New: response})
 
 	return openapi.UpdateType200JSONResponse(response), nil
 }
@@ -1232,37 +1646,44 @@ func (s *Service) ListUsers(ctx context.Context, request openapi.ListUsersReques
 }
 
 func (s *Service) CreateUser(ctx context.Context, request openapi.CreateUserRequestObject) (openapi.CreateUserResponseObject, error) {
-	s.hooks.OnRecordBeforeCreateRequest.Publish(ctx, database.UsersTable.ID, request.Body)
+	if err := s.hooks.OnRecordBeforeCreateRequest.PublishWithError(ctx, database.UsersTable.ID, request.Body); err != nil {
+		return nil, err
+	}
 
 	tokenKey, err := password.GenerateTokenKey()
 	if err != nil {
 		return nil, fmt.Errorf("failed to generate token key: %w", err)
 	}
 
-	user, err := s.queries.CreateUser(ctx, sqlc.CreateUserParams{
-		Name:         request.Body.Name,
-		Email:        request.Body.Email,
-		Username:     request.Body.Username,
-		PasswordHash: "",
-		TokenKey:     tokenKey,
-		Avatar:       request.Body.Avatar,
-		Active:       request.Body.Active,
-	})
-	if err != nil {
-		return nil, err
-	}
+	response, err := changes.Write(ctx, s.queries, database.CreateAction, database.UsersTable.ID, func(queries *sqlc.Queries) (openapi.User, error) {
+		user, err := queries.CreateUser(ctx, sqlc.CreateUserParams{
+			Name:         request.Body.Name,
+			Email:        request.Body.Email,
+			Username:     request.Body.Username,
+			PasswordHash: "",
+			TokenKey:     tokenKey,
+			Avatar:       request.Body.Avatar,
+			Active:       request.Body.Active,
+		})
+		if err != nil {
+			return openapi.User{}, err
+		}
 
-	response := openapi.User{
-		Avatar:                 user.Avatar,
-		Created:                user.Created,
-		Email:                  user.Email,
-		Id:                     user.ID,
-		LastResetSentAt:        user.Lastresetsentat,
-		LastVerificationSentAt: user.Lastverificationsentat,
-		Name:                   user.Name,
-		Updated:                user.Updated,
-		Username:               user.Username,
-		Active:                 user.Active,
+		return openapi.User{
+			Avatar:                 user.Avatar,
+			Created:                user.Created,
+			Email:                  user.Email,
+			Id:                     user.ID,
+			LastResetSentAt:        user.Lastresetsentat,
+			LastVerificationSentAt: user.Lastverificationsentat,
+			Name:                   user.Name,
+			Updated:                user.Updated,
+			Username:               user.Username,
+			Active:                 user.Active,
+		}, nil
+	})
+	if err != nil {
+		return nil, err
 	}
 
 	s.hooks.OnRecordAfterCreateRequest.Publish(ctx, database.UsersTable.ID, response)
@@ -1271,14 +1692,24 @@ func (s *Service) CreateUser(ctx context.Context, request openapi.CreateUserRequ
 }
 
 func (s *Service) DeleteUser(ctx context.Context, request openapi.DeleteUserRequestObject) (openapi.DeleteUserResponseObject, error) {
-	s.hooks.OnRecordBeforeDeleteRequest.Publish(ctx, database.UsersTable.ID, request.Id)
-
-	err := s.queries.DeleteUser(ctx, request.Id)
+	found, err := s.queries.GetUser(ctx, request.Id)
 	if err != nil {
+		return nil, notFound(err)
+	}
+
+	record := mapUser(found)
+
+	if err := s.hooks.OnRecordBeforeDeleteRequest.PublishWithError(ctx, database.UsersTable.ID, record); err != nil {
+		return nil, err
+	}
+
+	if _, err := changes.Write(ctx, s.queries, database.DeleteAction, database.UsersTable.ID, func(queries *sqlc.Queries) (any, error) {
+		return record, queries.DeleteUser(ctx, request.Id)
+	}); err != nil {
 		return nil, err
 	}
 
-	s.hooks.OnRecordAfterDeleteRequest.Publish(ctx, database.UsersTable.ID, request.Id)
+	s.hooks.OnRecordAfterDeleteRequest.Publish(ctx, database.UsersTable.ID, record)
 
 	return openapi.DeleteUser204Response{}, nil
 }
@@ -1308,7 +1739,22 @@ func (s *Service) GetUser(ctx context.Context, request openapi.GetUserRequestObj
 }
 
 func (s *Service) UpdateUser(ctx context.Context, request openapi.UpdateUserRequestObject) (openapi.UpdateUserResponseObject, error) {
-	s.hooks.OnRecordBeforeUpdateRequest.Publish(ctx, database.UsersTable.ID, request.Body)
+	found, err := s.queries.GetUser(ctx, request.Id)
+	if err != nil {
+		return nil, notFound(err)
+	}
+
+	old := mapUser(found)
+
+	// the password is not passed to the hooks, so reactions do not see or
+	// store it in their runs
+	update := *request.Body
+	update.Password = nil
+	update.PasswordConfirm = nil
+
+	if err := s.hooks.OnRecordBeforeUpdateRequest.PublishWithError(ctx, database.UsersTable.ID, &hook.Update{Old: old, New: &update}); err != nil {
+		return nil, err
+	}
 
 	var passwordHash, tokenHash *string
 
@@ -1330,34 +1776,42 @@ func (s *Service) UpdateUser(ctx context.Context, request openapi.UpdateUserRequ
 		return nil, errors.New("password and password confirm must be provided together")
 	}
 
-	user, err := s.queries.UpdateUser(ctx, sqlc.UpdateUserParams{
-		Name:         request.Body.Name,
-		Email:        request.Body.Email,
-		Username:     request.Body.Username,
-		PasswordHash: passwordHash,
-		TokenKey:     tokenHash,
-		Avatar:       request.Body.Avatar,
-		Active:       request.Body.Active,
-		ID:           request.Id,
+	response, err := changes.Write(ctx, s.queries, database.UpdateAction, database.UsersTable.ID, func(queries *sqlc.Queries) (openapi.User, error) {
+		current, err := queries.GetUser(ctx, request.Id)
+		if err != nil {
+			return openapi.User{}, notFound(err)
+		}
+
+		old = mapUser(current)
+
+		user, err := queries.UpdateUser(ctx, sqlc.UpdateUserParams{
+			Name:         request.Body.Name,
+			Email:        request.Body.Email,
+			Username:     request.Body.Username,
+			PasswordHash: passwordHash,
+			TokenKey:     tokenHash,
+			Avatar:       request.Body.Avatar,
+			Active:       request.Body.Active,
+			ID:           request.Id,
+		})
+		if err != nil {
+			return openapi.User{}, err
+		}
+
+		// deactivated users and changed passwords end all sessions
+		if !user.Active || tokenHash != nil {
+			if err := auth.RevokeSessions(ctx, queries, user.ID); err != nil {
+				return openapi.User{}, err
+			}
+		}
+
+		return mapUser(user), nil
 	})
 	if err != nil {
 		return nil, err
 	}
 
-	response := openapi.User{
-		Avatar:                 user.Avatar,
-		Created:                user.Created,
-		Email:                  user.Email,
-		Id:                     user.ID,
-		LastResetSentAt:        user.Lastresetsentat,
-		LastVerificationSentAt: user.Lastverificationsentat,
-		Name:                   user.Name,
-		Updated:                user.Updated,
-		Username:               user.Username,
-		Active:                 user.Active,
-	}
-
-	s.hooks.OnRecordAfterUpdateRequest.Publish(ctx, "users", response)
+	s.hooks.OnRecordAfterUpdateRequest.Publish(ctx, "users", &hook.Update{Old: old, New: response})
 
 	return openapi.UpdateUser200JSONResponse(response), nil
 }
@@ -1399,42 +1853,59 @@ func (s *Service) ListGroups(ctx context.Context, request openapi.ListGroupsRequ
 }
 
 func (s *Service) CreateGroup(ctx context.Context, request openapi.CreateGroupRequestObject) (openapi.CreateGroupResponseObject, error) {
-	s.hooks.OnRecordBeforeCreateRequest.Publish(ctx, database.GroupsTable.ID, request.Body)
+	if err := s.hooks.OnRecordBeforeCreateRequest.PublishWithError(ctx, database.GroupsTable.ID, request.Body); err != nil {
+		return nil, err
+	}
+
+	response, err := changes.Write(ctx, s.queries, database.CreateAction, database.GroupsTable.ID, func(queries *sqlc.Queries) (openapi.Group, error) {
+		group, err := queries.CreateGroup(ctx, sqlc.CreateGroupParams{
+			Name:        request.Body.Name,
+			Permissions: auth.ToJSONArray(ctx, request.Body.Permissions),
+		})
+		if err != nil {
+			return openapi.Group{}, err
+		}
 
-	group, err := s.queries.CreateGroup(ctx, sqlc.CreateGroupParams{
-		Name:        request.Body.Name,
-		Permissions: auth.ToJSONArray(ctx, request.Body.Permissions),
+		return openapi.Group{
+			Created:     group.Created,
+			Id:          group.ID,
+			Name:        group.Name,
+			Permissions: auth.FromJSONArray(ctx, group.Permissions),
+			Updated:     group.Updated,
+		}, nil
 	})
 	if err != nil {
 		return nil, err
 	}
 
-	response := openapi.Group{
-		Created:     group.Created,
-		Id:          group.ID,
-		Name:        group.Name,
-		Permissions: auth.FromJSONArray(ctx, group.Permissions),
-		Updated:     group.Updated,
-	}
-
 	s.hooks.OnRecordAfterCreateRequest.Publish(ctx, database.GroupsTable.ID, response)
 
 	return openapi.CreateGroup200JSONResponse(response), nil
 }
 
 func (s *Service) DeleteGroup(ctx context.Context, request openapi.DeleteGroupRequestObject) (openapi.DeleteGroupResponseObject, error) {
-	s.hooks.OnRecordBeforeDeleteRequest.Publish(ctx, database.GroupsTable.ID, request.Id)
-
 	if request.Id == "admin" {
-		return nil, errors.New("cannot delete the admin group")
+		return nil, forbidden(errors.New("cannot delete the admin group"))
 	}
 
-	err := s.queries.DeleteGroup(ctx, request.Id)
+	found, err := s.queries.GetGroup(ctx, request.Id)
 	if err != nil {
+		return nil, notFound(err)
+	}
+
+	record := mapGroup(ctx, found)
+
+	if err := s.hooks.OnRecordBeforeDeleteRequest.PublishWithError(ctx, database.GroupsTable.ID, record); err != nil {
+		return nil, err
+	}
+
+	if _, err := changes.Write(ctx, s.queries, database.DeleteAction, database.GroupsTable.ID, func(queries *sqlc.Queries) (any, error) {
+		return record, queries.DeleteGroup(ctx, request.Id)
+	}); err != nil {
 		return nil, err
 	}
 
-	s.hooks.OnRecordAfterDeleteRequest.Publish(ctx, database.GroupsTable.ID, request.Id)
+	s.hooks.OnRecordAfterDeleteRequest.Publish(ctx, database.GroupsTable.ID, record)
 
 	return openapi.DeleteGroup204Response{}, nil
 }
@@ -1459,10 +1930,19 @@ func (s *Service) GetGroup(ctx context.Context, request openapi.GetGroupRequestO
 }
 
 func (s *Service) UpdateGroup(ctx context.Context, request openapi.UpdateGroupRequestObject) (openapi.UpdateGroupResponseObject, error) {
-	s.hooks.OnRecordBeforeUpdateRequest.Publish(ctx, database.GroupsTable.ID, request.Body)
-
 	if request.Id == "admin" {
-		return nil, errors.New("cannot update the admin group")
+		return nil, forbidden(errors.New("cannot update the admin group"))
+	}
+
+	found, err := s.queries.GetGroup(ctx, request.Id)
+	if err != nil {
+		return nil, notFound(err)
+	}
+
+	old := mapGroup(ctx, found)
+
+	if err := s.hooks.OnRecordBeforeUpdateRequest.PublishWithError(ctx, database.GroupsTable.ID, &hook.Update{Old: old, New: request.Body}); err != nil {
+		return nil, err
 	}
 
 	var permissions *string
@@ -1472,88 +1952,138 @@ func (s *Service) UpdateGroup(ctx context.Context, request openapi.UpdateGroupRe
 		permissions = &p
 	}
 
-	group, err := s.queries.UpdateGroup(ctx, sqlc.UpdateGroupParams{
-		Name:        request.Body.Name,
-		Permissions: permissions,
-		ID:          request.Id,
+	response, err := changes.Write(ctx, s.queries, database.UpdateAction, database.GroupsTable.ID, func(queries *sqlc.Queries) (openapi.Group, error) {
+		current, err := queries.GetGroup(ctx, request.Id)
+		if err != nil {
+			return openapi.Group{}, notFound(err)
+		}
+
+		old = mapGroup(ctx, current)
+
+		group, err := queries.UpdateGroup(ctx, sqlc.UpdateGroupParams{
+			Name:        request.Body.Name,
+			Permissions: permissions,
+			ID:          request.Id,
+		})
+		if err != nil {
+			return openapi.Group{}, err
+		}
+
+		return mapGroup(ctx, group), nil
 	})
 	if err != nil {
 		return nil, err
 	}
 
-	response := openapi.Group{
-		Created:     group.Created,
-		Id:          group.ID,
-		Name:        group.Name,
-		Permissions: auth.FromJSONArray(ctx, group.Permissions),
-		Updated:     group.Updated,
-	}
-
-	s.hooks.OnRecordAfterUpdateRequest.Publish(ctx, database.GroupsTable.ID, response)
+	s.hooks.OnRecordAfterUpdateRequest.Publish(ctx, database.GroupsTable.ID, &hook.Update{Old: old, New: response})
 
 	return openapi.UpdateGroup200JSONResponse(response), nil
 }
 
+// groupParent is the record of a group parent relation in hooks and the
+// change feed.
+type groupParent struct {
+	ID     string `json:"id"`
+	Child  string `json:"child"`
+	Parent string `json:"parent"`
+}
+
+func newGroupParent(child, parent string) groupParent {
+	return groupParent{ID: child + ":" + parent, Child: child, Parent: parent}
+}
+
+// userGroup is the record of a group membership in hooks and the change
+// feed.
+type userGroup struct {
+	ID    string `json:"id"`
+	User  string `json:"user"`
+	Group string `json:"group"`
+}
+
+func newUserGroup(user, group string) userGroup {
+	return userGroup{ID: user + ":" + group, User: user, Group: group}
+}
+
 func (s *Service) AddGroupParent(ctx context.Context, request openapi.AddGroupParentRequestObject) (openapi.AddGroupParentResponseObject, error) {
-	s.hooks.OnRecordBeforeCreateRequest.Publish(ctx, database.GroupParentTable.ID, request.Body)
+	record := newGroupParent(request.Id, request.Body.GroupId)
 
-	err := s.queries.AssignParentGroup(ctx, sqlc.AssignParentGroupParams{
-		ChildGroupID:  request.Id,
-		ParentGroupID: request.Body.GroupId,
-	})
-	if err != nil {
+	if err := s.hooks.OnRecordBeforeCreateRequest.PublishWithError(ctx, database.GroupParentTable.ID, record); err != nil {
+		return nil, err
+	}
+
+	if _, err := changes.Write(ctx, s.queries, database.CreateAction, database.GroupParentTable.ID, func(queries *sqlc.Queries) (any, error) {
+		return record, queries.AssignParentGroup(ctx, sqlc.AssignParentGroupParams{
+			ChildGroupID:  record.Child,
+			ParentGroupID: record.Parent,
+		})
+	}); err != nil {
 		return nil, err
 	}
 
-	s.hooks.OnRecordAfterCreateRequest.Publish(ctx, database.GroupParentTable.ID, request.Body)
+	s.hooks.OnRecordAfterCreateRequest.Publish(ctx, database.GroupParentTable.ID, record)
 
 	return openapi.AddGroupParent201Response{}, nil
 }
 
 func (s *Service) RemoveGroupParent(ctx context.Context, request openapi.RemoveGroupParentRequestObject) (openapi.RemoveGroupParentResponseObject, error) {
-	s.hooks.OnRecordBeforeDeleteRequest.Publish(ctx, database.GroupParentTable.ID, request.Id)
+	record := newGroupParent(request.Id, request.ParentGroupId)
 
-	err := s.queries.RemoveParentGroup(ctx, sqlc.RemoveParentGroupParams{
-		ChildGroupID:  request.Id,
-		ParentGroupID: request.ParentGroupId,
-	})
-	if err != nil {
+	if err := s.hooks.OnRecordBeforeDeleteRequest.PublishWithError(ctx, database.GroupParentTable.ID, record); err != nil {
+		return nil, err
+	}
+
+	if _, err := changes.Write(ctx, s.queries, database.DeleteAction, database.GroupParentTable.ID, func(queries *sqlc.Queries) (any, error) {
+		return record, queries.RemoveParentGroup(ctx, sqlc.RemoveParentGroupParams{
+			ChildGroupID:  record.Child,
+			ParentGroupID: record.Parent,
+		})
+	}); err != nil {
 		return nil, err
 	}
 
-	s.hooks.OnRecordAfterDeleteRequest.Publish(ctx, database.GroupParentTable.ID, request.Id)
+	s.hooks.OnRecordAfterDeleteRequest.Publish(ctx, database.GroupParentTable.ID, record)
 
 	return openapi.RemoveGroupParent204Response{}, nil
 }
 
 func (s *Service) AddUserGroup(ctx context.Context, request openapi.AddUserGroupRequestObject) (openapi.AddUserGroupResponseObject, error) {
-	s.hooks.OnRecordBeforeCreateRequest.Publish(ctx, database.UserGroupTable.ID, request.Body)
+	record := newUserGroup(request.Id, request.Body.GroupId)
 
-	err := s.queries.AssignGroupToUser(ctx, sqlc.AssignGroupToUserParams{
-		UserID:  request.Id,
-		GroupID: request.Body.GroupId,
-	})
-	if err != nil {
+	if err := s.hooks.OnRecordBeforeCreateRequest.PublishWithError(ctx, database.UserGroupTable.ID, record); err != nil {
+		return nil, err
+	}
+
+	if _, err := changes.Write(ctx, s.queries, database.CreateAction, database.UserGroupTable.ID, func(queries *sqlc.Queries) (any, error) {
+		return record, queries.AssignGroupToUser(ctx, sqlc.AssignGroupToUserParams{
+			UserID:  record.User,
+			GroupID: record.Group,
+		})
+	}); err != nil {
 		return nil, err
 	}
 
-	s.hooks.OnRecordAfterCreateRequest.Publish(ctx, database.UserGroupTable.ID, request.Body)
+	s.hooks.OnRecordAfterCreateRequest.Publish(ctx, database.UserGroupTable.ID, record)
 
 	return openapi.AddUserGroup201Response{}, nil
 }
 
 func (s *Service) RemoveUserGroup(ctx context.Context, request openapi.RemoveUserGroupRequestObject) (openapi.RemoveUserGroupResponseObject, error) {
-	s.hooks.OnRecordBeforeDeleteRequest.Publish(ctx, database.UserGroupTable.ID, request.Id)
+	record := newUserGroup(request.Id, request.GroupId)
 
-	err := s.queries.RemoveGroupFromUser(ctx, sqlc.RemoveGroupFromUserParams{
-		UserID:  request.Id,
-		GroupID: request.GroupId,
-	})
-	if err != nil {
+	if err := s.hooks.OnRecordBeforeDeleteRequest.PublishWithError(ctx, database.UserGroupTable.ID, record); err != nil {
+		return nil, err
+	}
+
+	if _, err := changes.Write(ctx, s.queries, database.DeleteAction, database.UserGroupTable.ID, func(queries *sqlc.Queries) (any, error) {
+		return record, queries.RemoveGroupFromUser(ctx, sqlc.RemoveGroupFromUserParams{
+			UserID:  record.User,
+			GroupID: record.Group,
+		})
+	}); err != nil {
 		return nil, err
 	}
 
-	s.hooks.OnRecordAfterDeleteRequest.Publish(ctx, database.UserGroupTable.ID, request.Id)
+	s.hooks.OnRecordAfterDeleteRequest.Publish(ctx, database.UserGroupTable.ID, record)
 
 	return openapi.RemoveUserGroup204Response{}, nil
 }
@@ -1569,6 +2099,231 @@ func (s *Service) ListUserPermissions(ctx context.Context, request openapi.ListU
 	return openapi.ListUserPermissions200JSONResponse(permissions), nil
 }
 
+func (s *Service) ListApiTokens(ctx context.Context, request openapi.ListApiTokensRequestObject) (openapi.ListApiTokensResponseObject, error) {
+	user, ok := usercontext.UserFromContext(ctx)
+	if !ok {
+		return nil, errors.New("missing user")
+	}
+
+	tokens, totalCount, err := s.listAPITokens(ctx, user.ID, request.Params.Offset, request.Params.Limit)
+	if err != nil {
+		return nil, err
+	}
+
+	return openapi.ListApiTokens200JSONResponse{
+		Body: tokens,
+		Headers: openapi.ListApiTokens200ResponseHeaders{
+			XTotalCount: totalCount,
+		},
+	}, nil
+}
+
+func (s *Service) CreateApiToken(ctx context.Context, request openapi.CreateApiTokenRequestObject) (openapi.CreateApiTokenResponseObject, error) {
+	user, ok := usercontext.UserFromContext(ctx)
+	if !ok {
+		return nil, errors.New("missing user")
+	}
+
+	token, err := s.createAPIToken(ctx, user.ID, request.Body)
+	if err != nil {
+		return nil, err
+	}
+
+	return openapi.CreateApiToken200JSONResponse(token), nil
+}
+
+func (s *Service) DeleteApiToken(ctx context.Context, request openapi.DeleteApiTokenRequestObject) (openapi.DeleteApiTokenResponseObject, error) {
+	user, ok := usercontext.UserFromContext(ctx)
+	if !ok {
+		return nil, errors.New("missing user")
+	}
+
+	if _, err := s.queries.DeleteAPIToken(ctx, sqlc.DeleteAPITokenParams{ID: request.Id, User: user.ID}); err != nil {
+		return nil, notFound(err)
+	}
+
+	return openapi.DeleteApiToken204Response{}, nil
+}
+
+func (s *Service) ListUserApiTokens(ctx context.Context, request openapi.ListUserApiTokensRequestObject) (openapi.ListUserApiTokensResponseObject, error) {
+	tokens, totalCount, err := s.listAPITokens(ctx, request.Id, request.Params.Offset, request.Params.Limit)
+	if err != nil {
+		return nil, err
+	}
+
+	return openapi.ListUserApiTokens200JSONResponse{
+		Body: tokens,
+		Headers: openapi.ListUserApiTokens200ResponseHeaders{
+			XTotalCount: totalCount,
+		},
+	}, nil
+}
+
+func (s *Service) CreateUserApiToken(ctx context.Context, request openapi.CreateUserApiTokenRequestObject) (openapi.CreateUserApiTokenResponseObject, error) {
+	token, err := s.createAPIToken(ctx, request.Id, request.Body)
+	if err != nil {
+		return nil, err
+	}
+
+	return openapi.CreateUserApiToken200JSONResponse(token), nil
+}
+
+func (s *Service) DeleteUserApiToken(ctx context.Context, request openapi.DeleteUserApiTokenRequestObject) (openapi.DeleteUserApiTokenResponseObject, error) {
+	if _, err := s.queries.DeleteAPIToken(ctx, sqlc.DeleteAPITokenParams{ID: request.TokenId, User: request.Id}); err != nil {
+		return nil, notFound(err)
+	}
+
+	return openapi.DeleteUserApiToken204Response{}, nil
+}
+
+func (s *Service) listAPITokens(ctx context.Context, userID string, offset, limit *int) ([]openapi.ApiToken, int, error) {
+	tokens, err := s.queries.ListAPITokens(ctx, sqlc.ListAPITokensParams{
+		User:   userID,
+		Offset: toInt64(offset, defaultOffset),
+		Limit:  toInt64(limit, defaultLimit),
+	})
+	if err != nil {
+		return nil, 0, err
+	}
+
+	response := make([]openapi.ApiToken, 0, len(tokens))
+	for _, token := range tokens {
+		response = append(response, mapAPIToken(ctx, sqlc.ApiToken{
+			ID:         token.ID,
+			User:       token.User,
+			Name:       token.Name,
+			Prefix:     token.Prefix,
+			Scopes:     token.Scopes,
+			Expires:    token.Expires,
+			LastUsed:   token.LastUsed,
+			LastUsedIp: token.LastUsedIp,
+			Created:    token.Created,
+		}))
+	}
+
+	totalCount := 0
+	if len(tokens) > 0 {
+		totalCount = int(tokens[0].TotalCount)
+	}
+
+	return response, totalCount, nil
+}
+
+func (s *Service) createAPIToken(ctx context.Context, userID string, body *openapi.NewApiToken) (openapi.ApiToken, error) {
+	apiToken, token, err := auth.CreateAPIToken(ctx, s.queries, userID, body.Name, body.Scopes, body.Expires)
+	if err != nil {
+		switch {
+		case errors.Is(err, auth.ErrAPITokenCreation):
+			return openapi.ApiToken{}, forbidden(err)
+		case errors.Is(err, auth.ErrInvalidAPIToken):
+			return openapi.ApiToken{}, badRequest(err)
+		}
+
+		return openapi.ApiToken{}, err
+	}
+
+	response := mapAPIToken(ctx, *apiToken)
+	response.Token = &token
+
+	return response, nil
+}
+
+func (s *Service) ListSessions(ctx context.Context, request openapi.ListSessionsRequestObject) (openapi.ListSessionsResponseObject, error) {
+	user, ok := usercontext.UserFromContext(ctx)
+	if !ok {
+		return nil, errors.New("missing user")
+	}
+
+	sessions, totalCount, err := s.listSessions(ctx, user.ID, request.Params.Offset, request.Params.Limit)
+	if err != nil {
+		return nil, err
+	}
+
+	return openapi.ListSessions200JSONResponse{
+		Body: sessions,
+		Headers: openapi.ListSessions200ResponseHeaders{
+			XTotalCount: totalCount,
+		},
+	}, nil
+}
+
+func (s *Service) DeleteSession(ctx context.Context, request openapi.DeleteSessionRequestObject) (openapi.DeleteSessionResponseObject, error) {
+	user, ok := usercontext.UserFromContext(ctx)
+	if !ok {
+		return nil, errors.New("missing user")
+	}
+
+	if _, err := s.queries.DeleteSession(ctx, sqlc.DeleteSessionParams{ID: request.Id, User: user.ID}); err != nil {
+		return nil, notFound(err)
+	}
+
+	return openapi.DeleteSession204Response{}, nil
+}
+
+func (s *Service) ListUserSessions(ctx context.Context, request openapi.ListUserSessionsRequestObject) (openapi.ListUserSessionsResponseObject, error) {
+	sessions, totalCount, err := s.listSessions(ctx, request.Id, request.Params.Offset, request.Params.Limit)
+	if err != nil {
+		return nil, err
+	}
+
+	return openapi.ListUserSessions200JSONResponse{
+		Body: sessions,
+		Headers: openapi.ListUserSessions200ResponseHeaders{
+			XTotalCount: totalCount,
+		},
+	}, nil
+}
+
+func (s *Service) DeleteUserSessions(ctx context.Context, request openapi.DeleteUserSessionsRequestObject) (openapi.DeleteUserSessionsResponseObject, error) {
+	if err := auth.RevokeSessions(ctx, s.queries, request.Id); err != nil {
+		return nil, err
+	}
+
+	return openapi.DeleteUserSessions204Response{}, nil
+}
+
+func (s *Service) DeleteUserSession(ctx context.Context, request openapi.DeleteUserSessionRequestObject) (openapi.DeleteUserSessionResponseObject, error) {
+	if _, err := s.queries.DeleteSession(ctx, sqlc.DeleteSessionParams{ID: request.SessionId, User: request.Id}); err != nil {
+		return nil, notFound(err)
+	}
+
+	return openapi.DeleteUserSession204Response{}, nil
+}
+
+func (s *Service) listSessions(ctx context.Context, userID string, offset, limit *int) ([]openapi.Session, int, error) {
+	sessions, err := s.queries.ListSessions(ctx, sqlc.ListSessionsParams{
+		User:   userID,
+		Now:    time.Now().UTC(),
+		Offset: toInt64(offset, defaultOffset),
+		Limit:  toInt64(limit, defaultLimit),
+	})
+	if err != nil {
+		return nil, 0, err
+	}
+
+	current, _ := usercontext.SessionFromContext(ctx)
+
+	response := make([]openapi.Session, 0, len(sessions))
+	for _, session := range sessions {
+		response = append(response, mapSession(sqlc.Session{
+			ID:        session.ID,
+			User:      session.User,
+			UserAgent: session.UserAgent,
+			Ip:        session.Ip,
+			Expires:   session.Expires,
+			LastSeen:  session.LastSeen,
+			Created:   session.Created,
+		}, current))
+	}
+
+	totalCount := 0
+	if len(sessions) > 0 {
+		totalCount = int(sessions[0].TotalCount)
+	}
+
+	return response, totalCount, nil
+}
+
 func (s *Service) ListUserGroups(ctx context.Context, request openapi.ListUserGroupsRequestObject) (openapi.ListUserGroupsResponseObject, error) {
 	groups, err := s.queries.ListUserGroups(ctx, request.Id)
 	if err != nil {
@@ -1710,40 +2465,100 @@ func (s *Service) ListWebhooks(ctx context.Context, request openapi.ListWebhooks
 }
 
 func (s *Service) CreateWebhook(ctx context.Context, request openapi.CreateWebhookRequestObject) (openapi.CreateWebhookResponseObject, error) {
-	s.hooks.OnRecordBeforeCreateRequest.Publish(ctx, database.WebhooksTable.ID, request.Body)
+	events := pointer.Dereference(request.Body.Events)
+	filter := pointer.Dereference(request.Body.Filter)
 
-	webhook, err := s.queries.CreateWebhook(ctx, sqlc.CreateWebhookParams{
-		Name:        request.Body.Name,
-		Destination: request.Body.Destination,
-		Collection:  request.Body.Collection,
-	})
-	if err != nil {
-		return nil, err
+	format := pointer.Dereference(request.Body.Format)
+	if format == "" {
+		format = cloudevents.FormatCatalyst
 	}
 
-	response := openapi.Webhook{
-		Id:          webhook.ID,
-		Name:        webhook.Name,
-		Created:     webhook.Created,
-		Updated:     webhook.Updated,
-		Destination: webhook.Destination,
-		Collection:  webhook.Collection,
+	// the destination is required, so an empty one is invalid
+	if request.Body.Destination == "" {
+		return nil, badRequest(errors.New("destination is required"))
+	}
+
+	if err := webhook.Validate(request.Body.Destination, pointer.Dereference(request.Body.Headers), events, filter, format); err != nil {
+		return nil, badRequest(err)
+	}
+
+	// the header values usually contain credentials, so they are
+	// redacted like in the published records
+	body := *request.Body
+	if body.Headers != nil {
+		body.Headers = pointer.Pointer(redactHeaders(*body.Headers))
+	}
+
+	if err := s.hooks.OnRecordBeforeCreateRequest.PublishWithError(ctx, database.WebhooksTable.ID, &body); err != nil {
+		return nil, err
+	}
+
+	secret, err := webhook.GenerateSecret()
+	if err != nil {
+		return nil, fmt.Errorf("failed to generate secret: %w", err)
+	}
+
+	headers := []byte("{}")
+
+	if request.Body.Headers != nil {
+		if headers, err = json.Marshal(*request.Body.Headers); err != nil {
+			return nil, err
+		}
+	}
+
+	var created sqlc.Webhook
+
+	// the secret is only returned to the caller and not published, so it
+	// is not persisted in the change feed
+	response, err := changes.Write(ctx, s.queries, database.CreateAction, database.WebhooksTable.ID, func(queries *sqlc.Queries) (openapi.Webhook, error) {
+		var err error
+
+		created, err = queries.CreateWebhook(ctx, sqlc.CreateWebhookParams{
+			Name:        request.Body.Name,
+			Destination: request.Body.Destination,
+			Collection:  request.Body.Collection,
+			Events:      auth.ToJSONArray(ctx, events),
+			Filter:      filter,
+			Secret:      secret,
+			Headers:     string(headers),
+			Format:      format,
+		})
+		if err != nil {
+			return openapi.Webhook{}, err
+		}
+
+		return mapWebhook(ctx, created), nil
+	})
+	if err != nil {
+		return nil, err
 	}
 
 	s.hooks.OnRecordAfterCreateRequest.Publish(ctx, database.WebhooksTable.ID, response)
 
+	response.Secret = &created.Secret
+
 	return openapi.CreateWebhook200JSONResponse(response), nil
 }
 
 func (s *Service) DeleteWebhook(ctx context.Context, request openapi.DeleteWebhookRequestObject) (openapi.DeleteWebhookResponseObject, error) {
-	s.hooks.OnRecordBeforeDeleteRequest.Publish(ctx, database.WebhooksTable.ID, request.Id)
-
-	err := s.queries.DeleteWebhook(ctx, request.Id)
+	found, err := s.queries.GetWebhook(ctx, request.Id)
 	if err != nil {
+		return nil, notFound(err)
+	}
+
+	record := mapWebhook(ctx, found)
+
+	if err := s.hooks.OnRecordBeforeDeleteRequest.PublishWithError(ctx, database.WebhooksTable.ID, record); err != nil {
+		return nil, err
+	}
+
+	if _, err := changes.Write(ctx, s.queries, database.DeleteAction, database.WebhooksTable.ID, func(queries *sqlc.Queries) (any, error) {
+		return record, queries.DeleteWebhook(ctx, request.Id)
+	}); err != nil {
 		return nil, err
 	}
 
-	s.hooks.OnRecordAfterDeleteRequest.Publish(ctx, database.WebhooksTable.ID, request.Id)
+	s.hooks.OnRecordAfterDeleteRequest.Publish(ctx, database.WebhooksTable.ID, record)
 
 	return openapi.DeleteWebhook204Response{}, nil
 }
@@ -1754,14 +2569,7 @@ func (s *Service) GetWebhook(ctx context.Context, request openapi.GetWebhookRequ
 		return nil, err
 	}
 
-	response := openapi.Webhook{
-		Id:          webhook.ID,
-		Name:        webhook.Name,
-		Created:     webhook.Created,
-		Updated:     webhook.Updated,
-		Destination: webhook.Destination,
-		Collection:  webhook.Collection,
-	}
+	response := mapWebhook(ctx, webhook)
 
 	s.hooks.OnRecordViewRequest.Publish(ctx, database.WebhooksTable.ID, response)
 
@@ -1769,30 +2577,342 @@ func (s *Service) GetWebhook(ctx context.Context, request openapi.GetWebhookRequ
 }
 
 func (s *Service) UpdateWebhook(ctx context.Context, request openapi.UpdateWebhookRequestObject) (openapi.UpdateWebhookResponseObject, error) {
-	s.hooks.OnRecordBeforeUpdateRequest.Publish(ctx, database.WebhooksTable.ID, request.Body)
+	found, err := s.queries.GetWebhook(ctx, request.Id)
+	if err != nil {
+		return nil, notFound(err)
+	}
+
+	old := mapWebhook(ctx, found)
+
+	if request.Body.Destination != nil && *request.Body.Destination == "" {
+		return nil, badRequest(errors.New("destination is required"))
+	}
+
+	if err := webhook.Validate(pointer.Dereference(request.Body.Destination), pointer.Dereference(request.Body.Headers), pointer.Dereference(request.Body.Events), pointer.Dereference(request.Body.Filter), pointer.Dereference(request.Body.Format)); err != nil {
+		return nil, badRequest(err)
+	}
+
+	body := *request.Body
+	if body.Headers != nil {
+		body.Headers = pointer.Pointer(redactHeaders(*body.Headers))
+	}
+
+	if err := s.hooks.OnRecordBeforeUpdateRequest.PublishWithError(ctx, database.WebhooksTable.ID, &hook.Update{Old: old, New: &body}); err != nil {
+		return nil, err
+	}
+
+	var events *string
 
-	webhook, err := s.queries.UpdateWebhook(ctx, sqlc.UpdateWebhookParams{
-		ID:          request.Id,
-		Name:        request.Body.Name,
-		Destination: request.Body.Destination,
-		Collection:  request.Body.Collection,
+	if request.Body.Events != nil {
+		e := auth.ToJSONArray(ctx, *request.Body.Events)
+		events = &e
+	}
+
+	response, err := changes.Write(ctx, s.queries, database.UpdateAction, database.WebhooksTable.ID, func(queries *sqlc.Queries) (openapi.Webhook, error) {
+		current, err := queries.GetWebhook(ctx, request.Id)
+		if err != nil {
+			return openapi.Webhook{}, notFound(err)
+		}
+
+		old = mapWebhook(ctx, current)
+
+		var headers *string
+
+		if request.Body.Headers != nil {
+			// redacted values are sent back unchanged by clients that
+			// update a fetched webhook, so they keep the stored value
+			stored := unmarshalHeaders(ctx, current.Headers)
+			for key, value := range *request.Body.Headers {
+				if value == redactedHeader {
+					(*request.Body.Headers)[key] = stored[key]
+				}
+			}
+
+			b, err := json.Marshal(*request.Body.Headers)
+			if err != nil {
+				return openapi.Webhook{}, err
+			}
+
+			headers = pointer.Pointer(string(b))
+		}
+
+		updated, err := queries.UpdateWebhook(ctx, sqlc.UpdateWebhookParams{
+			ID:          request.Id,
+			Name:        request.Body.Name,
+			Destination: request.Body.Destination,
+			Collection:  request.Body.Collection,
+			Events:      events,
+			Filter:      request.Body.Filter,
+			Headers:     headers,
+			Format:      request.Body.Format,
+		})
+		if err != nil {
+			return openapi.Webhook{}, err
+		}
+
+		return mapWebhook(ctx, updated), nil
 	})
 	if err != nil {
 		return nil, err
 	}
 
-	response := openapi.Webhook{
-		Id:          webhook.ID,
-		Name:        webhook.Name,
-		Created:     webhook.Created,
-		Updated:     webhook.Updated,
-		Destination: webhook.Destination,
-		Collection:  webhook.Collection,
+	s.hooks.OnRecordAfterUpdateRequest.Publish(ctx, database.WebhooksTable.ID, &hook.Update{Old: old, New: response})
+
+	return openapi.UpdateWebhook200JSONResponse(response), nil
+}
+
+// RotateWebhookSecret replaces the secret of a webhook. Deliveries are
+// signed with the new secret immediately. The secret is not part of the
+// webhook records, so hooks and changes see an update without changed fields.
+func (s *Service) RotateWebhookSecret(ctx context.Context, request openapi.RotateWebhookSecretRequestObject) (openapi.RotateWebhookSecretResponseObject, error) {
+	found, err := s.queries.GetWebhook(ctx, request.Id)
+	if err != nil {
+		return nil, notFound(err)
 	}
 
-	s.hooks.OnRecordAfterUpdateRequest.Publish(ctx, database.WebhooksTable.ID, response)
+	old := mapWebhook(ctx, found)
 
-	return openapi.UpdateWebhook200JSONResponse(response), nil
+	if err := s.hooks.OnRecordBeforeUpdateRequest.PublishWithError(ctx, database.WebhooksTable.ID, &hook.Update{Old: old, New: &openapi.WebhookUpdate{}}); err != nil {
+		return nil, err
+	}
+
+	secret, err := webhook.GenerateSecret()
+	if err != nil {
+		return nil, fmt.Errorf("failed to generate secret: %w", err)
+	}
+
+	response, err := changes.Write(ctx, s.queries, database.UpdateAction, database.WebhooksTable.ID, func(queries *sqlc.Queries) (openapi.Webhook, error) {
+		current, err := queries.GetWebhook(ctx, request.Id)
+		if err != nil {
+			return openapi.Webhook{}, notFound(err)
+		}
+
+		old = mapWebhook(ctx, current)
+
+		updated, err := queries.UpdateWebhook(ctx, sqlc.UpdateWebhookParams{
+			ID:     request.Id,
+			Secret: &secret,
+		})
+		if err != nil {
+			return openapi.Webhook{}, err
+		}
+
+		return mapWebhook(ctx, updated), nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	s.hooks.OnRecordAfterUpdateRequest.Publish(ctx, database.WebhooksTable.ID, &hook.Update{Old: old, New: response})
+
+	response.Secret = &secret
+
+	return openapi.RotateWebhookSecret200JSONResponse(response), nil
+}
+
+func (s *Service) ListWebhookDeliveries(ctx context.Context, request openapi.ListWebhookDeliveriesRequestObject) (openapi.ListWebhookDeliveriesResponseObject, error) {
+	deliveries, err := s.queries.ListWebhookDeliveries(ctx, sqlc.ListWebhookDeliveriesParams{
+		Webhook: request.Id,
+		Offset:  toInt64(request.Params.Offset, defaultOffset),
+		Limit:   toInt64(request.Params.Limit, defaultLimit),
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	response := make([]openapi.WebhookDelivery, 0, len(deliveries))
+	for _, delivery := range deliveries {
+		response = append(response, mapWebhookDelivery(sqlc.WebhookDelivery{
+			ID:             delivery.ID,
+			Webhook:        delivery.Webhook,
+			Event:          delivery.Event,
+			Collection:     delivery.Collection,
+			Payload:        delivery.Payload,
+			Status:         delivery.Status,
+			Attempts:       delivery.Attempts,
+			MaxAttempts:    delivery.MaxAttempts,
+			ResponseStatus: delivery.ResponseStatus,
+			ResponseBody:   delivery.ResponseBody,
+			LastError:      delivery.LastError,
+			NextAttempt:    delivery.NextAttempt,
+			Created:        delivery.Created,
+			Updated:        delivery.Updated,
+		}))
+	}
+
+	s.hooks.OnRecordsListRequest.Publish(ctx, database.WebhookDeliveriesTable.ID, response)
+
+	totalCount := 0
+	if len(deliveries) > 0 {
+		totalCount = int(deliveries[0].TotalCount)
+	}
+
+	return openapi.ListWebhookDeliveries200JSONResponse{
+		Body: response,
+		Headers: openapi.ListWebhookDeliveries200ResponseHeaders{
+			XTotalCount: totalCount,
+		},
+	}, nil
+}
+
+func (s *Service) GetWebhookDelivery(ctx context.Context, request openapi.GetWebhookDeliveryRequestObject) (openapi.GetWebhookDeliveryResponseObject, error) {
+	delivery, err := s.queries.GetWebhookDelivery(ctx, sqlc.GetWebhookDeliveryParams{
+		ID:      request.DeliveryId,
+		Webhook: request.Id,
+	})
+	if err != nil {
+		return nil, notFound(err)
+	}
+
+	response := mapWebhookDelivery(delivery)
+
+	s.hooks.OnRecordViewRequest.Publish(ctx, database.WebhookDeliveriesTable.ID, response)
+
+	return openapi.GetWebhookDelivery200JSONResponse(response), nil
+}
+
+// RedeliverWebhookDelivery creates a new delivery with the payload of an
+// existing one, which is picked up by the webhook outbox.
+func (s *Service) RedeliverWebhookDelivery(ctx context.Context, request openapi.RedeliverWebhookDeliveryRequestObject) (openapi.RedeliverWebhookDeliveryResponseObject, error) {
+	delivery, err := s.queries.RedeliverWebhookDelivery(ctx, sqlc.RedeliverWebhookDeliveryParams{
+		ID:      request.DeliveryId,
+		Webhook: request.Id,
+		Now:     time.Now().UTC(),
+	})
+	if err != nil {
+		return nil, notFound(err)
+	}
+
+	return openapi.RedeliverWebhookDelivery200JSONResponse(mapWebhookDelivery(delivery)), nil
+}
+
+func (s *Service) ListChanges(ctx context.Context, request openapi.ListChangesRequestObject) (openapi.ListChangesResponseObject, error) {
+	found, err := s.queries.ListChangesAfter(ctx, sqlc.ListChangesAfterParams{
+		After: pointer.Dereference(request.Params.After),
+		Limit: toInt64(request.Params.Limit, defaultLimit),
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	response := make([]openapi.Change, 0, len(found))
+	for _, change := range found {
+		response = append(response, changes.Map(change))
+	}
+
+	return openapi.ListChanges200JSONResponse(response), nil
+}
+
+func (s *Service) ListSecrets(ctx context.Context, request openapi.ListSecretsRequestObject) (openapi.ListSecretsResponseObject, error) {
+	secrets, err := s.queries.ListSecrets(ctx, sqlc.ListSecretsParams{
+		Offset: toInt64(request.Params.Offset, defaultOffset),
+		Limit:  toInt64(request.Params.Limit, defaultLimit),
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	response := make([]openapi.Secret, 0, len(secrets))
+	for _, secret := range secrets {
+		response = append(response, openapi.Secret{
+			Id:      secret.ID,
+			Name:    secret.Name,
+			Created: secret.Created,
+			Updated: secret.Updated,
+		})
+	}
+
+	totalCount := 0
+	if len(secrets) > 0 {
+		totalCount = int(secrets[0].TotalCount)
+	}
+
+	return openapi.ListSecrets200JSONResponse{
+		Body: response,
+		Headers: openapi.ListSecrets200ResponseHeaders{
+			XTotalCount: totalCount,
+		},
+	}, nil
+}
+
+func (s *Service) CreateSecret(ctx context.Context, request openapi.CreateSecretRequestObject) (openapi.CreateSecretResponseObject, error) {
+	if err := secret.ValidateName(request.Body.Name); err != nil {
+		return nil, badRequest(err)
+	}
+
+	value, err := s.secrets.Encrypt([]byte(request.Body.Value))
+	if err != nil {
+		return nil, err
+	}
+
+	created, err := s.queries.CreateSecret(ctx, sqlc.CreateSecretParams{
+		Name:  request.Body.Name,
+		Value: value,
+		Now:   time.Now().UTC(),
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return openapi.CreateSecret200JSONResponse(mapSecret(created)), nil
+}
+
+func (s *Service) DeleteSecret(ctx context.Context, request openapi.DeleteSecretRequestObject) (openapi.DeleteSecretResponseObject, error) {
+	if err := s.queries.DeleteSecret(ctx, request.Id); err != nil {
+		return nil, err
+	}
+
+	return openapi.DeleteSecret204Response{}, nil
+}
+
+func (s *Service) GetSecret(ctx context.Context, request openapi.GetSecretRequestObject) (openapi.GetSecretResponseObject, error) {
+	found, err := s.queries.GetSecret(ctx, request.Id)
+	if err != nil {
+		return nil, err
+	}
+
+	return openapi.GetSecret200JSONResponse(mapSecret(found)), nil
+}
+
+func (s *Service) UpdateSecret(ctx context.Context, request openapi.UpdateSecretRequestObject) (openapi.UpdateSecretResponseObject, error) {
+	if request.Body.Name != nil {
+		if err := secret.ValidateName(*request.Body.Name); err != nil {
+			return nil, badRequest(err)
+		}
+	}
+
+	var value []byte
+
+	if request.Body.Value != nil {
+		var err error
+
+		value, err = s.secrets.Encrypt([]byte(*request.Body.Value))
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	updated, err := s.queries.UpdateSecret(ctx, sqlc.UpdateSecretParams{
+		ID:    request.Id,
+		Name:  request.Body.Name,
+		Value: value,
+		Now:   time.Now().UTC(),
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return openapi.UpdateSecret200JSONResponse(mapSecret(updated)), nil
+}
+
+// mapSecret maps a secret without its value.
+func mapSecret(secret sqlc.Secret) openapi.Secret {
+	return openapi.Secret{
+		Id:      secret.ID,
+		Name:    secret.Name,
+		Created: secret.Created,
+		Updated: secret.Updated,
+	}
 }
 
 func (s *Service) GetConfig(ctx context.Context, _ openapi.GetConfigRequestObject) (openapi.GetConfigResponseObject, error) {
@@ -1836,6 +2956,12 @@ func (s *Service) GetSettings(ctx context.Context, _ openapi.GetSettingsRequestO
 }
 
 func (s *Service) UpdateSettings(ctx context.Context, request openapi.UpdateSettingsRequestObject) (openapi.UpdateSettingsResponseObject, error) {
+	if request.Body.Oidc != nil {
+		if err := s.validateOIDC(ctx, request.Body.Oidc); err != nil {
+			return nil, err
+		}
+	}
+
 	se, err := settings.Update(ctx, s.queries, func(settings *settings.Settings) {
 		settings.Meta.AppName = request.Body.Meta.AppName
 		settings.Meta.AppURL = request.Body.Meta.AppUrl
@@ -1849,6 +2975,19 @@ func (s *Service) UpdateSettings(ctx context.Context, request openapi.UpdateSett
 		settings.SMTP.AuthMethod = request.Body.Smtp.AuthMethod
 		settings.SMTP.TLS = request.Body.Smtp.Tls
 		settings.SMTP.LocalName = request.Body.Smtp.LocalName
+
+		if oidc := request.Body.Oidc; oidc != nil {
+			settings.OIDC.Enabled = oidc.Enabled
+			settings.OIDC.Issuer = oidc.Issuer
+			settings.OIDC.ClientID = oidc.ClientId
+			settings.OIDC.ClientSecret = oidc.ClientSecret
+			settings.OIDC.Scopes = oidc.Scopes
+			settings.OIDC.EmailClaim = oidc.EmailClaim
+			settings.OIDC.NameClaim = oidc.NameClaim
+			settings.OIDC.GroupsClaim = oidc.GroupsClaim
+			settings.OIDC.GroupMapping = oidc.GroupMapping
+			settings.OIDC.LinkExistingUsers = pointer.Dereference(oidc.LinkExistingUsers)
+		}
 	})
 	if err != nil {
 		return nil, fmt.Errorf("failed to save settings: %w", err)
@@ -1857,6 +2996,24 @@ func (s *Service) UpdateSettings(ctx context.Context, request openapi.UpdateSett
 	return openapi.UpdateSettings200JSONResponse(mapSettings(se)), err
 }
 
+func (s *Service) validateOIDC(ctx context.Context, oidc *openapi.SettingsOidc) error {
+	if oidc.Enabled && (oidc.Issuer == "" || oidc.ClientId == "") {
+		return badRequest(errors.New("oidc issuer and client id are required"))
+	}
+
+	for idpGroup, group := range oidc.GroupMapping {
+		if _, err := s.queries.GetGroup(ctx, group); err != nil {
+			if errors.Is(err, sql.ErrNoRows) {
+				return badRequest(fmt.Errorf("group %q of IdP group %q not found", group, idpGroup))
+			}
+
+			return fmt.Errorf("failed to get group %q: %w", group, err)
+		}
+	}
+
+	return nil
+}
+
 func toString(value *string, defaultValue string) string {
 	if value == nil {
 		return defaultValue
@@ -1899,6 +3056,336 @@ func unmarshal(data json.RawMessage) map[string]any {
 	return m
 }
 
+// recordDeletedChildren records the deletes of the records that are removed
+// with a ticket by the foreign key cascade, so consumers of the changes do
+// not keep them.
+func recordDeletedChildren(ctx context.Context, queries *sqlc.Queries, ticketID string) error {
+	comments, err := queries.ListTicketComments(ctx, ticketID)
+	if err != nil {
+		return err
+	}
+
+	for _, comment := range comments {
+		if err := changes.Record(ctx, queries, database.DeleteAction, database.CommentsTable.ID, mapExtendedComment(sqlc.GetCommentRow(comment))); err != nil {
+			return err
+		}
+	}
+
+	tasks, err := queries.ListTicketTasks(ctx, ticketID)
+	if err != nil {
+		return err
+	}
+
+	for _, task := range tasks {
+		if err := changes.Record(ctx, queries, database.DeleteAction, database.TasksTable.ID, mapExtendedTask(sqlc.GetTaskRow(task))); err != nil {
+			return err
+		}
+	}
+
+	files, err := queries.ListTicketFiles(ctx, ticketID)
+	if err != nil {
+		return err
+	}
+
+	for _, file := range files {
+		if err := changes.Record(ctx, queries, database.DeleteAction, database.FilesTable.ID, mapFile(file)); err != nil {
+			return err
+		}
+	}
+
+	links, err := queries.ListTicketLinks(ctx, ticketID)
+	if err != nil {
+		return err
+	}
+
+	for _, link := range links {
+		if err := changes.Record(ctx, queries, database.DeleteAction, database.LinksTable.ID, mapLink(link)); err != nil {
+			return err
+		}
+	}
+
+	timeline, err := queries.ListTicketTimeline(ctx, ticketID)
+	if err != nil {
+		return err
+	}
+
+	for _, entry := range timeline {
+		if err := changes.Record(ctx, queries, database.DeleteAction, database.TimelinesTable.ID, mapTimeline(entry)); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// deletedTicket is the snapshot of a deleted ticket that is published to
+// the delete hooks. Children counts the records that are deleted with it.
+type deletedTicket struct {
+	openapi.ExtendedTicket
+
+	Children ticketChildren `json:"children"`
+}
+
+type ticketChildren struct {
+	Comments int `json:"comments"`
+	Tasks    int `json:"tasks"`
+	Files    int `json:"files"`
+	Links    int `json:"links"`
+	Timeline int `json:"timeline"`
+}
+
+func mapTicket(ticket sqlc.TicketRow) openapi.Ticket {
+	return openapi.Ticket{
+		Created:     ticket.Created,
+		Description: ticket.Description,
+		Id:          ticket.ID,
+		Name:        ticket.Name,
+		Open:        ticket.Open,
+		Owner:       ticket.Owner,
+		Resolution:  ticket.Resolution,
+		Schema:      unmarshal(ticket.Schema),
+		State:       unmarshal(ticket.State),
+		Type:        ticket.Type,
+		Updated:     ticket.Updated,
+	}
+}
+
+func mapExtendedTicket(ticket sqlc.TicketRow) openapi.ExtendedTicket {
+	return openapi.ExtendedTicket{
+		Created:      ticket.Created,
+		Description:  ticket.Description,
+		Id:           ticket.ID,
+		Name:         ticket.Name,
+		Open:         ticket.Open,
+		Owner:        ticket.Owner,
+		OwnerName:    ticket.OwnerName,
+		Resolution:   ticket.Resolution,
+		Schema:       unmarshal(ticket.Schema),
+		State:        unmarshal(ticket.State),
+		Type:         ticket.Type,
+		TypePlural:   pointer.Dereference(ticket.TypePlural),
+		TypeSingular: pointer.Dereference(ticket.TypeSingular),
+		Updated:      ticket.Updated,
+	}
+}
+
+func mapComment(comment sqlc.GetCommentRow) openapi.Comment {
+	return openapi.Comment{
+		Author:  comment.Author,
+		Created: comment.Created,
+		Id:      comment.ID,
+		Message: comment.Message,
+		Ticket:  comment.Ticket,
+		Updated: comment.Updated,
+	}
+}
+
+func mapExtendedComment(comment sqlc.GetCommentRow) openapi.ExtendedComment {
+	return openapi.ExtendedComment{
+		Author:     comment.Author,
+		AuthorName: pointer.Dereference(comment.AuthorName),
+		Created:    comment.Created,
+		Id:         comment.ID,
+		Message:    comment.Message,
+		Ticket:     comment.Ticket,
+		Updated:    comment.Updated,
+	}
+}
+
+func mapTask(task sqlc.GetTaskRow) openapi.Task {
+	return openapi.Task{
+		Created: task.Created,
+		Id:      task.ID,
+		Name:    task.Name,
+		Open:    task.Open,
+		Owner:   task.Owner,
+		Ticket:  task.Ticket,
+		Updated: task.Updated,
+	}
+}
+
+func mapExtendedTask(task sqlc.GetTaskRow) openapi.ExtendedTask {
+	return openapi.ExtendedTask{
+		Id:         task.ID,
+		Name:       task.Name,
+		Created:    task.Created,
+		Updated:    task.Updated,
+		Open:       task.Open,
+		Owner:      task.Owner,
+		Ticket:     task.Ticket,
+		OwnerName:  task.OwnerName,
+		TicketName: pointer.Dereference(task.TicketName),
+		TicketType: pointer.Dereference(task.TicketType),
+	}
+}
+
+func mapFile(file sqlc.File) openapi.File {
+	return openapi.File{
+		Created: file.Created,
+		Id:      file.ID,
+		Name:    file.Name,
+		Size:    file.Size,
+		Ticket:  file.Ticket,
+		Updated: file.Updated,
+	}
+}
+
+func mapLink(link sqlc.Link) openapi.Link {
+	return openapi.Link{
+		Created: link.Created,
+		Id:      link.ID,
+		Name:    link.Name,
+		Ticket:  link.Ticket,
+		Updated: link.Updated,
+		Url:     link.Url,
+	}
+}
+
+func mapReaction(reaction sqlc.Reaction) openapi.Reaction {
+	return openapi.Reaction{
+		Action:      reaction.Action,
+		Actiondata:  unmarshal(reaction.Actiondata),
+		Created:     reaction.Created,
+		Id:          reaction.ID,
+		Name:        reaction.Name,
+		Trigger:     reaction.Trigger,
+		Triggerdata: unmarshal(reaction.Triggerdata),
+		Updated:     reaction.Updated,
+	}
+}
+
+func mapTimeline(timeline sqlc.Timeline) openapi.TimelineEntry {
+	return openapi.TimelineEntry{
+		Created: timeline.Created,
+		Id:      timeline.ID,
+		Message: timeline.Message,
+		Ticket:  timeline.Ticket,
+		Time:    timeline.Time,
+		Updated: timeline.Updated,
+	}
+}
+
+func mapType(t sqlc.Type) openapi.Type {
+	return openapi.Type{
+		Created:  t.Created,
+		Icon:     t.Icon,
+		Id:       t.ID,
+		Plural:   t.Plural,
+		Schema:   unmarshal(t.Schema),
+		Singular: t.Singular,
+		Updated:  t.Updated,
+	}
+}
+
+func mapUser(user sqlc.User) openapi.User {
+	return openapi.User{
+		Avatar:                 user.Avatar,
+		Created:                user.Created,
+		Email:                  user.Email,
+		Id:                     user.ID,
+		LastResetSentAt:        user.Lastresetsentat,
+		LastVerificationSentAt: user.Lastverificationsentat,
+		Name:                   user.Name,
+		Updated:                user.Updated,
+		Username:               user.Username,
+		Active:                 user.Active,
+	}
+}
+
+func mapGroup(ctx context.Context, group sqlc.Group) openapi.Group {
+	return openapi.Group{
+		Created:     group.Created,
+		Id:          group.ID,
+		Name:        group.Name,
+		Permissions: auth.FromJSONArray(ctx, group.Permissions),
+		Updated:     group.Updated,
+	}
+}
+
+func mapWebhook(ctx context.Context, webhook sqlc.Webhook) openapi.Webhook {
+	return openapi.Webhook{
+		Id:          webhook.ID,
+		Name:        webhook.Name,
+		Created:     webhook.Created,
+		Updated:     webhook.Updated,
+		Destination: webhook.Destination,
+		Collection:  webhook.Collection,
+		Events:      auth.FromJSONArray(ctx, webhook.Events),
+		Filter:      webhook.Filter,
+		Headers:     redactHeaders(unmarshalHeaders(ctx, webhook.Headers)),
+		Format:      webhook.Format,
+	}
+}
+
+// redactedHeader replaces the values of the custom webhook headers in
+// responses, hooks and the change feed, as they usually contain API keys.
+const redactedHeader = "********"
+
+func redactHeaders(headers map[string]string) map[string]string {
+	redacted := make(map[string]string, len(headers))
+	for key := range headers {
+		redacted[key] = redactedHeader
+	}
+
+	return redacted
+}
+
+func unmarshalHeaders(ctx context.Context, data string) map[string]string {
+	headers := map[string]string{}
+	if err := json.Unmarshal([]byte(data), &headers); err != nil {
+		slog.ErrorContext(ctx, "Failed to unmarshal headers", "error", err)
+	}
+
+	return headers
+}
+
+func mapWebhookDelivery(delivery sqlc.WebhookDelivery) openapi.WebhookDelivery {
+	response := openapi.WebhookDelivery{
+		Attempts:     int(delivery.Attempts),
+		Collection:   delivery.Collection,
+		Created:      delivery.Created,
+		Error:        delivery.LastError,
+		Event:        delivery.Event,
+		Id:           delivery.ID,
+		NextAttempt:  delivery.NextAttempt,
+		Payload:      unmarshal(delivery.Payload),
+		ResponseBody: delivery.ResponseBody,
+		Status:       delivery.Status,
+		Updated:      delivery.Updated,
+		Webhook:      delivery.Webhook,
+	}
+
+	if delivery.ResponseStatus != nil {
+		response.ResponseStatus = pointer.Pointer(int(*delivery.ResponseStatus))
+	}
+
+	return response
+}
+
+func mapReactionRun(run sqlc.ReactionRun) openapi.ReactionRun {
+	response := openapi.ReactionRun{
+		Error:    run.Error,
+		Finished: run.Finished,
+		Id:       run.ID,
+		Input:    unmarshal(run.Input),
+		Output:   run.Output,
+		Reaction: run.Reaction,
+		Started:  run.Started,
+		Status:   run.Status,
+		Trigger:  run.Trigger,
+	}
+
+	if run.ExitCode != nil {
+		response.ExitCode = pointer.Pointer(int(*run.ExitCode))
+	}
+
+	if run.Finished != nil {
+		response.Duration = pointer.Pointer(int(run.Finished.Sub(run.Started).Milliseconds()))
+	}
+
+	return response
+}
+
 func mapSettings(settings *settings.Settings) openapi.Settings {
 	return openapi.Settings{
 		Meta: openapi.SettingsMeta{
@@ -1921,5 +3408,69 @@ func mapSettings(settings *settings.Settings) openapi.Settings {
 			Tls:        settings.SMTP.TLS,
 			Username:   settings.SMTP.Username,
 		},
+		Oidc: mapOIDCSettings(settings.OIDC),
+	}
+}
+
+func mapAPIToken(ctx context.Context, token sqlc.ApiToken) openapi.ApiToken {
+	return openapi.ApiToken{
+		Id:         token.ID,
+		User:       token.User,
+		Name:       token.Name,
+		Prefix:     token.Prefix,
+		Scopes:     auth.FromJSONArray(ctx, token.Scopes),
+		Expires:    token.Expires,
+		LastUsed:   token.LastUsed,
+		LastUsedIp: token.LastUsedIp,
+		Created:    token.Created,
+	}
+}
+
+func mapSession(session sqlc.Session, current string) openapi.Session {
+	return openapi.Session{
+		Id:        session.ID,
+		User:      session.User,
+		UserAgent: session.UserAgent,
+		Ip:        session.Ip,
+		Current:   session.ID == current,
+		Expires:   session.Expires,
+		LastSeen:  session.LastSeen,
+		Created:   session.Created,
+	}
+}
+
+func mapOIDCSettings(oidc settings.OIDC) *openapi.SettingsOidc {
+	scopes := oidc.Scopes
+	if scopes == nil {
+		scopes = []string{}
+	}
+
+	groupMapping := oidc.GroupMapping
+	if groupMapping == nil {
+		groupMapping = map[string]string{}
+	}
+
+	return &openapi.SettingsOidc{
+		Enabled:           oidc.Enabled,
+		Issuer:            oidc.Issuer,
+		ClientId:          oidc.ClientID,
+		ClientSecret:      oidc.ClientSecret,
+		Scopes:            scopes,
+		EmailClaim:        oidc.EmailClaim,
+		NameClaim:         oidc.NameClaim,
+		GroupsClaim:       oidc.GroupsClaim,
+		GroupMapping:      groupMapping,
+		LinkExistingUsers: &oidc.LinkExistingUsers,
+	}
+}
+
+func mapPythonEnvironment(environment *python.Environment) openapi.PythonEnvironment {
+	return openapi.PythonEnvironment{
+		Id:            environment.ID,
+		PythonVersion: environment.PythonVersion,
+		Requirements:  environment.Requirements,
+		Created:       environment.Created,
+		LastUsed:      environment.LastUsed,
+		Size:          int(environment.Size),
 	}
 }
@@ -1,22 +1,33 @@
 package service
 
 import (
+	"context"
 	"encoding/json"
+	"errors"
+	"fmt"
 	"io"
+	"net/http"
+	"net/http/httptest"
 	"testing"
 
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 
+	"github.com/SecurityBrewery/catalyst/app/auth/usercontext"
 	"github.com/SecurityBrewery/catalyst/app/data"
 	"github.com/SecurityBrewery/catalyst/app/database"
 	"github.com/SecurityBrewery/catalyst/app/database/sqlc"
 	"github.com/SecurityBrewery/catalyst/app/hook"
 	"github.com/SecurityBrewery/catalyst/app/migration"
 	"github.com/SecurityBrewery/catalyst/app/openapi"
+	"github.com/SecurityBrewery/catalyst/app/pointer"
+	"github.com/SecurityBrewery/catalyst/app/reaction/action"
+	"github.com/SecurityBrewery/catalyst/app/secret"
 	"github.com/SecurityBrewery/catalyst/app/upload"
 )
 
+const testSecretKey = "MDEyMzQ1Njc4OWFiY2RlZjAxMjM0NTY3ODlhYmNkZWY="
+
 func newTestService(t *testing.T) *Service {
 	t.Helper()
 
@@ -29,7 +40,16 @@ func newTestService(t *testing.T) *Service {
 	err = migration.Apply(t.Context(), queries, dir, uploader)
 	require.NoError(t, err)
 
-	return New(queries, hooks, uploader, nil)
+	secrets, err := secret.New(testSecretKey)
+	require.NoError(t, err)
+
+	runner := action.NewRunner(queries, nil, nil, secrets)
+
+	s := New(queries, hooks, uploader, nil, nil, runner, secrets)
+
+	runner.SetRecords(s)
+
+	return s
 }
 
 func Test_toString(t *testing.T) {
@@ -157,3 +177,857 @@ func TestService_UpdateTicket_PreservesStateOnNil(t *testing.T) {
 	require.NoError(t, err)
 	assert.Equal(t, before.State, after.State)
 }
+
+func TestService_UpdateTicket_PublishesOldRecord(t *testing.T) {
+	t.Parallel()
+
+	s := newTestService(t)
+
+	var before, after *hook.Update
+
+	s.hooks.OnRecordBeforeUpdateRequest.Subscribe(func(_ context.Context, _ string, record any) {
+		before, _ = record.(*hook.Update)
+	})
+	s.hooks.OnRecordAfterUpdateRequest.Subscribe(func(_ context.Context, _ string, record any) {
+		after, _ = record.(*hook.Update)
+	})
+
+	body := &openapi.TicketUpdate{Owner: pointer.Pointer("u_admin")}
+
+	_, err := s.UpdateTicket(t.Context(), openapi.UpdateTicketRequestObject{Id: "test-ticket", Body: body})
+	require.NoError(t, err)
+
+	require.NotNil(t, before)
+	assert.Equal(t, "u_bob_analyst", *before.Old.(openapi.Ticket).Owner) //nolint:forcetypeassert
+	assert.Equal(t, body, before.New)
+
+	require.NotNil(t, after)
+	assert.Equal(t, "u_bob_analyst", *after.Old.(openapi.Ticket).Owner) //nolint:forcetypeassert
+	assert.Equal(t, "u_admin", *after.New.(openapi.Ticket).Owner)       //nolint:forcetypeassert
+}
+
+func TestService_UpdateUser_DoesNotPublishPassword(t *testing.T) {
+	t.Parallel()
+
+	s := newTestService(t)
+
+	var before *hook.Update
+
+	s.hooks.OnRecordBeforeUpdateRequest.Subscribe(func(_ context.Context, _ string, record any) {
+		before, _ = record.(*hook.Update)
+	})
+
+	body := &openapi.UserUpdate{
+		Name:            pointer.Pointer("Bob"),
+		Password:        pointer.Pointer("new-password"),
+		PasswordConfirm: pointer.Pointer("new-password"),
+	}
+
+	_, err := s.UpdateUser(t.Context(), openapi.UpdateUserRequestObject{Id: "u_bob_analyst", Body: body})
+	require.NoError(t, err)
+
+	require.NotNil(t, before)
+
+	b, err := json.Marshal(before.New)
+	require.NoError(t, err)
+	assert.JSONEq(t, `{"name":"Bob"}`, string(b))
+	assert.Equal(t, "new-password", *body.Password, "the request body is not modified")
+}
+
+func TestService_DeleteTicket_PublishesSnapshot(t *testing.T) {
+	t.Parallel()
+
+	s := newTestService(t)
+
+	var before, after any
+
+	s.hooks.OnRecordBeforeDeleteRequest.Subscribe(func(_ context.Context, _ string, record any) {
+		before = record
+	})
+	s.hooks.OnRecordAfterDeleteRequest.Subscribe(func(_ context.Context, _ string, record any) {
+		after = record
+	})
+
+	_, err := s.DeleteTicket(t.Context(), openapi.DeleteTicketRequestObject{Id: "test-ticket"})
+	require.NoError(t, err)
+
+	assert.Equal(t, before, after)
+
+	b, err := json.Marshal(after)
+	require.NoError(t, err)
+
+	var snapshot map[string]any
+	require.NoError(t, json.Unmarshal(b, &snapshot))
+
+	assert.Equal(t, "test-ticket", snapshot["id"])
+	assert.Equal(t, "u_bob_analyst", snapshot["owner"])
+	assert.Equal(t, map[string]any{"comments": 1.0, "tasks": 1.0, "files": 1.0, "links": 1.0, "timeline": 1.0}, snapshot["children"])
+}
+
+func TestService_DeleteComment_PublishesSnapshot(t *testing.T) {
+	t.Parallel()
+
+	s := newTestService(t)
+
+	var after any
+
+	s.hooks.OnRecordAfterDeleteRequest.Subscribe(func(_ context.Context, _ string, record any) {
+		after = record
+	})
+
+	_, err := s.DeleteComment(t.Context(), openapi.DeleteCommentRequestObject{Id: "c_test_comment"})
+	require.NoError(t, err)
+
+	comment, ok := after.(openapi.ExtendedComment)
+	require.True(t, ok)
+	assert.Equal(t, "c_test_comment", comment.Id)
+	assert.Equal(t, "test-ticket", comment.Ticket)
+
+	_, err = s.DeleteComment(t.Context(), openapi.DeleteCommentRequestObject{Id: "c_test_comment"})
+
+	var statusErr *statusError
+	require.ErrorAs(t, err, &statusErr)
+	assert.Equal(t, http.StatusNotFound, statusErr.status)
+}
+
+func TestService_CreateWebhook_DoesNotPublishSecret(t *testing.T) {
+	t.Parallel()
+
+	s := newTestService(t)
+
+	var published any
+
+	s.hooks.OnRecordAfterCreateRequest.Subscribe(func(_ context.Context, _ string, record any) {
+		published = record
+	})
+
+	response, err := s.CreateWebhook(t.Context(), openapi.CreateWebhookRequestObject{Body: &openapi.NewWebhook{
+		Name:        "new",
+		Collection:  "tickets",
+		Destination: "https://example.com/new",
+	}})
+	require.NoError(t, err)
+
+	created, ok := response.(openapi.CreateWebhook200JSONResponse)
+	require.True(t, ok)
+	require.NotNil(t, created.Secret, "the secret is returned to the caller")
+
+	require.NotNil(t, published)
+
+	b, err := json.Marshal(published)
+	require.NoError(t, err)
+
+	assert.NotContains(t, string(b), *created.Secret)
+	assert.NotContains(t, string(b), `"secret"`)
+}
+
+func TestService_Webhook_RedactsHeaders(t *testing.T) {
+	t.Parallel()
+
+	s := newTestService(t)
+
+	var published []any
+
+	s.hooks.OnRecordBeforeCreateRequest.SubscribeWithError(func(_ context.Context, _ string, record any) error {
+		published = append(published, record)
+
+		return nil
+	})
+	s.hooks.OnRecordAfterCreateRequest.Subscribe(func(_ context.Context, _ string, record any) {
+		published = append(published, record)
+	})
+	s.hooks.OnRecordAfterUpdateRequest.Subscribe(func(_ context.Context, _ string, record any) {
+		published = append(published, record)
+	})
+
+	response, err := s.CreateWebhook(t.Context(), openapi.CreateWebhookRequestObject{Body: &openapi.NewWebhook{
+		Name:        "new",
+		Collection:  "tickets",
+		Destination: "https://example.com/new",
+		Headers:     &map[string]string{"Authorization": "Bearer key"},
+	}})
+	require.NoError(t, err)
+
+	created, ok := response.(openapi.CreateWebhook200JSONResponse)
+	require.True(t, ok)
+	assert.Equal(t, map[string]string{"Authorization": redactedHeader}, created.Headers)
+
+	// clients send the redacted value back when they update a fetched webhook
+	_, err = s.UpdateWebhook(t.Context(), openapi.UpdateWebhookRequestObject{Id: created.Id, Body: &openapi.WebhookUpdate{
+		Name:    pointer.Pointer("update"),
+		Headers: &map[string]string{"Authorization": redactedHeader, "X-Extra": "extra"},
+	}})
+	require.NoError(t, err)
+
+	stored, err := s.queries.GetWebhook(t.Context(), created.Id)
+	require.NoError(t, err)
+	assert.JSONEq(t, `{"Authorization":"Bearer key","X-Extra":"extra"}`, stored.Headers)
+
+	require.Len(t, published, 3)
+
+	for _, record := range published {
+		b, err := json.Marshal(record)
+		require.NoError(t, err)
+
+		assert.NotContains(t, string(b), "Bearer key")
+		assert.NotContains(t, string(b), `"extra"`)
+	}
+}
+
+func TestService_UserGroup_Changes(t *testing.T) {
+	t.Parallel()
+
+	s := newTestService(t)
+
+	var published []any
+
+	s.hooks.OnRecordAfterCreateRequest.Subscribe(func(_ context.Context, _ string, record any) {
+		published = append(published, record)
+	})
+	s.hooks.OnRecordAfterDeleteRequest.Subscribe(func(_ context.Context, _ string, record any) {
+		published = append(published, record)
+	})
+
+	_, err := s.AddUserGroup(t.Context(), openapi.AddUserGroupRequestObject{Id: "u_bob_analyst", Body: &openapi.GroupRelation{GroupId: "admin"}})
+	require.NoError(t, err)
+
+	_, err = s.RemoveUserGroup(t.Context(), openapi.RemoveUserGroupRequestObject{Id: "u_bob_analyst", GroupId: "admin"})
+	require.NoError(t, err)
+
+	expected := newUserGroup("u_bob_analyst", "admin")
+	assert.Equal(t, []any{expected, expected}, published)
+
+	changes, err := s.queries.ListChangesAfter(t.Context(), sqlc.ListChangesAfterParams{After: 0, Limit: 100})
+	require.NoError(t, err)
+	require.Len(t, changes, 2)
+
+	for _, change := range changes {
+		assert.Equal(t, database.UserGroupTable.ID, change.Collection)
+		assert.Equal(t, "u_bob_analyst:admin", change.Record)
+		assert.JSONEq(t, `{"id":"u_bob_analyst:admin","user":"u_bob_analyst","group":"admin"}`, string(change.Snapshot))
+	}
+}
+
+func TestService_DeleteTicket_Changes(t *testing.T) {
+	t.Parallel()
+
+	s := newTestService(t)
+
+	_, err := s.DeleteTicket(t.Context(), openapi.DeleteTicketRequestObject{Id: "test-ticket"})
+	require.NoError(t, err)
+
+	changes, err := s.queries.ListChangesAfter(t.Context(), sqlc.ListChangesAfterParams{After: 0, Limit: 100})
+	require.NoError(t, err)
+
+	deleted := map[string]string{}
+
+	for _, change := range changes {
+		assert.Equal(t, database.DeleteAction, change.Action)
+
+		deleted[change.Record] = change.Collection
+	}
+
+	// the children are deleted by the cascade in the same transaction
+	assert.Equal(t, map[string]string{
+		"test-ticket":     database.TicketsTable.ID,
+		"c_test_comment":  database.CommentsTable.ID,
+		"k_test_task":     database.TasksTable.ID,
+		"b_test_file":     database.FilesTable.ID,
+		"l_test_link":     database.LinksTable.ID,
+		"h_test_timeline": database.TimelinesTable.ID,
+	}, deleted)
+}
+
+func TestService_Delete_NotFound(t *testing.T) {
+	t.Parallel()
+
+	s := newTestService(t)
+
+	tests := []struct {
+		name   string
+		delete func() error
+	}{
+		{name: "ticket", delete: func() error {
+			_, err := s.DeleteTicket(t.Context(), openapi.DeleteTicketRequestObject{Id: "missing"})
+
+			return err
+		}},
+		{name: "comment", delete: func() error {
+			_, err := s.DeleteComment(t.Context(), openapi.DeleteCommentRequestObject{Id: "missing"})
+
+			return err
+		}},
+		{name: "user", delete: func() error {
+			_, err := s.DeleteUser(t.Context(), openapi.DeleteUserRequestObject{Id: "missing"})
+
+			return err
+		}},
+		{name: "webhook", delete: func() error {
+			_, err := s.DeleteWebhook(t.Context(), openapi.DeleteWebhookRequestObject{Id: "missing"})
+
+			return err
+		}},
+		{name: "api token", delete: func() error {
+			ctx := usercontext.UserContext(t.Context(), &sqlc.User{ID: "u_bob_analyst"})
+			_, err := s.DeleteApiToken(ctx, openapi.DeleteApiTokenRequestObject{Id: "missing"})
+
+			return err
+		}},
+		{name: "user api token", delete: func() error {
+			_, err := s.DeleteUserApiToken(t.Context(), openapi.DeleteUserApiTokenRequestObject{Id: "u_bob_analyst", TokenId: "missing"})
+
+			return err
+		}},
+		{name: "session", delete: func() error {
+			ctx := usercontext.UserContext(t.Context(), &sqlc.User{ID: "u_bob_analyst"})
+			_, err := s.DeleteSession(ctx, openapi.DeleteSessionRequestObject{Id: "missing"})
+
+			return err
+		}},
+		{name: "user session", delete: func() error {
+			_, err := s.DeleteUserSession(t.Context(), openapi.DeleteUserSessionRequestObject{Id: "u_bob_analyst", SessionId: "missing"})
+
+			return err
+		}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			var statusErr *statusError
+			require.ErrorAs(t, tt.delete(), &statusErr)
+			assert.Equal(t, http.StatusNotFound, statusErr.status)
+		})
+	}
+}
+
+func TestService_Update_NotFound(t *testing.T) {
+	t.Parallel()
+
+	s := newTestService(t)
+
+	tests := []struct {
+		name   string
+		update func() error
+	}{
+		{name: "ticket", update: func() error {
+			_, err := s.UpdateTicket(t.Context(), openapi.UpdateTicketRequestObject{Id: "missing", Body: &openapi.TicketUpdate{}})
+
+			return err
+		}},
+		{name: "comment", update: func() error {
+			_, err := s.UpdateComment(t.Context(), openapi.UpdateCommentRequestObject{Id: "missing", Body: &openapi.CommentUpdate{}})
+
+			return err
+		}},
+		{name: "user", update: func() error {
+			_, err := s.UpdateUser(t.Context(), openapi.UpdateUserRequestObject{Id: "missing", Body: &openapi.UserUpdate{}})
+
+			return err
+		}},
+		{name: "webhook", update: func() error {
+			_, err := s.UpdateWebhook(t.Context(), openapi.UpdateWebhookRequestObject{Id: "missing", Body: &openapi.WebhookUpdate{}})
+
+			return err
+		}},
+		{name: "webhook secret", update: func() error {
+			_, err := s.RotateWebhookSecret(t.Context(), openapi.RotateWebhookSecretRequestObject{Id: "missing"})
+
+			return err
+		}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			var statusErr *statusError
+			require.ErrorAs(t, tt.update(), &statusErr)
+			assert.Equal(t, http.StatusNotFound, statusErr.status)
+		})
+	}
+}
+
+func TestService_RotateWebhookSecret(t *testing.T) {
+	t.Parallel()
+
+	s := newTestService(t)
+
+	var published []any
+
+	s.hooks.OnRecordBeforeUpdateRequest.Subscribe(func(_ context.Context, _ string, record any) {
+		published = append(published, record)
+	})
+	s.hooks.OnRecordAfterUpdateRequest.Subscribe(func(_ context.Context, _ string, record any) {
+		published = append(published, record)
+	})
+
+	created, err := s.CreateWebhook(t.Context(), openapi.CreateWebhookRequestObject{Body: &openapi.NewWebhook{
+		Name:        "new",
+		Collection:  "tickets",
+		Destination: "https://example.com/new",
+	}})
+	require.NoError(t, err)
+
+	id := created.(openapi.CreateWebhook200JSONResponse).Id
+
+	response, err := s.RotateWebhookSecret(t.Context(), openapi.RotateWebhookSecretRequestObject{Id: id})
+	require.NoError(t, err)
+
+	rotated, ok := response.(openapi.RotateWebhookSecret200JSONResponse)
+	require.True(t, ok)
+	require.NotNil(t, rotated.Secret, "the secret is returned to the caller")
+	assert.NotEqual(t, created.(openapi.CreateWebhook200JSONResponse).Secret, rotated.Secret)
+
+	require.Len(t, published, 2)
+
+	b, err := json.Marshal(published)
+	require.NoError(t, err)
+	assert.NotContains(t, string(b), *rotated.Secret)
+
+	changes, err := s.queries.ListChangesAfter(t.Context(), sqlc.ListChangesAfterParams{After: 0, Limit: 100})
+	require.NoError(t, err)
+	require.Len(t, changes, 2)
+	assert.Equal(t, database.UpdateAction, changes[1].Action)
+	assert.Equal(t, id, changes[1].Record)
+	assert.NotContains(t, string(changes[1].Snapshot), *rotated.Secret)
+}
+
+func TestService_Get_NotFound(t *testing.T) {
+	t.Parallel()
+
+	s := newTestService(t)
+
+	tests := []struct {
+		name string
+		call func() error
+	}{
+		{name: "reaction run", call: func() error {
+			_, err := s.GetReactionRun(t.Context(), openapi.GetReactionRunRequestObject{Id: "missing", RunId: "missing"})
+
+			return err
+		}},
+		{name: "webhook delivery", call: func() error {
+			_, err := s.GetWebhookDelivery(t.Context(), openapi.GetWebhookDeliveryRequestObject{Id: "missing", DeliveryId: "missing"})
+
+			return err
+		}},
+		{name: "redeliver webhook delivery", call: func() error {
+			_, err := s.RedeliverWebhookDelivery(t.Context(), openapi.RedeliverWebhookDeliveryRequestObject{Id: "missing", DeliveryId: "missing"})
+
+			return err
+		}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			var statusErr *statusError
+			require.ErrorAs(t, tt.call(), &statusErr)
+			assert.Equal(t, http.StatusNotFound, statusErr.status)
+		})
+	}
+}
+
+func TestService_UpdateTicket_ConcurrentUpdate(t *testing.T) {
+	t.Parallel()
+
+	s := newTestService(t)
+
+	// another request updates the ticket after it was read for the before
+	// hooks, but before it is written
+	s.hooks.OnRecordBeforeUpdateRequest.SubscribeWithError(func(ctx context.Context, _ string, _ any) error {
+		_, err := s.queries.UpdateTicket(ctx, sqlc.UpdateTicketParams{ID: "test-ticket", Name: pointer.Pointer("concurrent")})
+
+		return err
+	})
+
+	var update *hook.Update
+
+	s.hooks.OnRecordAfterUpdateRequest.Subscribe(func(_ context.Context, _ string, record any) {
+		update, _ = record.(*hook.Update)
+	})
+
+	_, err := s.UpdateTicket(t.Context(), openapi.UpdateTicketRequestObject{Id: "test-ticket", Body: &openapi.TicketUpdate{Open: pointer.Pointer(false)}})
+	require.NoError(t, err)
+
+	require.NotNil(t, update)
+
+	old, ok := update.Old.(openapi.Ticket)
+	require.True(t, ok)
+	assert.Equal(t, "concurrent", old.Name)
+	assert.True(t, old.Open)
+
+	updated, ok := update.New.(openapi.Ticket)
+	require.True(t, ok)
+	assert.Equal(t, "concurrent", updated.Name)
+	assert.False(t, updated.Open)
+}
+
+func TestService_Group_Admin(t *testing.T) {
+	t.Parallel()
+
+	s := newTestService(t)
+
+	s.hooks.OnRecordBeforeDeleteRequest.Subscribe(func(context.Context, string, any) {
+		t.Error("before hooks must not run for the admin group")
+	})
+	s.hooks.OnRecordBeforeUpdateRequest.Subscribe(func(context.Context, string, any) {
+		t.Error("before hooks must not run for the admin group")
+	})
+
+	var statusErr *statusError
+
+	_, err := s.DeleteGroup(t.Context(), openapi.DeleteGroupRequestObject{Id: "admin"})
+	require.ErrorAs(t, err, &statusErr)
+	assert.Equal(t, http.StatusForbidden, statusErr.status)
+	require.EqualError(t, err, "cannot delete the admin group")
+
+	_, err = s.UpdateGroup(t.Context(), openapi.UpdateGroupRequestObject{Id: "admin", Body: &openapi.GroupUpdate{Name: pointer.Pointer("new")}})
+	require.ErrorAs(t, err, &statusErr)
+	assert.Equal(t, http.StatusForbidden, statusErr.status)
+	require.EqualError(t, err, "cannot update the admin group")
+}
+
+func TestService_UpdateSettings_OIDC(t *testing.T) {
+	t.Parallel()
+
+	s := newTestService(t)
+
+	current, err := s.GetSettings(t.Context(), openapi.GetSettingsRequestObject{})
+	require.NoError(t, err)
+
+	body := openapi.Settings(current.(openapi.GetSettings200JSONResponse))
+	body.Oidc = &openapi.SettingsOidc{
+		Enabled:      true,
+		Issuer:       "https://idp.example.com",
+		ClientId:     "catalyst",
+		ClientSecret: "secret",
+		Scopes:       []string{"openid", "email"},
+		EmailClaim:   "email",
+		NameClaim:    "name",
+		GroupsClaim:  "groups",
+		GroupMapping: map[string]string{"soc-analysts": "analyst"},
+	}
+
+	updated, err := s.UpdateSettings(t.Context(), openapi.UpdateSettingsRequestObject{Body: &body})
+	require.NoError(t, err)
+
+	oidc := updated.(openapi.UpdateSettings200JSONResponse).Oidc
+	assert.Equal(t, "https://idp.example.com", oidc.Issuer)
+	assert.Equal(t, map[string]string{"soc-analysts": "analyst"}, oidc.GroupMapping)
+
+	// settings without oidc keep the current configuration
+	body.Oidc = nil
+
+	updated, err = s.UpdateSettings(t.Context(), openapi.UpdateSettingsRequestObject{Body: &body})
+	require.NoError(t, err)
+	assert.True(t, updated.(openapi.UpdateSettings200JSONResponse).Oidc.Enabled)
+
+	body.Oidc = &openapi.SettingsOidc{Enabled: true, Issuer: "https://idp.example.com", ClientId: "catalyst", GroupMapping: map[string]string{"soc": "missing"}}
+
+	_, err = s.UpdateSettings(t.Context(), openapi.UpdateSettingsRequestObject{Body: &body})
+	require.ErrorContains(t, err, `group "missing" of IdP group "soc" not found`)
+
+	var statusErr *statusError
+	require.ErrorAs(t, err, &statusErr)
+	assert.Equal(t, http.StatusBadRequest, statusErr.status)
+
+	body.Oidc = &openapi.SettingsOidc{Enabled: true}
+
+	_, err = s.UpdateSettings(t.Context(), openapi.UpdateSettingsRequestObject{Body: &body})
+	require.ErrorContains(t, err, "oidc issuer and client id are required")
+	require.ErrorAs(t, err, &statusErr)
+	assert.Equal(t, http.StatusBadRequest, statusErr.status)
+}
+
+func TestService_RunReaction(t *testing.T) {
+	t.Parallel()
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		_, _ = io.Copy(w, r.Body)
+	}))
+	t.Cleanup(server.Close)
+
+	s := newTestService(t)
+
+	reaction, err := s.queries.CreateReaction(t.Context(), sqlc.CreateReactionParams{
+		Name:        "echo",
+		Action:      "webhook",
+		Actiondata:  []byte(`{"url":"` + server.URL + `"}`),
+		Trigger:     "hook",
+		Triggerdata: []byte(`{"collections":["tickets"],"events":["create"]}`),
+	})
+	require.NoError(t, err)
+
+	resp, err := s.RunReaction(t.Context(), openapi.RunReactionRequestObject{
+		Id:   reaction.ID,
+		Body: &map[string]any{"test": true},
+	})
+	require.NoError(t, err)
+
+	result, ok := resp.(openapi.RunReaction200JSONResponse)
+	require.True(t, ok)
+
+	assert.False(t, result.DryRun)
+	assert.Nil(t, result.Error)
+	assert.Contains(t, result.Output, `"body":"{\"test\":true}"`)
+	require.NotNil(t, result.Run)
+
+	run, err := s.queries.GetReactionRun(t.Context(), sqlc.GetReactionRunParams{ID: *result.Run, Reaction: reaction.ID})
+	require.NoError(t, err)
+	assert.Equal(t, "manual", run.Trigger)
+	assert.Equal(t, "success", run.Status)
+	assert.JSONEq(t, `{"test":true}`, string(run.Input))
+
+	// without a body, the action gets the empty payload that is stored
+	resp, err = s.RunReaction(t.Context(), openapi.RunReactionRequestObject{Id: reaction.ID})
+	require.NoError(t, err)
+
+	result, ok = resp.(openapi.RunReaction200JSONResponse)
+	require.True(t, ok)
+	assert.Contains(t, result.Output, `"body":"{}"`)
+
+	run, err = s.queries.GetReactionRun(t.Context(), sqlc.GetReactionRunParams{ID: *result.Run, Reaction: reaction.ID})
+	require.NoError(t, err)
+	assert.JSONEq(t, `{}`, string(run.Input))
+}
+
+func TestService_RunReaction_SelfTrigger(t *testing.T) {
+	t.Parallel()
+
+	s := newTestService(t)
+
+	reaction, err := s.queries.CreateReaction(t.Context(), sqlc.CreateReactionParams{
+		Name:        "Touch",
+		Action:      "update_ticket",
+		Actiondata:  []byte(`{"ticket":"test-ticket","description":"touched","permissions":["ticket:write"]}`),
+		Trigger:     "hook",
+		Triggerdata: []byte(`{"collections":["tickets"],"events":["update"]}`),
+	})
+	require.NoError(t, err)
+
+	// the hook triggers skip the reaction that runs in the context
+	var running []string
+
+	s.hooks.OnRecordAfterUpdateRequest.Subscribe(func(ctx context.Context, _ string, _ any) {
+		reactionID, _ := action.ReactionFromContext(ctx)
+		running = append(running, reactionID)
+	})
+
+	resp, err := s.RunReaction(t.Context(), openapi.RunReactionRequestObject{Id: reaction.ID})
+	require.NoError(t, err)
+
+	result, ok := resp.(openapi.RunReaction200JSONResponse)
+	require.True(t, ok)
+	assert.Nil(t, result.Error)
+	assert.Equal(t, []string{reaction.ID}, running)
+}
+
+func TestService_RunReaction_NotFound(t *testing.T) {
+	t.Parallel()
+
+	s := newTestService(t)
+
+	_, err := s.RunReaction(t.Context(), openapi.RunReactionRequestObject{Id: "missing"})
+
+	var statusErr *statusError
+	require.ErrorAs(t, err, &statusErr)
+	assert.Equal(t, http.StatusNotFound, statusErr.status)
+}
+
+func TestService_RunReaction_DryRun(t *testing.T) {
+	t.Parallel()
+
+	s := newTestService(t)
+
+	dryRun := true
+
+	tests := []struct {
+		name        string
+		action      string
+		actiondata  string
+		trigger     string
+		triggerdata string
+		wantErr     string
+	}{
+		{
+			name:        "valid",
+			action:      "python",
+			actiondata:  `{"script":"print(1)","timeout":10}`,
+			trigger:     "schedule",
+			triggerdata: `{"expression":"*/5 * * * *"}`,
+		},
+		{
+			name:        "unknown action field",
+			action:      "python",
+			actiondata:  `{"script":"print(1)","timout":10}`,
+			trigger:     "webhook",
+			triggerdata: `{"path":"test"}`,
+			wantErr:     `unknown field "timout"`,
+		},
+		{
+			name:        "unknown permission",
+			action:      "python",
+			actiondata:  `{"script":"print(1)","permissions":["ticket:read","user:delete"]}`,
+			trigger:     "webhook",
+			triggerdata: `{"path":"test"}`,
+			wantErr:     `unknown permission "user:delete"`,
+		},
+		{
+			name:        "unknown permission of a native action",
+			action:      "add_comment",
+			actiondata:  `{"ticket":"test-ticket","message":"test","permissions":["ticket:delete"]}`,
+			trigger:     "webhook",
+			triggerdata: `{"path":"test"}`,
+			wantErr:     `unknown permission "ticket:delete"`,
+		},
+		{
+			name:        "unknown action",
+			action:      "shell",
+			actiondata:  `{}`,
+			trigger:     "webhook",
+			triggerdata: `{"path":"test"}`,
+			wantErr:     `action "shell" not found`,
+		},
+		{
+			name:        "invalid condition",
+			action:      "webhook",
+			actiondata:  `{"url":"http://localhost"}`,
+			trigger:     "hook",
+			triggerdata: `{"collections":["tickets"],"events":["create"],"condition":"record.type =="}`,
+			wantErr:     "invalid condition",
+		},
+		{
+			name:        "invalid schedule",
+			action:      "webhook",
+			actiondata:  `{"url":"http://localhost"}`,
+			trigger:     "schedule",
+			triggerdata: `{"expression":"every minute"}`,
+			wantErr:     "invalid schedule expression",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			reaction, err := s.queries.CreateReaction(t.Context(), sqlc.CreateReactionParams{
+				Name:        tt.name,
+				Action:      tt.action,
+				Actiondata:  []byte(tt.actiondata),
+				Trigger:     tt.trigger,
+				Triggerdata: []byte(tt.triggerdata),
+			})
+			require.NoError(t, err)
+
+			resp, err := s.RunReaction(t.Context(), openapi.RunReactionRequestObject{
+				Id:     reaction.ID,
+				Params: openapi.RunReactionParams{DryRun: &dryRun},
+			})
+			require.NoError(t, err)
+
+			result, ok := resp.(openapi.RunReaction200JSONResponse)
+			require.True(t, ok)
+
+			assert.True(t, result.DryRun)
+			assert.Nil(t, result.Run)
+
+			if tt.wantErr == "" {
+				assert.Nil(t, result.Error)
+			} else {
+				require.NotNil(t, result.Error)
+				assert.Contains(t, *result.Error, tt.wantErr)
+			}
+
+			runs, err := s.queries.ListReactionRuns(t.Context(), sqlc.ListReactionRunsParams{Reaction: reaction.ID, Limit: 10})
+			require.NoError(t, err)
+			assert.Empty(t, runs)
+		})
+	}
+}
+
+func TestService_Secrets(t *testing.T) {
+	t.Parallel()
+
+	s := newTestService(t)
+
+	createResp, err := s.CreateSecret(t.Context(), openapi.CreateSecretRequestObject{
+		Body: &openapi.CreateSecretJSONRequestBody{Name: "VT_API_KEY", Value: "vt-key"},
+	})
+	require.NoError(t, err)
+
+	created, ok := createResp.(openapi.CreateSecret200JSONResponse)
+	require.True(t, ok)
+	assert.Equal(t, "VT_API_KEY", created.Name)
+
+	stored, err := s.queries.GetSecret(t.Context(), created.Id)
+	require.NoError(t, err)
+	assert.NotContains(t, string(stored.Value), "vt-key")
+
+	_, err = s.UpdateSecret(t.Context(), openapi.UpdateSecretRequestObject{
+		Id:   created.Id,
+		Body: &openapi.UpdateSecretJSONRequestBody{Value: pointer.Pointer("new-key")},
+	})
+	require.NoError(t, err)
+
+	env, err := s.secrets.Env(t.Context(), s.queries, []string{"VT_API_KEY"})
+	require.NoError(t, err)
+	assert.Equal(t, []string{"VT_API_KEY=new-key"}, env)
+
+	listResp, err := s.ListSecrets(t.Context(), openapi.ListSecretsRequestObject{})
+	require.NoError(t, err)
+
+	list, ok := listResp.(openapi.ListSecrets200JSONResponse)
+	require.True(t, ok)
+	require.Len(t, list.Body, 1)
+	assert.Equal(t, created.Id, list.Body[0].Id)
+
+	_, err = s.CreateSecret(t.Context(), openapi.CreateSecretRequestObject{
+		Body: &openapi.CreateSecretJSONRequestBody{Name: "CATALYST_TOKEN", Value: "x"},
+	})
+	assert.ErrorContains(t, err, "reserved")
+
+	_, err = s.DeleteSecret(t.Context(), openapi.DeleteSecretRequestObject{Id: created.Id})
+	require.NoError(t, err)
+
+	_, err = s.GetSecret(t.Context(), openapi.GetSecretRequestObject{Id: created.Id})
+	assert.Error(t, err)
+}
+
+func Test_jsonError(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name       string
+		err        error
+		wantStatus int
+		wantBody   string
+	}{
+		{
+			name:       "internal error",
+			err:        errors.New("database locked"),
+			wantStatus: http.StatusInternalServerError,
+			wantBody:   `{"status":500,"error":"An internal error occurred","message":"database locked"}`,
+		},
+		{
+			name:       "rejected",
+			err:        fmt.Errorf("wrapped: %w", hook.Reject(http.StatusUnprocessableEntity, "resolution required")),
+			wantStatus: http.StatusUnprocessableEntity,
+			wantBody:   `{"status":422,"error":"Unprocessable Entity","message":"wrapped: resolution required"}`,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			rec := httptest.NewRecorder()
+			jsonError(rec, httptest.NewRequest(http.MethodGet, "/", nil), tt.err)
+
+			assert.Equal(t, tt.wantStatus, rec.Code)
+			assert.JSONEq(t, tt.wantBody, rec.Body.String())
+		})
+	}
+}
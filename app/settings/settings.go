@@ -17,6 +17,7 @@ type Settings struct {
 	RecordAuthToken          TokenConfig `json:"recordAuthToken"`
 	RecordPasswordResetToken TokenConfig `json:"recordPasswordResetToken"`
 	RecordVerificationToken  TokenConfig `json:"recordVerificationToken"`
+	OIDC                     OIDC        `json:"oidc"`
 }
 
 type Meta struct {
@@ -43,6 +44,24 @@ type SMTP struct {
 	LocalName  string `json:"localName"`
 }
 
+// OIDC configures the single sign-on login with an OpenID Connect provider.
+// Users are created on their first login. The IdP groups found in the groups
+// claim are mapped onto Catalyst groups with GroupMapping. Existing users are
+// only signed in if LinkExistingUsers is set, as the IdP could otherwise take
+// over any local account by its email.
+type OIDC struct {
+	Enabled           bool              `json:"enabled"`
+	Issuer            string            `json:"issuer"`
+	ClientID          string            `json:"clientId"`
+	ClientSecret      string            `json:"clientSecret"`
+	Scopes            []string          `json:"scopes"`
+	EmailClaim        string            `json:"emailClaim"`
+	NameClaim         string            `json:"nameClaim"`
+	GroupsClaim       string            `json:"groupsClaim"`
+	GroupMapping      map[string]string `json:"groupMapping"`
+	LinkExistingUsers bool              `json:"linkExistingUsers"`
+}
+
 type TokenConfig struct {
 	Secret   string `json:"secret"`
 	Duration int    `json:"duration"`
@@ -118,6 +137,13 @@ func initSettings(ctx context.Context, queries *sqlc.Queries) (*Settings, error)
 			Secret:   rand.Text(),
 			Duration: 604800, // 7 days
 		},
+		OIDC: OIDC{
+			Scopes:       []string{"openid", "profile", "email"},
+			EmailClaim:   "email",
+			NameClaim:    "name",
+			GroupsClaim:  "groups",
+			GroupMapping: map[string]string{},
+		},
 	}
 
 	b, err := json.Marshal(s)
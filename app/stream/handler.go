@@ -0,0 +1,223 @@
+package stream
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+	"net/http"
+	"slices"
+	"strconv"
+	"time"
+
+	"github.com/SecurityBrewery/catalyst/app/auth"
+	"github.com/SecurityBrewery/catalyst/app/auth/usercontext"
+	"github.com/SecurityBrewery/catalyst/app/database"
+	"github.com/SecurityBrewery/catalyst/app/database/sqlc"
+)
+
+const (
+	Path = "/api/events"
+
+	heartbeatInterval = 30 * time.Second
+	replayPageSize    = 100
+	retryMillis       = 3000
+)
+
+// readPermissions maps the collections to the permission that is required
+// to receive their events.
+var readPermissions = map[string]string{
+	database.TicketsTable.ID:   auth.TicketReadPermission,
+	database.CommentsTable.ID:  auth.TicketReadPermission,
+	database.TasksTable.ID:     auth.TicketReadPermission,
+	database.TimelinesTable.ID: auth.TicketReadPermission,
+	database.LinksTable.ID:     auth.TicketReadPermission,
+	database.FilesTable.ID:     auth.FileReadPermission,
+	database.TypesTable.ID:     auth.TypeReadPermission,
+	database.UsersTable.ID:     auth.UserReadPermission,
+	database.GroupsTable.ID:    auth.GroupReadPermission,
+	database.ReactionsTable.ID: auth.ReactionReadPermission,
+	database.WebhooksTable.ID:  auth.WebhookReadPermission,
+}
+
+// filter selects the events of a stream. Empty fields match all events.
+type filter struct {
+	collections []string
+	record      string
+	ticket      string
+	permissions []string
+}
+
+func (f *filter) match(event *sqlc.Event) bool {
+	permission, ok := readPermissions[event.Collection]
+	if !ok || !auth.HasScope(f.permissions, []string{permission}) {
+		return false
+	}
+
+	if len(f.collections) > 0 && !slices.Contains(f.collections, event.Collection) {
+		return false
+	}
+
+	if f.record != "" && event.Record != f.record {
+		return false
+	}
+
+	if f.ticket != "" && (event.Ticket == nil || *event.Ticket != f.ticket) {
+		return false
+	}
+
+	return true
+}
+
+// ServeHTTP streams the events as Server-Sent Events. The events can be
+// filtered with the query parameters collection (repeatable), record and
+// ticket. Only events of collections the caller can read are sent. If the
+// Last-Event-ID header is set, the missed events are sent first. The stream
+// ends when the token of the caller is no longer valid, e.g. after a logout.
+func (b *Broker) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	permissions, _ := usercontext.PermissionFromContext(ctx)
+
+	query := r.URL.Query()
+
+	f := &filter{
+		collections: query["collection"],
+		record:      query.Get("record"),
+		ticket:      query.Get("ticket"),
+		permissions: permissions,
+	}
+
+	var lastID int64
+
+	if header := r.Header.Get("Last-Event-ID"); header != "" {
+		id, err := strconv.ParseInt(header, 10, 64)
+		if err != nil {
+			http.Error(w, "invalid Last-Event-ID", http.StatusBadRequest)
+
+			return
+		}
+
+		lastID = id
+	}
+
+	ch := b.subscribe()
+	defer b.unsubscribe(ch)
+
+	rc := http.NewResponseController(w)
+
+	w.Header().Set("Content-Type", "text/event-stream")
+	w.Header().Set("Cache-Control", "no-cache")
+	w.Header().Set("Connection", "keep-alive")
+	w.Header().Set("X-Accel-Buffering", "no")
+	w.WriteHeader(http.StatusOK)
+
+	if _, err := fmt.Fprintf(w, "retry: %d\n\n", retryMillis); err != nil {
+		return
+	}
+
+	if err := rc.Flush(); err != nil {
+		slog.ErrorContext(ctx, "event stream does not support flushing", "error", err)
+
+		return
+	}
+
+	if r.Header.Get("Last-Event-ID") != "" {
+		var err error
+
+		if lastID, err = b.replay(ctx, w, f, lastID); err != nil {
+			slog.ErrorContext(ctx, "failed to replay events", "error", err)
+
+			return
+		}
+	}
+
+	ticker := time.NewTicker(b.heartbeat)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case event, ok := <-ch:
+			if !ok {
+				return
+			}
+
+			// the event was already sent by the replay
+			if event.ID <= lastID {
+				continue
+			}
+
+			lastID = event.ID
+
+			if !f.match(event) {
+				continue
+			}
+
+			if err := write(w, event); err != nil {
+				return
+			}
+		case <-ticker.C:
+			// the session may have been revoked or the token may have
+			// expired since the stream was opened
+			permissions, err := auth.Verify(ctx, b.queries, r)
+			if err != nil {
+				slog.InfoContext(ctx, "closing event stream", "reason", err)
+
+				return
+			}
+
+			f.permissions = permissions
+
+			if _, err := fmt.Fprint(w, ": ping\n\n"); err != nil {
+				return
+			}
+		}
+
+		if err := rc.Flush(); err != nil {
+			return
+		}
+	}
+}
+
+// replay sends the persisted events after the given ID and returns the ID
+// of the last event.
+func (b *Broker) replay(ctx context.Context, w http.ResponseWriter, f *filter, after int64) (int64, error) {
+	rc := http.NewResponseController(w)
+
+	for {
+		events, err := b.queries.ListEventsAfter(ctx, sqlc.ListEventsAfterParams{
+			After: after,
+			Limit: replayPageSize,
+		})
+		if err != nil {
+			return after, err
+		}
+
+		for i := range events {
+			after = events[i].ID
+
+			if !f.match(&events[i]) {
+				continue
+			}
+
+			if err := write(w, &events[i]); err != nil {
+				return after, err
+			}
+		}
+
+		if err := rc.Flush(); err != nil {
+			return after, err
+		}
+
+		if len(events) < replayPageSize {
+			return after, nil
+		}
+	}
+}
+
+func write(w http.ResponseWriter, event *sqlc.Event) error {
+	_, err := fmt.Fprintf(w, "id: %d\ndata: %s\n\n", event.ID, event.Payload)
+
+	return err
+}
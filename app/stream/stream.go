@@ -0,0 +1,213 @@
+package stream
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"log/slog"
+	"sync"
+	"time"
+
+	"github.com/SecurityBrewery/catalyst/app/auth/usercontext"
+	"github.com/SecurityBrewery/catalyst/app/database"
+	"github.com/SecurityBrewery/catalyst/app/database/sqlc"
+	"github.com/SecurityBrewery/catalyst/app/hook"
+	"github.com/SecurityBrewery/catalyst/app/webhook"
+)
+
+const (
+	retention        = 24 * time.Hour
+	pruneInterval    = 10 * time.Minute
+	subscriberBuffer = 64
+)
+
+// Broker streams record changes to connected clients. Every change is
+// persisted in the events table first, so clients can resume a stream with
+// the Last-Event-ID header after a reconnect. Events are kept for 24 hours.
+type Broker struct {
+	queries *sqlc.Queries
+
+	// heartbeat is the interval of the keep-alive comments, the token of a
+	// stream is verified again at the same interval
+	heartbeat time.Duration
+
+	mu          sync.Mutex
+	subscribers map[chan *sqlc.Event]struct{}
+
+	cancel context.CancelFunc
+	wg     sync.WaitGroup
+}
+
+func NewBroker(queries *sqlc.Queries) *Broker {
+	return &Broker{
+		queries:     queries,
+		heartbeat:   heartbeatInterval,
+		subscribers: map[chan *sqlc.Event]struct{}{},
+	}
+}
+
+func BindHooks(hooks *hook.Hooks, broker *Broker) {
+	hooks.OnRecordAfterCreateRequest.Subscribe(func(ctx context.Context, table string, record any) {
+		broker.publish(ctx, database.CreateAction, table, record)
+	})
+	hooks.OnRecordAfterUpdateRequest.Subscribe(func(ctx context.Context, table string, record any) {
+		broker.publish(ctx, database.UpdateAction, table, record)
+	})
+	hooks.OnRecordAfterDeleteRequest.Subscribe(func(ctx context.Context, table string, record any) {
+		broker.publish(ctx, database.DeleteAction, table, record)
+	})
+}
+
+// Start prunes the event log periodically.
+func (b *Broker) Start(ctx context.Context) {
+	ctx, b.cancel = context.WithCancel(context.WithoutCancel(ctx))
+
+	b.wg.Add(1)
+
+	go b.prune(ctx)
+}
+
+// Stop stops pruning and closes all streams.
+func (b *Broker) Stop() {
+	if b.cancel != nil {
+		b.cancel()
+		b.wg.Wait()
+	}
+
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	for ch := range b.subscribers {
+		delete(b.subscribers, ch)
+		close(ch)
+	}
+}
+
+func (b *Broker) prune(ctx context.Context) {
+	defer b.wg.Done()
+
+	ticker := time.NewTicker(pruneInterval)
+	defer ticker.Stop()
+
+	for {
+		if err := b.queries.DeleteEventsBefore(ctx, time.Now().UTC().Add(-retention)); err != nil && ctx.Err() == nil {
+			slog.ErrorContext(ctx, "failed to prune events", "error", err)
+		}
+
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+func (b *Broker) publish(ctx context.Context, action, collection string, record any) {
+	user, _ := usercontext.UserFromContext(ctx)
+
+	payload, err := webhook.NewPayload(action, collection, record, user)
+	if err != nil {
+		slog.ErrorContext(ctx, "failed to create event payload", "error", err)
+
+		return
+	}
+
+	data, err := json.Marshal(payload)
+	if err != nil {
+		slog.ErrorContext(ctx, "failed to marshal event payload", "error", err)
+
+		return
+	}
+
+	recordID, ticket, err := ids(collection, payload.Record)
+	if err != nil {
+		slog.ErrorContext(ctx, "failed to get record id", "error", err)
+
+		return
+	}
+
+	// the lock keeps the order of the event IDs and the order in which the
+	// events are sent to the subscribers the same
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	event, err := b.queries.CreateEvent(context.WithoutCancel(ctx), sqlc.CreateEventParams{
+		Action:     action,
+		Collection: collection,
+		Record:     recordID,
+		Ticket:     ticket,
+		Payload:    data,
+		Now:        time.Now().UTC(),
+	})
+	if err != nil {
+		slog.ErrorContext(ctx, "failed to create event", "error", err)
+
+		return
+	}
+
+	for ch := range b.subscribers {
+		select {
+		case ch <- &event:
+		default:
+			// the client is too slow, it resumes from the event log after
+			// reconnecting
+			delete(b.subscribers, ch)
+			close(ch)
+		}
+	}
+}
+
+func (b *Broker) subscribe() chan *sqlc.Event {
+	ch := make(chan *sqlc.Event, subscriberBuffer)
+
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	b.subscribers[ch] = struct{}{}
+
+	return ch
+}
+
+func (b *Broker) unsubscribe(ch chan *sqlc.Event) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	if _, ok := b.subscribers[ch]; ok {
+		delete(b.subscribers, ch)
+		close(ch)
+	}
+}
+
+// ids returns the ID of the record and the ID of the ticket it belongs to.
+func ids(collection string, record any) (string, *string, error) {
+	if id, ok := record.(string); ok {
+		if collection == database.TicketsTable.ID {
+			return id, &id, nil
+		}
+
+		return id, nil, nil
+	}
+
+	b, err := json.Marshal(record)
+	if err != nil {
+		return "", nil, err
+	}
+
+	var r struct {
+		ID     string `json:"id"`
+		Ticket string `json:"ticket"`
+	}
+
+	if err := json.Unmarshal(b, &r); err != nil {
+		return "", nil, fmt.Errorf("failed to unmarshal record: %w", err)
+	}
+
+	switch {
+	case collection == database.TicketsTable.ID:
+		return r.ID, &r.ID, nil
+	case r.Ticket != "":
+		return r.ID, &r.Ticket, nil
+	default:
+		return r.ID, nil, nil
+	}
+}
@@ -0,0 +1,271 @@
+package stream
+
+import (
+	"bufio"
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/SecurityBrewery/catalyst/app/auth"
+	"github.com/SecurityBrewery/catalyst/app/auth/usercontext"
+	"github.com/SecurityBrewery/catalyst/app/data"
+	"github.com/SecurityBrewery/catalyst/app/database/sqlc"
+	"github.com/SecurityBrewery/catalyst/app/openapi"
+	"github.com/SecurityBrewery/catalyst/app/pointer"
+)
+
+func Test_ids(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name       string
+		collection string
+		record     any
+		wantID     string
+		wantTicket *string
+	}{
+		{
+			name:       "ticket",
+			collection: "tickets",
+			record:     openapi.Ticket{Id: "test-ticket"},
+			wantID:     "test-ticket",
+			wantTicket: pointer.Pointer("test-ticket"),
+		},
+		{
+			name:       "comment",
+			collection: "comments",
+			record:     openapi.ExtendedComment{Id: "c_test", Ticket: "test-ticket"},
+			wantID:     "c_test",
+			wantTicket: pointer.Pointer("test-ticket"),
+		},
+		{
+			name:       "type",
+			collection: "types",
+			record:     openapi.Type{Id: "alert"},
+			wantID:     "alert",
+			wantTicket: nil,
+		},
+		{
+			name:       "id only",
+			collection: "links",
+			record:     "l_test",
+			wantID:     "l_test",
+			wantTicket: nil,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			id, ticket, err := ids(tt.collection, tt.record)
+			require.NoError(t, err)
+
+			assert.Equal(t, tt.wantID, id)
+			assert.Equal(t, tt.wantTicket, ticket)
+		})
+	}
+}
+
+func Test_filter_match(t *testing.T) {
+	t.Parallel()
+
+	comment := &sqlc.Event{Collection: "comments", Record: "c_test", Ticket: pointer.Pointer("test-ticket")}
+	user := &sqlc.Event{Collection: "users", Record: "u_test"}
+
+	read := []string{auth.TicketReadPermission}
+
+	assert.True(t, (&filter{permissions: read}).match(comment))
+	assert.False(t, (&filter{permissions: read}).match(user), "missing permission")
+	assert.False(t, (&filter{}).match(comment), "no permissions")
+	assert.True(t, (&filter{permissions: []string{"admin"}}).match(user), "admin can read everything")
+
+	assert.True(t, (&filter{permissions: read, collections: []string{"tasks", "comments"}}).match(comment))
+	assert.False(t, (&filter{permissions: read, collections: []string{"tasks"}}).match(comment))
+
+	assert.True(t, (&filter{permissions: read, record: "c_test"}).match(comment))
+	assert.False(t, (&filter{permissions: read, record: "c_other"}).match(comment))
+
+	assert.True(t, (&filter{permissions: read, ticket: "test-ticket"}).match(comment))
+	assert.False(t, (&filter{permissions: read, ticket: "other-ticket"}).match(comment))
+}
+
+func newTestServer(t *testing.T) (*Broker, *httptest.Server) {
+	t.Helper()
+
+	broker := NewBroker(data.NewTestDB(t, t.TempDir()))
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		broker.ServeHTTP(w, usercontext.PermissionRequest(r, []string{auth.TicketReadPermission}))
+	}))
+
+	t.Cleanup(func() {
+		broker.Stop()
+		server.Close()
+	})
+
+	return broker, server
+}
+
+// open connects to the stream and returns a function that reads the next
+// event.
+func open(t *testing.T, url, lastEventID string) func() (string, string) {
+	t.Helper()
+
+	ctx, cancel := context.WithTimeout(t.Context(), 10*time.Second)
+	t.Cleanup(cancel)
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, url, nil)
+	require.NoError(t, err)
+
+	if lastEventID != "" {
+		req.Header.Set("Last-Event-ID", lastEventID)
+	}
+
+	res, err := http.DefaultClient.Do(req)
+	require.NoError(t, err)
+	t.Cleanup(func() { res.Body.Close() })
+
+	require.Equal(t, http.StatusOK, res.StatusCode)
+	require.Equal(t, "text/event-stream", res.Header.Get("Content-Type"))
+
+	scanner := bufio.NewScanner(res.Body)
+
+	return func() (string, string) {
+		var id string
+
+		for scanner.Scan() {
+			line := scanner.Text()
+
+			switch {
+			case strings.HasPrefix(line, "id: "):
+				id = strings.TrimPrefix(line, "id: ")
+			case strings.HasPrefix(line, "data: "):
+				return id, strings.TrimPrefix(line, "data: ")
+			}
+		}
+
+		require.NoError(t, scanner.Err())
+
+		return "", ""
+	}
+}
+
+func TestBroker_ServeHTTP(t *testing.T) {
+	t.Parallel()
+
+	broker, server := newTestServer(t)
+
+	next := open(t, server.URL+"?ticket=test-ticket", "")
+
+	// wait until the stream is subscribed
+	require.Eventually(t, func() bool {
+		broker.mu.Lock()
+		defer broker.mu.Unlock()
+
+		return len(broker.subscribers) == 1
+	}, 5*time.Second, 10*time.Millisecond)
+
+	broker.publish(t.Context(), "create", "types", openapi.Type{Id: "alert"})
+	broker.publish(t.Context(), "create", "comments", openapi.ExtendedComment{Id: "c_other", Ticket: "other-ticket"})
+	broker.publish(t.Context(), "create", "comments", openapi.ExtendedComment{Id: "c_test", Ticket: "test-ticket", Message: "hello"})
+
+	id, data := next()
+	assert.Equal(t, "3", id)
+	assert.JSONEq(t, `{"action":"create","collection":"comments","record":{"id":"c_test","ticket":"test-ticket","message":"hello","author":"","author_name":"","created":"0001-01-01T00:00:00Z","updated":"0001-01-01T00:00:00Z"}}`, data)
+
+	// resume after the type event
+	next = open(t, server.URL, "1")
+
+	id, _ = next()
+	assert.Equal(t, "2", id)
+
+	id, _ = next()
+	assert.Equal(t, "3", id)
+
+	broker.publish(t.Context(), "delete", "comments", openapi.ExtendedComment{Id: "c_test", Ticket: "test-ticket"})
+
+	id, data = next()
+	assert.Equal(t, "4", id)
+	assert.Contains(t, data, `"action":"delete"`)
+}
+
+func TestBroker_ServeHTTP_invalidLastEventID(t *testing.T) {
+	t.Parallel()
+
+	_, server := newTestServer(t)
+
+	req, err := http.NewRequestWithContext(t.Context(), http.MethodGet, server.URL, nil)
+	require.NoError(t, err)
+
+	req.Header.Set("Last-Event-ID", "abc")
+
+	res, err := http.DefaultClient.Do(req)
+	require.NoError(t, err)
+	defer res.Body.Close()
+
+	assert.Equal(t, http.StatusBadRequest, res.StatusCode)
+}
+
+func TestBroker_ServeHTTP_revokedSession(t *testing.T) {
+	t.Parallel()
+
+	queries := data.NewTestDB(t, t.TempDir())
+
+	broker := NewBroker(queries)
+	broker.heartbeat = 10 * time.Millisecond
+
+	server := httptest.NewServer(auth.Middleware(queries)(broker))
+	t.Cleanup(func() {
+		broker.Stop()
+		server.Close()
+	})
+
+	user, err := queries.GetUser(t.Context(), "u_bob_analyst")
+	require.NoError(t, err)
+
+	token, err := auth.CreateAccessToken(t.Context(), &user, []string{auth.TicketReadPermission}, time.Minute, queries)
+	require.NoError(t, err)
+
+	ctx, cancel := context.WithTimeout(t.Context(), 10*time.Second)
+	t.Cleanup(cancel)
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, server.URL, nil)
+	require.NoError(t, err)
+
+	req.Header.Set("Authorization", "Bearer "+token)
+
+	res, err := http.DefaultClient.Do(req)
+	require.NoError(t, err)
+	t.Cleanup(func() { res.Body.Close() })
+
+	require.Equal(t, http.StatusOK, res.StatusCode)
+
+	scanner := bufio.NewScanner(res.Body)
+
+	// the stream is kept open while the session is active
+	var pings int
+
+	for pings < 2 && scanner.Scan() {
+		if scanner.Text() == ": ping" {
+			pings++
+		}
+	}
+
+	require.Equal(t, 2, pings)
+
+	require.NoError(t, auth.RevokeSessions(t.Context(), queries, user.ID))
+
+	// the stream ends at the next heartbeat
+	for scanner.Scan() {
+		// skip the pings that were sent before the revocation
+	}
+
+	require.NoError(t, scanner.Err())
+	require.NoError(t, ctx.Err())
+}
@@ -0,0 +1,85 @@
+package webhook
+
+import (
+	"encoding/json"
+	"reflect"
+)
+
+// Change is the old and new value of a changed field.
+type Change struct {
+	Old any `json:"old"`
+	New any `json:"new"`
+}
+
+// Diff returns the changed fields between two records, keyed by their path,
+// e.g. "owner" or "state.severity". Nested objects are compared field by
+// field. Top level fields that are missing in the new record are unchanged,
+// as update requests only contain the fields to change.
+func Diff(oldRecord, newRecord any) (map[string]Change, error) {
+	oldFields, err := toMap(oldRecord)
+	if err != nil {
+		return nil, err
+	}
+
+	newFields, err := toMap(newRecord)
+	if err != nil {
+		return nil, err
+	}
+
+	changes := map[string]Change{}
+
+	for key, value := range newFields {
+		diff(changes, key, oldFields[key], value)
+	}
+
+	return changes, nil
+}
+
+func diff(changes map[string]Change, path string, oldValue, newValue any) {
+	oldMap, oldIsMap := oldValue.(map[string]any)
+	newMap, newIsMap := newValue.(map[string]any)
+
+	// a missing object is compared like an empty one, so that setting the
+	// state for the first time reports the single fields
+	if oldValue == nil && newIsMap {
+		oldIsMap = true
+	}
+
+	if newValue == nil && oldIsMap {
+		newIsMap = true
+	}
+
+	if !oldIsMap || !newIsMap {
+		if !reflect.DeepEqual(oldValue, newValue) {
+			changes[path] = Change{Old: oldValue, New: newValue}
+		}
+
+		return
+	}
+
+	for key, value := range newMap {
+		diff(changes, path+"."+key, oldMap[key], value)
+	}
+
+	for key, value := range oldMap {
+		if _, ok := newMap[key]; !ok {
+			changes[path+"."+key] = Change{Old: value, New: nil}
+		}
+	}
+}
+
+// toMap converts a record to its JSON representation, so records of
+// different types can be compared by their JSON fields.
+func toMap(record any) (map[string]any, error) {
+	b, err := json.Marshal(record)
+	if err != nil {
+		return nil, err
+	}
+
+	var m map[string]any
+	if err := json.Unmarshal(b, &m); err != nil {
+		return nil, err
+	}
+
+	return m, nil
+}
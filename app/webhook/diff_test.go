@@ -0,0 +1,95 @@
+package webhook
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/SecurityBrewery/catalyst/app/database/sqlc"
+	"github.com/SecurityBrewery/catalyst/app/hook"
+	"github.com/SecurityBrewery/catalyst/app/openapi"
+	"github.com/SecurityBrewery/catalyst/app/pointer"
+)
+
+func TestDiff(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name      string
+		oldRecord any
+		newRecord any
+		want      map[string]Change
+	}{
+		{
+			name:      "unchanged",
+			oldRecord: map[string]any{"name": "a", "open": true},
+			newRecord: map[string]any{"name": "a", "open": true},
+			want:      map[string]Change{},
+		},
+		{
+			name:      "changed field",
+			oldRecord: map[string]any{"name": "a", "owner": "u_bob"},
+			newRecord: map[string]any{"name": "a", "owner": "u_alice"},
+			want:      map[string]Change{"owner": {Old: "u_bob", New: "u_alice"}},
+		},
+		{
+			name:      "missing fields are unchanged",
+			oldRecord: map[string]any{"name": "a", "owner": "u_bob"},
+			newRecord: map[string]any{"name": "b"},
+			want:      map[string]Change{"name": {Old: "a", New: "b"}},
+		},
+		{
+			name:      "nested",
+			oldRecord: map[string]any{"state": map[string]any{"severity": "Low", "tlp": "White", "old": true}},
+			newRecord: map[string]any{"state": map[string]any{"severity": "High", "tlp": "White"}},
+			want: map[string]Change{
+				"state.severity": {Old: "Low", New: "High"},
+				"state.old":      {Old: true, New: nil},
+			},
+		},
+		{
+			name:      "new object",
+			oldRecord: map[string]any{"state": nil},
+			newRecord: map[string]any{"state": map[string]any{"severity": "High"}},
+			want:      map[string]Change{"state.severity": {Old: nil, New: "High"}},
+		},
+		{
+			name:      "no old record",
+			oldRecord: nil,
+			newRecord: map[string]any{"name": "a"},
+			want:      map[string]Change{"name": {Old: nil, New: "a"}},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			got, err := Diff(tt.oldRecord, tt.newRecord)
+			require.NoError(t, err)
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
+
+func TestNewPayload(t *testing.T) {
+	t.Parallel()
+
+	oldTicket := openapi.Ticket{Id: "test-ticket", Name: "phishing", Owner: pointer.Pointer("u_bob_analyst")}
+	newTicket := openapi.Ticket{Id: "test-ticket", Name: "phishing", Owner: pointer.Pointer("u_admin")}
+
+	payload, err := NewPayload("update", "tickets", &hook.Update{Old: oldTicket, New: newTicket}, &sqlc.User{ID: "u_admin"})
+	require.NoError(t, err)
+
+	assert.Equal(t, newTicket, payload.Record)
+	assert.Equal(t, oldTicket, payload.OldRecord)
+	assert.Equal(t, map[string]Change{"owner": {Old: "u_bob_analyst", New: "u_admin"}}, payload.Changes)
+	assert.Equal(t, "u_admin", payload.Auth.ID)
+
+	payload, err = NewPayload("create", "tickets", newTicket, nil)
+	require.NoError(t, err)
+
+	assert.Equal(t, newTicket, payload.Record)
+	assert.Nil(t, payload.OldRecord)
+	assert.Nil(t, payload.Changes)
+}
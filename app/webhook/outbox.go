@@ -0,0 +1,222 @@
+package webhook
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"log/slog"
+	"net/http"
+	"time"
+
+	"github.com/SecurityBrewery/catalyst/app/cloudevents"
+	"github.com/SecurityBrewery/catalyst/app/database/sqlc"
+	"github.com/SecurityBrewery/catalyst/app/pointer"
+	"github.com/SecurityBrewery/catalyst/app/settings"
+	"github.com/SecurityBrewery/catalyst/app/worker"
+)
+
+const (
+	defaultWorkers  = 2
+	pruneInterval   = time.Hour
+	retention       = 30 * 24 * time.Hour
+	requestTimeout  = 30 * time.Second
+	maxResponseBody = 1 << 10
+)
+
+// Outbox delivers outgoing webhooks. Deliveries are persisted in the
+// webhook_deliveries table before they are sent, so they survive restarts
+// and downtime of the receiver. Failed deliveries are retried with
+// exponential backoff until they run out of attempts and are marked as
+// failed. Delivered and failed deliveries are kept for 30 days.
+type Outbox struct {
+	queries *sqlc.Queries
+	client  *http.Client
+	pool    *worker.Pool
+}
+
+func NewOutbox(queries *sqlc.Queries) *Outbox {
+	o := &Outbox{
+		queries: queries,
+		client:  &http.Client{Timeout: requestTimeout},
+	}
+
+	o.pool = worker.NewPool(o.next)
+
+	return o
+}
+
+// Start requeues deliveries that were interrupted by a previous shutdown
+// and starts the workers.
+func (o *Outbox) Start(ctx context.Context) error {
+	if err := o.queries.RequeueRunningWebhookDeliveries(ctx, worker.Now()); err != nil {
+		return fmt.Errorf("failed to requeue running deliveries: %w", err)
+	}
+
+	o.pool.Start(ctx, defaultWorkers, worker.Task{Interval: pruneInterval, Run: o.prune})
+
+	return nil
+}
+
+// Stop cancels all running deliveries and waits for the workers to exit.
+func (o *Outbox) Stop() {
+	o.pool.Stop()
+}
+
+// Enqueue persists a new delivery of the payload to the webhook.
+func (o *Outbox) Enqueue(ctx context.Context, webhookID, event, collection string, payload []byte) (*sqlc.WebhookDelivery, error) {
+	delivery, err := o.queries.CreateWebhookDelivery(ctx, sqlc.CreateWebhookDeliveryParams{
+		Webhook:    webhookID,
+		Event:      event,
+		Collection: collection,
+		Payload:    payload,
+		Now:        worker.Now(),
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to enqueue delivery: %w", err)
+	}
+
+	o.pool.Wake()
+
+	return &delivery, nil
+}
+
+// prune deletes the deliveries that were delivered or failed permanently
+// before the retention period.
+func (o *Outbox) prune(ctx context.Context) {
+	if err := o.queries.DeleteFinishedWebhookDeliveries(ctx, worker.Now().Add(-retention)); err != nil && ctx.Err() == nil {
+		slog.ErrorContext(ctx, "failed to prune webhook deliveries", "error", err)
+	}
+}
+
+// next claims and sends a single delivery. It reports whether a delivery
+// was found.
+func (o *Outbox) next(ctx context.Context) bool {
+	delivery, ok := worker.Claim(ctx, "webhook delivery", o.queries.ClaimWebhookDelivery)
+	if !ok {
+		return false
+	}
+
+	o.process(ctx, &delivery)
+
+	return true
+}
+
+func (o *Outbox) process(ctx context.Context, delivery *sqlc.WebhookDelivery) {
+	res, err := o.send(ctx, delivery)
+
+	if err != nil && ctx.Err() != nil {
+		// the outbox is shutting down, the delivery is requeued on the next start
+		return
+	}
+
+	params := sqlc.FinishWebhookDeliveryParams{
+		ID:          delivery.ID,
+		Status:      "delivered",
+		NextAttempt: delivery.NextAttempt,
+		Now:         worker.Now(),
+	}
+
+	if res != nil {
+		params.ResponseStatus = pointer.Pointer(int64(res.status))
+		params.ResponseBody = pointer.Pointer(res.body)
+	}
+
+	switch {
+	case err == nil:
+		slog.InfoContext(ctx, "webhook delivered", "delivery_id", delivery.ID, "webhook_id", delivery.Webhook, "event", delivery.Event, "collection", delivery.Collection)
+	case delivery.Attempts >= delivery.MaxAttempts:
+		slog.ErrorContext(ctx, "webhook delivery failed permanently", "error", err, "delivery_id", delivery.ID, "webhook_id", delivery.Webhook, "attempts", delivery.Attempts)
+
+		params.Status = "failed"
+		params.LastError = pointer.Pointer(err.Error())
+	default:
+		params.Status = "pending"
+		params.LastError = pointer.Pointer(err.Error())
+		params.NextAttempt = worker.Now().Add(worker.Backoff(delivery.Attempts))
+
+		slog.WarnContext(ctx, "webhook delivery failed, retrying", "error", err, "delivery_id", delivery.ID, "webhook_id", delivery.Webhook, "attempts", delivery.Attempts, "next_attempt", params.NextAttempt)
+	}
+
+	if err := o.queries.FinishWebhookDelivery(context.WithoutCancel(ctx), params); err != nil {
+		slog.ErrorContext(ctx, "failed to update webhook delivery", "error", err, "delivery_id", delivery.ID)
+	}
+}
+
+type response struct {
+	status int
+	body   string
+}
+
+// send posts the signed payload to the destination of the webhook. The
+// response is returned even if the status indicates a failure.
+func (o *Outbox) send(ctx context.Context, delivery *sqlc.WebhookDelivery) (*response, error) {
+	webhook, err := o.queries.GetWebhook(ctx, delivery.Webhook)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get webhook %s: %w", delivery.Webhook, err)
+	}
+
+	body, formatHeader, err := o.encode(ctx, &webhook, delivery)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, webhook.Destination, bytes.NewReader(body))
+	if err != nil {
+		return nil, err
+	}
+
+	var headers map[string]string
+	if err := json.Unmarshal([]byte(webhook.Headers), &headers); err != nil {
+		return nil, fmt.Errorf("failed to unmarshal headers: %w", err)
+	}
+
+	setHeaders(req.Header, headers, webhook.Secret, delivery.ID, delivery.Event, worker.Now(), body)
+
+	for key, values := range formatHeader {
+		req.Header[key] = values
+	}
+
+	resp, err := o.client.Do(req)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+
+	b, err := io.ReadAll(io.LimitReader(resp.Body, maxResponseBody))
+	if err != nil {
+		return nil, fmt.Errorf("failed to read response: %w", err)
+	}
+
+	res := &response{status: resp.StatusCode, body: string(b)}
+
+	if resp.StatusCode < 200 || resp.StatusCode >= 300 {
+		return res, fmt.Errorf("unexpected status %d", resp.StatusCode)
+	}
+
+	return res, nil
+}
+
+// encode returns the body in the format of the webhook and the headers of
+// the format. CloudEvents use the delivery ID as event ID, so redeliveries
+// are new events.
+func (o *Outbox) encode(ctx context.Context, webhook *sqlc.Webhook, delivery *sqlc.WebhookDelivery) ([]byte, http.Header, error) {
+	if webhook.Format == "" || webhook.Format == cloudevents.FormatCatalyst {
+		return delivery.Payload, nil, nil
+	}
+
+	s, err := settings.Load(ctx, o.queries)
+	if err != nil {
+		return nil, nil, fmt.Errorf("failed to load settings: %w", err)
+	}
+
+	header := http.Header{}
+
+	body, err := cloudevents.New(delivery.ID, s.Meta.AppURL, delivery.Created, delivery.Payload).Encode(webhook.Format, header)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return body, header, nil
+}
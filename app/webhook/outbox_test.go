@@ -0,0 +1,212 @@
+package webhook
+
+import (
+	"database/sql"
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strconv"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/SecurityBrewery/catalyst/app/data"
+	"github.com/SecurityBrewery/catalyst/app/database/sqlc"
+	"github.com/SecurityBrewery/catalyst/app/pointer"
+	"github.com/SecurityBrewery/catalyst/app/worker"
+)
+
+func newTestOutbox(t *testing.T, handler http.HandlerFunc) (*Outbox, *sqlc.Queries, string) {
+	t.Helper()
+
+	server := httptest.NewServer(handler)
+	t.Cleanup(server.Close)
+
+	queries := data.NewTestDB(t, t.TempDir())
+
+	webhook, err := queries.CreateWebhook(t.Context(), sqlc.CreateWebhookParams{
+		Name:        "test",
+		Collection:  "tickets",
+		Destination: server.URL,
+		Events:      "[]",
+		Secret:      "whsec_test",
+		Headers:     `{"Authorization":"Bearer key"}`,
+		Format:      "catalyst",
+	})
+	require.NoError(t, err)
+
+	return NewOutbox(queries), queries, webhook.ID
+}
+
+func TestOutbox_process(t *testing.T) {
+	t.Parallel()
+
+	var (
+		body   string
+		header http.Header
+	)
+
+	outbox, queries, webhookID := newTestOutbox(t, func(w http.ResponseWriter, r *http.Request) {
+		b, _ := io.ReadAll(r.Body)
+		body = string(b)
+		header = r.Header
+
+		w.WriteHeader(http.StatusAccepted)
+		_, _ = w.Write([]byte("ok"))
+	})
+
+	_, err := outbox.Enqueue(t.Context(), webhookID, "create", "tickets", []byte(`{"action":"create"}`))
+	require.NoError(t, err)
+
+	require.True(t, outbox.next(t.Context()))
+	assert.False(t, outbox.next(t.Context()))
+
+	assert.JSONEq(t, `{"action":"create"}`, body)
+
+	timestamp, err := strconv.ParseInt(header.Get(TimestampHeader), 10, 64)
+	require.NoError(t, err)
+
+	assert.Equal(t, Sign("whsec_test", time.Unix(timestamp, 0), []byte(body)), header.Get(SignatureHeader))
+	assert.Equal(t, "create", header.Get(EventHeader))
+	assert.Equal(t, "Bearer key", header.Get("Authorization"))
+
+	deliveries, err := queries.ListWebhookDeliveries(t.Context(), sqlc.ListWebhookDeliveriesParams{Webhook: webhookID, Limit: 10})
+	require.NoError(t, err)
+	require.Len(t, deliveries, 1)
+
+	assert.Equal(t, "delivered", deliveries[0].Status)
+	assert.Equal(t, int64(1), deliveries[0].Attempts)
+	assert.Equal(t, int64(http.StatusAccepted), *deliveries[0].ResponseStatus)
+	assert.Equal(t, "ok", *deliveries[0].ResponseBody)
+	assert.Nil(t, deliveries[0].LastError)
+	assert.Equal(t, deliveries[0].ID, header.Get(DeliveryHeader))
+}
+
+func TestOutbox_process_cloudevents(t *testing.T) {
+	t.Parallel()
+
+	var (
+		body   string
+		header http.Header
+	)
+
+	outbox, queries, webhookID := newTestOutbox(t, func(w http.ResponseWriter, r *http.Request) {
+		b, _ := io.ReadAll(r.Body)
+		body = string(b)
+		header = r.Header
+
+		w.WriteHeader(http.StatusOK)
+	})
+
+	_, err := queries.UpdateWebhook(t.Context(), sqlc.UpdateWebhookParams{ID: webhookID, Format: pointer.Pointer("cloudevents")})
+	require.NoError(t, err)
+
+	delivery, err := outbox.Enqueue(t.Context(), webhookID, "create", "tickets", []byte(`{"action":"create","collection":"tickets","record":{"id":"test-ticket"}}`))
+	require.NoError(t, err)
+
+	require.True(t, outbox.next(t.Context()))
+
+	var event map[string]any
+	require.NoError(t, json.Unmarshal([]byte(body), &event))
+
+	assert.Equal(t, "application/cloudevents+json", header.Get("Content-Type"))
+	assert.Equal(t, delivery.ID, event["id"])
+	assert.Equal(t, "catalyst.tickets.create", event["type"])
+	assert.Equal(t, "test-ticket", event["subject"])
+	assert.NotEmpty(t, event["source"])
+
+	timestamp, err := strconv.ParseInt(header.Get(TimestampHeader), 10, 64)
+	require.NoError(t, err)
+
+	assert.Equal(t, Sign("whsec_test", time.Unix(timestamp, 0), []byte(body)), header.Get(SignatureHeader))
+}
+
+func TestOutbox_process_retry(t *testing.T) {
+	t.Parallel()
+
+	outbox, queries, webhookID := newTestOutbox(t, func(w http.ResponseWriter, _ *http.Request) {
+		http.Error(w, "maintenance", http.StatusServiceUnavailable)
+	})
+
+	delivery, err := outbox.Enqueue(t.Context(), webhookID, "update", "tickets", []byte(`{}`))
+	require.NoError(t, err)
+
+	require.True(t, outbox.next(t.Context()))
+
+	got, err := queries.GetWebhookDelivery(t.Context(), sqlc.GetWebhookDeliveryParams{ID: delivery.ID, Webhook: webhookID})
+	require.NoError(t, err)
+
+	assert.Equal(t, "pending", got.Status)
+	assert.Equal(t, int64(http.StatusServiceUnavailable), *got.ResponseStatus)
+	assert.Equal(t, "maintenance\n", *got.ResponseBody)
+	assert.Equal(t, "unexpected status 503", *got.LastError)
+	assert.WithinDuration(t, time.Now().Add(worker.Backoff(1)), got.NextAttempt, 5*time.Second)
+
+	// not due yet
+	assert.False(t, outbox.next(t.Context()))
+
+	got.Attempts = got.MaxAttempts
+
+	outbox.process(t.Context(), &got)
+
+	got, err = queries.GetWebhookDelivery(t.Context(), sqlc.GetWebhookDeliveryParams{ID: delivery.ID, Webhook: webhookID})
+	require.NoError(t, err)
+	assert.Equal(t, "failed", got.Status)
+}
+
+func TestOutbox_redeliver(t *testing.T) {
+	t.Parallel()
+
+	outbox, queries, webhookID := newTestOutbox(t, func(w http.ResponseWriter, _ *http.Request) {
+		w.WriteHeader(http.StatusOK)
+	})
+
+	delivery, err := outbox.Enqueue(t.Context(), webhookID, "delete", "tickets", []byte(`{"action":"delete"}`))
+	require.NoError(t, err)
+
+	require.True(t, outbox.next(t.Context()))
+
+	redelivery, err := queries.RedeliverWebhookDelivery(t.Context(), sqlc.RedeliverWebhookDeliveryParams{ID: delivery.ID, Webhook: webhookID, Now: worker.Now()})
+	require.NoError(t, err)
+
+	assert.NotEqual(t, delivery.ID, redelivery.ID)
+	assert.Equal(t, "pending", redelivery.Status)
+	assert.Equal(t, "delete", redelivery.Event)
+	assert.JSONEq(t, `{"action":"delete"}`, string(redelivery.Payload))
+
+	require.True(t, outbox.next(t.Context()))
+}
+
+func TestOutbox_prune(t *testing.T) {
+	t.Parallel()
+
+	outbox, queries, webhookID := newTestOutbox(t, func(w http.ResponseWriter, _ *http.Request) {
+		w.WriteHeader(http.StatusOK)
+	})
+
+	ids := map[string]string{}
+
+	for _, status := range []string{"delivered", "failed", "pending"} {
+		delivery, err := outbox.Enqueue(t.Context(), webhookID, "create", "tickets", []byte(`{}`))
+		require.NoError(t, err)
+
+		_, err = queries.WriteDB.ExecContext(t.Context(), "UPDATE webhook_deliveries SET status = ?, updated = ? WHERE id = ?", status, worker.Now().Add(-retention-time.Hour), delivery.ID)
+		require.NoError(t, err)
+
+		ids[status] = delivery.ID
+	}
+
+	outbox.prune(t.Context())
+
+	for status, id := range ids {
+		_, err := queries.GetWebhookDelivery(t.Context(), sqlc.GetWebhookDeliveryParams{ID: id, Webhook: webhookID})
+		if status == "pending" {
+			require.NoError(t, err, "pending deliveries are kept")
+		} else {
+			require.ErrorIs(t, err, sql.ErrNoRows, "%s deliveries are pruned", status)
+		}
+	}
+}
@@ -0,0 +1,61 @@
+package webhook
+
+import (
+	"crypto/hmac"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/hex"
+	"net/http"
+	"strconv"
+	"time"
+)
+
+// Headers of outgoing webhooks. The signature is the hex encoded
+// HMAC-SHA256 of "<timestamp>.<body>" with the secret of the webhook,
+// prefixed with "sha256=". Receivers should check that the timestamp is
+// recent to prevent replays.
+const (
+	SignatureHeader = "X-Catalyst-Signature"
+	TimestampHeader = "X-Catalyst-Timestamp"
+	DeliveryHeader  = "X-Catalyst-Delivery"
+	EventHeader     = "X-Catalyst-Event"
+
+	secretPrefix = "whsec_"
+)
+
+// GenerateSecret returns a new random secret to sign payloads.
+func GenerateSecret() (string, error) {
+	b := make([]byte, 32)
+	if _, err := rand.Read(b); err != nil {
+		return "", err
+	}
+
+	return secretPrefix + hex.EncodeToString(b), nil
+}
+
+// Sign returns the signature of the payload at the given time.
+func Sign(secret string, timestamp time.Time, payload []byte) string {
+	mac := hmac.New(sha256.New, []byte(secret))
+	mac.Write([]byte(strconv.FormatInt(timestamp.Unix(), 10)))
+	mac.Write([]byte("."))
+	mac.Write(payload)
+
+	return "sha256=" + hex.EncodeToString(mac.Sum(nil))
+}
+
+// setHeaders sets the custom headers of the webhook and the signature
+// headers. The custom headers cannot override the signature headers.
+func setHeaders(header http.Header, headers map[string]string, secret, deliveryID, event string, timestamp time.Time, payload []byte) {
+	for key, value := range headers {
+		header.Set(key, value)
+	}
+
+	header.Set("Content-Type", "application/json")
+	header.Set(DeliveryHeader, deliveryID)
+	header.Set(EventHeader, event)
+
+	if secret != "" {
+		header.Set(TimestampHeader, strconv.FormatInt(timestamp.Unix(), 10))
+		header.Set(SignatureHeader, Sign(secret, timestamp, payload))
+	}
+}
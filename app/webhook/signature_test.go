@@ -0,0 +1,61 @@
+package webhook
+
+import (
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"net/http"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestGenerateSecret(t *testing.T) {
+	t.Parallel()
+
+	a, err := GenerateSecret()
+	require.NoError(t, err)
+
+	b, err := GenerateSecret()
+	require.NoError(t, err)
+
+	assert.True(t, strings.HasPrefix(a, "whsec_"))
+	assert.Len(t, a, len("whsec_")+64)
+	assert.NotEqual(t, a, b)
+}
+
+func TestSign(t *testing.T) {
+	t.Parallel()
+
+	timestamp := time.Unix(1700000000, 0)
+
+	mac := hmac.New(sha256.New, []byte("whsec_test"))
+	mac.Write([]byte(`1700000000.{"action":"create"}`))
+
+	assert.Equal(t, "sha256="+hex.EncodeToString(mac.Sum(nil)), Sign("whsec_test", timestamp, []byte(`{"action":"create"}`)))
+	assert.NotEqual(t, Sign("whsec_test", timestamp, []byte(`{}`)), Sign("whsec_test", timestamp.Add(time.Second), []byte(`{}`)))
+	assert.NotEqual(t, Sign("whsec_test", timestamp, []byte(`{}`)), Sign("whsec_other", timestamp, []byte(`{}`)))
+}
+
+func Test_setHeaders(t *testing.T) {
+	t.Parallel()
+
+	timestamp := time.Unix(1700000000, 0)
+	header := http.Header{}
+
+	setHeaders(header, map[string]string{
+		"Authorization": "Bearer key",
+		"Content-Type":  "text/plain",
+		SignatureHeader: "forged",
+	}, "whsec_test", "d_1", "update", timestamp, []byte(`{}`))
+
+	assert.Equal(t, "Bearer key", header.Get("Authorization"))
+	assert.Equal(t, "application/json", header.Get("Content-Type"))
+	assert.Equal(t, "d_1", header.Get(DeliveryHeader))
+	assert.Equal(t, "update", header.Get(EventHeader))
+	assert.Equal(t, "1700000000", header.Get(TimestampHeader))
+	assert.Equal(t, Sign("whsec_test", timestamp, []byte(`{}`)), header.Get(SignatureHeader))
+}
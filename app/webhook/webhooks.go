@@ -1,16 +1,18 @@
 package webhook
 
 import (
-	"bytes"
 	"context"
 	"encoding/json"
 	"fmt"
-	"io"
 	"log/slog"
-	"net/http"
+	"net/url"
+	"slices"
+	"strings"
 	"time"
 
 	"github.com/SecurityBrewery/catalyst/app/auth/usercontext"
+	"github.com/SecurityBrewery/catalyst/app/cloudevents"
+	"github.com/SecurityBrewery/catalyst/app/condition"
 	"github.com/SecurityBrewery/catalyst/app/database"
 	"github.com/SecurityBrewery/catalyst/app/database/sqlc"
 	"github.com/SecurityBrewery/catalyst/app/hook"
@@ -23,24 +25,53 @@ type Webhook struct {
 	Destination string `db:"destination" json:"destination"`
 }
 
-func BindHooks(hooks *hook.Hooks, queries *sqlc.Queries) {
+func BindHooks(hooks *hook.Hooks, queries *sqlc.Queries, outbox *Outbox) {
 	hooks.OnRecordAfterCreateRequest.Subscribe(func(ctx context.Context, table string, record any) {
-		event(ctx, queries, database.CreateAction, table, record)
+		event(ctx, queries, outbox, database.CreateAction, table, record)
 	})
 	hooks.OnRecordAfterUpdateRequest.Subscribe(func(ctx context.Context, table string, record any) {
-		event(ctx, queries, database.UpdateAction, table, record)
+		event(ctx, queries, outbox, database.UpdateAction, table, record)
 	})
 	hooks.OnRecordAfterDeleteRequest.Subscribe(func(ctx context.Context, table string, record any) {
-		event(ctx, queries, database.DeleteAction, table, record)
+		event(ctx, queries, outbox, database.DeleteAction, table, record)
 	})
 }
 
+// Payload is the body of outgoing webhooks and the payload of hook
+// reactions. OldRecord and Changes are only set for updates.
 type Payload struct {
-	Action     string    `json:"action"`
-	Collection string    `json:"collection"`
-	Record     any       `json:"record"`
-	Auth       *AuthUser `json:"auth,omitempty"`
-	Admin      *AuthUser `json:"admin,omitempty"`
+	Action     string            `json:"action"`
+	Collection string            `json:"collection"`
+	Record     any               `json:"record"`
+	OldRecord  any               `json:"old_record,omitempty"`
+	Changes    map[string]Change `json:"changes,omitempty"`
+	Auth       *AuthUser         `json:"auth,omitempty"`
+	Admin      *AuthUser         `json:"admin,omitempty"`
+}
+
+// NewPayload returns the payload for a record event. If the record is a
+// hook.Update, the payload contains the old record and the changed fields.
+func NewPayload(action, collection string, record any, auth *sqlc.User) (*Payload, error) {
+	payload := &Payload{
+		Action:     action,
+		Collection: collection,
+		Record:     record,
+		Auth:       SanitizeUser(auth),
+		Admin:      nil,
+	}
+
+	if update, ok := record.(*hook.Update); ok {
+		changes, err := Diff(update.Old, update.New)
+		if err != nil {
+			return nil, fmt.Errorf("failed to diff records: %w", err)
+		}
+
+		payload.Record = update.New
+		payload.OldRecord = update.Old
+		payload.Changes = changes
+	}
+
+	return payload, nil
 }
 
 type AuthUser struct {
@@ -75,7 +106,7 @@ func SanitizeUser(user *sqlc.User) *AuthUser {
 	}
 }
 
-func event(ctx context.Context, queries *sqlc.Queries, event, collection string, record any) {
+func event(ctx context.Context, queries *sqlc.Queries, outbox *Outbox, event, collection string, record any) {
 	user, ok := usercontext.UserFromContext(ctx)
 	if !ok {
 		slog.ErrorContext(ctx, "failed to get auth session")
@@ -83,8 +114,8 @@ func event(ctx context.Context, queries *sqlc.Queries, event, collection string,
 		return
 	}
 
-	webhooks, err := database.PaginateItems(ctx, func(ctx context.Context, offset, limit int64) ([]sqlc.ListWebhooksRow, error) {
-		return queries.ListWebhooks(ctx, sqlc.ListWebhooksParams{Limit: limit, Offset: offset})
+	webhooks, err := database.PaginateItems(ctx, func(ctx context.Context, offset, limit int64) ([]sqlc.ListWebhooksByCollectionRow, error) {
+		return queries.ListWebhooksByCollection(ctx, sqlc.ListWebhooksByCollectionParams{Collection: collection, Limit: limit, Offset: offset})
 	})
 	if err != nil {
 		slog.ErrorContext(ctx, "failed to list webhooks", "error", err.Error())
@@ -96,44 +127,104 @@ func event(ctx context.Context, queries *sqlc.Queries, event, collection string,
 		return
 	}
 
-	payload, err := json.Marshal(&Payload{
-		Action:     event,
-		Collection: collection,
-		Record:     record,
-		Auth:       SanitizeUser(user),
-		Admin:      nil,
-	})
+	p, err := NewPayload(event, collection, record, user)
+	if err != nil {
+		slog.ErrorContext(ctx, "failed to create payload", "error", err.Error())
+
+		return
+	}
+
+	payload, err := json.Marshal(p)
 	if err != nil {
 		slog.ErrorContext(ctx, "failed to marshal payload", "error", err.Error())
 
 		return
 	}
 
+	vars, err := condition.Vars(collection, event, payload)
+	if err != nil {
+		slog.ErrorContext(ctx, "failed to create filter variables", "error", err.Error())
+
+		return
+	}
+
 	for _, webhook := range webhooks {
-		if err := sendWebhook(ctx, webhook, payload); err != nil {
-			slog.ErrorContext(ctx, "failed to send webhook", "action", event, "name", webhook.Name, "collection", webhook.Collection, "destination", webhook.Destination, "error", err.Error())
-		} else {
-			slog.InfoContext(ctx, "webhook sent", "action", event, "name", webhook.Name, "collection", webhook.Collection, "destination", webhook.Destination)
+		matched, err := matches(webhook, event, vars)
+		if err != nil {
+			slog.WarnContext(ctx, "failed to check webhook filter", "name", webhook.Name, "error", err.Error())
+
+			continue
+		}
+
+		if !matched {
+			continue
+		}
+
+		if _, err := outbox.Enqueue(ctx, webhook.ID, event, collection, payload); err != nil {
+			slog.ErrorContext(ctx, "failed to enqueue webhook", "action", event, "name", webhook.Name, "collection", webhook.Collection, "destination", webhook.Destination, "error", err.Error())
 		}
 	}
 }
 
-func sendWebhook(ctx context.Context, webhook sqlc.ListWebhooksRow, payload []byte) error {
-	req, _ := http.NewRequestWithContext(ctx, http.MethodPost, webhook.Destination, bytes.NewReader(payload))
-	req.Header.Set("Content-Type", "application/json")
+// matches reports whether the webhook subscribed to the event and its
+// filter matches the payload. Webhooks without events get all events.
+func matches(webhook sqlc.ListWebhooksByCollectionRow, event string, vars map[string]any) (bool, error) {
+	var events []string
+	if err := json.Unmarshal([]byte(webhook.Events), &events); err != nil {
+		return false, fmt.Errorf("failed to unmarshal events: %w", err)
+	}
 
-	resp, err := http.DefaultClient.Do(req)
-	if err != nil {
-		return err
+	if len(events) > 0 && !slices.Contains(events, event) {
+		return false, nil
 	}
 
-	defer resp.Body.Close()
+	return condition.Match(webhook.Filter, vars)
+}
+
+// Validate checks the destination, the custom headers, the events, the
+// filter and the payload format of a webhook. An empty destination is not
+// checked, e.g. if an update does not change it.
+func Validate(destination string, headers map[string]string, events []string, filter, format string) error {
+	if destination != "" {
+		u, err := url.Parse(destination)
+		if err != nil || (u.Scheme != "http" && u.Scheme != "https") || u.Host == "" {
+			return fmt.Errorf("invalid destination %q, must be an http or https URL", destination)
+		}
+	}
 
-	if resp.StatusCode < 200 || resp.StatusCode >= 300 {
-		b, _ := io.ReadAll(resp.Body)
+	for key, value := range headers {
+		if key == "" || strings.IndexFunc(key, invalidHeaderRune) != -1 {
+			return fmt.Errorf("invalid header name %q", key)
+		}
 
-		return fmt.Errorf("failed to send webhook: %s", string(b))
+		if strings.ContainsAny(value, "\r\n\x00") {
+			return fmt.Errorf("invalid value of header %q", key)
+		}
 	}
 
-	return nil
+	if err := cloudevents.ValidateFormat(format); err != nil {
+		return err
+	}
+
+	for _, event := range events {
+		if !slices.Contains([]string{database.CreateAction, database.UpdateAction, database.DeleteAction}, event) {
+			return fmt.Errorf("invalid event %q, must be create, update or delete", event)
+		}
+	}
+
+	if filter == "" {
+		return nil
+	}
+
+	return condition.Validate(filter)
+}
+
+// invalidHeaderRune reports whether r is not allowed in a header name.
+func invalidHeaderRune(r rune) bool {
+	switch {
+	case r >= 'a' && r <= 'z', r >= 'A' && r <= 'Z', r >= '0' && r <= '9':
+		return false
+	default:
+		return !strings.ContainsRune("!#$%&'*+-.^_`|~", r)
+	}
 }
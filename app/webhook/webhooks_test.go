@@ -0,0 +1,65 @@
+package webhook
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/SecurityBrewery/catalyst/app/condition"
+	"github.com/SecurityBrewery/catalyst/app/database/sqlc"
+)
+
+func Test_matches(t *testing.T) {
+	t.Parallel()
+
+	vars, err := condition.Vars("tickets", "update", json.RawMessage(`{"record": {"type": "alert"}}`))
+	require.NoError(t, err)
+
+	tests := []struct {
+		name    string
+		events  string
+		filter  string
+		want    bool
+		wantErr bool
+	}{
+		{name: "all events", events: `[]`, want: true},
+		{name: "event", events: `["create","update"]`, want: true},
+		{name: "other event", events: `["delete"]`, want: false},
+		{name: "filter", events: `[]`, filter: `record.type == "alert"`, want: true},
+		{name: "filter no match", events: `[]`, filter: `record.type == "incident"`, want: false},
+		{name: "invalid filter", events: `[]`, filter: `record.type ==`, wantErr: true},
+		{name: "invalid events", events: ``, wantErr: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			got, err := matches(sqlc.ListWebhooksByCollectionRow{Events: tt.events, Filter: tt.filter}, "update", vars)
+			if tt.wantErr {
+				require.Error(t, err)
+
+				return
+			}
+
+			require.NoError(t, err)
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
+
+func TestValidate(t *testing.T) {
+	t.Parallel()
+
+	require.NoError(t, Validate("", nil, nil, "", ""))
+	require.NoError(t, Validate("https://example.com/hook", map[string]string{"Authorization": "Bearer x"}, []string{"create", "delete"}, `record.type == "alert"`, "cloudevents"))
+
+	require.EqualError(t, Validate("ftp://example.com", nil, nil, "", ""), `invalid destination "ftp://example.com", must be an http or https URL`)
+	require.EqualError(t, Validate("example.com", nil, nil, "", ""), `invalid destination "example.com", must be an http or https URL`)
+	require.EqualError(t, Validate("", map[string]string{"X Header": "x"}, nil, "", ""), `invalid header name "X Header"`)
+	require.EqualError(t, Validate("", map[string]string{"X-Header": "x\r\nX-Injected: y"}, nil, "", ""), `invalid value of header "X-Header"`)
+	require.EqualError(t, Validate("", nil, []string{"view"}, "", ""), `invalid event "view", must be create, update or delete`)
+	require.ErrorContains(t, Validate("", nil, nil, `record.type ==`, ""), "invalid condition")
+	require.EqualError(t, Validate("", nil, nil, "", "xml"), `invalid format "xml", must be catalyst, cloudevents or cloudevents-binary`)
+}
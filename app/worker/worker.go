@@ -0,0 +1,160 @@
+// Package worker drains the SQLite backed queues of Catalyst, i.e. the
+// reaction queue and the webhook outbox, with a fixed pool of workers.
+package worker
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"log/slog"
+	"sync"
+	"time"
+)
+
+const (
+	pollInterval = time.Second
+	baseBackoff  = 10 * time.Second
+	maxBackoff   = time.Hour
+)
+
+// Task runs periodically while the pool is running, e.g. to prune old
+// items of the queue.
+type Task struct {
+	Interval time.Duration
+	Run      func(ctx context.Context)
+}
+
+// Pool runs workers that process the items of a queue with next, which
+// reports whether an item was found. Idle workers poll the queue every
+// second or when they are woken up by Wake.
+type Pool struct {
+	next func(ctx context.Context) bool
+	wake chan struct{}
+
+	cancel context.CancelFunc
+	wg     sync.WaitGroup
+}
+
+func NewPool(next func(ctx context.Context) bool) *Pool {
+	return &Pool{
+		next: next,
+		wake: make(chan struct{}, 1),
+	}
+}
+
+// Start starts the workers and the tasks. They keep running when ctx is
+// canceled and are only stopped by Stop.
+func (p *Pool) Start(ctx context.Context, workers int, tasks ...Task) {
+	ctx, p.cancel = context.WithCancel(context.WithoutCancel(ctx))
+
+	for range workers {
+		p.wg.Add(1)
+
+		go p.work(ctx)
+	}
+
+	for _, task := range tasks {
+		p.wg.Add(1)
+
+		go p.run(ctx, task)
+	}
+}
+
+// Stop cancels the running items and waits for the workers to exit. It is
+// safe to call Stop on a pool that was never started.
+func (p *Pool) Stop() {
+	if p.cancel == nil {
+		return
+	}
+
+	p.cancel()
+	p.wg.Wait()
+}
+
+// Wake wakes up an idle worker, e.g. after a new item was enqueued.
+func (p *Pool) Wake() {
+	select {
+	case p.wake <- struct{}{}:
+	default:
+	}
+}
+
+func (p *Pool) work(ctx context.Context) {
+	defer p.wg.Done()
+
+	ticker := time.NewTicker(pollInterval)
+	defer ticker.Stop()
+
+	for {
+		if p.next(ctx) {
+			continue
+		}
+
+		select {
+		case <-ctx.Done():
+			return
+		case <-p.wake:
+		case <-ticker.C:
+		}
+	}
+}
+
+func (p *Pool) run(ctx context.Context, task Task) {
+	defer p.wg.Done()
+
+	ticker := time.NewTicker(task.Interval)
+	defer ticker.Stop()
+
+	for {
+		task.Run(ctx)
+
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+// Claim claims the next due item of a queue with the claim query. It
+// reports false if no item is due or if the claim failed, which is logged.
+func Claim[T any](ctx context.Context, name string, claim func(ctx context.Context, now time.Time) (T, error)) (T, bool) {
+	var zero T
+
+	if ctx.Err() != nil {
+		return zero, false
+	}
+
+	item, err := claim(ctx, Now())
+	if err != nil {
+		if !errors.Is(err, sql.ErrNoRows) && ctx.Err() == nil {
+			slog.ErrorContext(ctx, "failed to claim "+name, "error", err)
+		}
+
+		return zero, false
+	}
+
+	return item, true
+}
+
+// Backoff returns the delay before the next attempt of an item that failed
+// the given number of attempts. It doubles with each attempt, starting at
+// 10 seconds, up to an hour.
+func Backoff(attempts int64) time.Duration {
+	delay := baseBackoff
+
+	for range attempts - 1 {
+		delay *= 2
+
+		if delay >= maxBackoff {
+			return maxBackoff
+		}
+	}
+
+	return delay
+}
+
+// Now returns the current time in UTC, as stored in the queues.
+func Now() time.Time {
+	return time.Now().UTC()
+}
@@ -0,0 +1,74 @@
+package worker
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestPool(t *testing.T) {
+	t.Parallel()
+
+	var items, pruned atomic.Int64
+
+	items.Store(3)
+
+	pool := NewPool(func(context.Context) bool {
+		return items.Add(-1) >= 0
+	})
+
+	pool.Start(t.Context(), 2, Task{Interval: time.Hour, Run: func(context.Context) { pruned.Add(1) }})
+	t.Cleanup(pool.Stop)
+
+	assert.Eventually(t, func() bool { return items.Load() < 0 && pruned.Load() == 1 }, time.Second, 10*time.Millisecond)
+
+	// a woken up worker processes new items before the next poll
+	items.Store(1)
+	pool.Wake()
+
+	assert.Eventually(t, func() bool { return items.Load() < 0 }, 500*time.Millisecond, 10*time.Millisecond)
+}
+
+func TestPool_StopWithoutStart(t *testing.T) {
+	t.Parallel()
+
+	NewPool(func(context.Context) bool { return false }).Stop()
+}
+
+func TestClaim(t *testing.T) {
+	t.Parallel()
+
+	item, ok := Claim(t.Context(), "item", func(context.Context, time.Time) (string, error) { return "job", nil })
+	assert.True(t, ok)
+	assert.Equal(t, "job", item)
+
+	_, ok = Claim(t.Context(), "item", func(context.Context, time.Time) (string, error) { return "", sql.ErrNoRows })
+	assert.False(t, ok)
+
+	_, ok = Claim(t.Context(), "item", func(context.Context, time.Time) (string, error) { return "", errors.New("database is locked") })
+	assert.False(t, ok)
+
+	ctx, cancel := context.WithCancel(t.Context())
+	cancel()
+
+	_, ok = Claim(ctx, "item", func(context.Context, time.Time) (string, error) {
+		t.Fatal("no items are claimed after the context is canceled")
+
+		return "", nil
+	})
+	assert.False(t, ok)
+}
+
+func TestBackoff(t *testing.T) {
+	t.Parallel()
+
+	assert.Equal(t, 10*time.Second, Backoff(1))
+	assert.Equal(t, 20*time.Second, Backoff(2))
+	assert.Equal(t, 80*time.Second, Backoff(4))
+	assert.Equal(t, time.Hour, Backoff(20))
+}
@@ -70,6 +70,30 @@ func main() {
 					{Name: "set-password", Action: adminSetPassword},
 				},
 			},
+			{
+				Name:  "venv",
+				Usage: "Manage cached Python environments",
+				Commands: []*cli.Command{
+					{Name: "list", Action: venvList},
+					{Name: "prewarm", Usage: "Create the environment for a requirements file", Action: venvPrewarm},
+					{Name: "purge", Usage: "Remove one or all environments", Action: venvPurge},
+				},
+			},
+			{
+				Name:  "changes",
+				Usage: "Export the change feed",
+				Commands: []*cli.Command{
+					{
+						Name:  "tail",
+						Usage: "Print the changes after a cursor as NDJSON",
+						Flags: []cli.Flag{
+							&cli.Int64Flag{Name: "after", Usage: "Sequence number of the last received change"},
+							&cli.BoolFlag{Name: "follow", Aliases: []string{"f"}, Usage: "Wait for new changes"},
+						},
+						Action: changesTail,
+					},
+				},
+			},
 		},
 	}
 
@@ -111,6 +135,10 @@ func serve(ctx context.Context, command *cli.Command) error {
 
 	defer cleanup()
 
+	if err := catalyst.Start(ctx); err != nil {
+		return fmt.Errorf("failed to start catalyst: %w", err)
+	}
+
 	addr := command.String("http")
 
 	server := &http.Server{
@@ -0,0 +1,109 @@
+package testing
+
+import (
+	"bytes"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/SecurityBrewery/catalyst/app/data"
+	"github.com/SecurityBrewery/catalyst/app/openapi"
+)
+
+func TestChangesCollection(t *testing.T) {
+	t.Parallel()
+
+	testSets := []catalystTest{
+		{
+			baseTest: baseTest{
+				Name:   "ListChanges",
+				Method: http.MethodGet,
+				URL:    "/api/changes?after=0",
+			},
+			userTests: []userTest{
+				{
+					Name:            "Unauthorized",
+					ExpectedStatus:  http.StatusUnauthorized,
+					ExpectedContent: []string{`"invalid bearer token"`},
+					ExpectedEvents:  map[string]int{},
+				},
+				{
+					Name:            "Analyst",
+					AuthRecord:      data.AnalystEmail,
+					ExpectedStatus:  http.StatusUnauthorized,
+					ExpectedContent: []string{`"missing required scopes"`},
+				},
+				{
+					Name:            "Admin",
+					Admin:           data.AdminEmail,
+					ExpectedStatus:  http.StatusOK,
+					ExpectedContent: []string{`[]`},
+					ExpectedEvents:  map[string]int{},
+				},
+			},
+		},
+	}
+	for _, testSet := range testSets {
+		t.Run(testSet.baseTest.Name, func(t *testing.T) {
+			t.Parallel()
+
+			for _, userTest := range testSet.userTests {
+				t.Run(userTest.Name, func(t *testing.T) {
+					t.Parallel()
+
+					runMatrixTest(t, testSet.baseTest, userTest)
+				})
+			}
+		})
+	}
+}
+
+func TestChanges_Comments(t *testing.T) {
+	t.Parallel()
+
+	catalyst, cleanup, _ := App(t)
+	t.Cleanup(cleanup)
+
+	admin := accessToken(t, catalyst, data.AdminEmail)
+
+	createReq := httptest.NewRequest(http.MethodPost, "/api/comments", bytes.NewBufferString(`{"ticket":"test-ticket","author":"u_admin","message":"exported comment"}`))
+	createReq.Header.Set("Authorization", "Bearer "+admin)
+	createReq.Header.Set("Content-Type", "application/json")
+
+	createRec := httptest.NewRecorder()
+	catalyst.ServeHTTP(createRec, createReq)
+
+	require.Equal(t, http.StatusOK, createRec.Code)
+
+	listReq := httptest.NewRequest(http.MethodGet, "/api/changes?after=0", nil)
+	listReq.Header.Set("Authorization", "Bearer "+admin)
+
+	listRec := httptest.NewRecorder()
+	catalyst.ServeHTTP(listRec, listReq)
+
+	require.Equal(t, http.StatusOK, listRec.Code)
+
+	var changes []openapi.Change
+	require.NoError(t, json.Unmarshal(listRec.Body.Bytes(), &changes))
+	require.Len(t, changes, 1)
+
+	assert.Equal(t, int64(1), changes[0].Seq)
+	assert.Equal(t, "comments", changes[0].Collection)
+	assert.Equal(t, "create", changes[0].Action)
+	assert.Equal(t, "u_admin", *changes[0].Actor)
+	assert.Equal(t, "exported comment", changes[0].Snapshot["message"])
+
+	// the cursor skips changes that were already exported
+	listReq = httptest.NewRequest(http.MethodGet, "/api/changes?after=1", nil)
+	listReq.Header.Set("Authorization", "Bearer "+admin)
+
+	listRec = httptest.NewRecorder()
+	catalyst.ServeHTTP(listRec, listReq)
+
+	require.Equal(t, http.StatusOK, listRec.Code)
+	assert.JSONEq(t, `[]`, listRec.Body.String())
+}
@@ -0,0 +1,202 @@
+package testing
+
+import (
+	"bufio"
+	"bytes"
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/SecurityBrewery/catalyst/app"
+	"github.com/SecurityBrewery/catalyst/app/auth"
+	"github.com/SecurityBrewery/catalyst/app/data"
+)
+
+func TestEvents(t *testing.T) {
+	t.Parallel()
+
+	testSets := []catalystTest{
+		{
+			baseTest: baseTest{
+				Name:   "StreamEvents",
+				Method: http.MethodGet,
+				URL:    "/api/events",
+			},
+			userTests: []userTest{
+				{
+					Name:            "Unauthorized",
+					ExpectedStatus:  http.StatusUnauthorized,
+					ExpectedContent: []string{`"invalid bearer token"`},
+				},
+			},
+		},
+	}
+	for _, testSet := range testSets {
+		t.Run(testSet.baseTest.Name, func(t *testing.T) {
+			t.Parallel()
+
+			for _, userTest := range testSet.userTests {
+				t.Run(userTest.Name, func(t *testing.T) {
+					t.Parallel()
+
+					runMatrixTest(t, testSet.baseTest, userTest)
+				})
+			}
+		})
+	}
+}
+
+func TestEvents_Comments(t *testing.T) {
+	t.Parallel()
+
+	catalyst, cleanup, _ := App(t)
+	t.Cleanup(cleanup)
+
+	server := httptest.NewServer(catalyst)
+	t.Cleanup(server.Close)
+
+	analyst := accessToken(t, catalyst, data.AnalystEmail)
+	admin := accessToken(t, catalyst, data.AdminEmail)
+
+	ctx, cancel := context.WithTimeout(t.Context(), 10*time.Second)
+	defer cancel()
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, server.URL+"/api/events?ticket=test-ticket", nil)
+	require.NoError(t, err)
+
+	req.Header.Set("Authorization", "Bearer "+analyst)
+
+	res, err := http.DefaultClient.Do(req)
+	require.NoError(t, err)
+
+	defer res.Body.Close()
+
+	require.Equal(t, http.StatusOK, res.StatusCode)
+
+	scanner := bufio.NewScanner(res.Body)
+
+	// the stream is subscribed after the retry hint is sent
+	require.True(t, scanner.Scan())
+	require.Equal(t, "retry: 3000", scanner.Text())
+
+	createReq, err := http.NewRequestWithContext(ctx, http.MethodPost, server.URL+"/api/comments", bytes.NewBufferString(`{"ticket":"test-ticket","author":"u_admin","message":"live comment"}`))
+	require.NoError(t, err)
+
+	createReq.Header.Set("Authorization", "Bearer "+admin)
+	createReq.Header.Set("Content-Type", "application/json")
+
+	createRes, err := http.DefaultClient.Do(createReq)
+	require.NoError(t, err)
+
+	createRes.Body.Close()
+
+	require.Equal(t, http.StatusOK, createRes.StatusCode)
+
+	for scanner.Scan() {
+		line := scanner.Text()
+		if !strings.HasPrefix(line, "data: ") {
+			continue
+		}
+
+		assert.Contains(t, line, `"collection":"comments"`)
+		assert.Contains(t, line, `"message":"live comment"`)
+		assert.Contains(t, line, `"auth":{"id":"u_admin"`)
+
+		return
+	}
+
+	t.Fatalf("stream ended without event: %v", scanner.Err())
+}
+
+func TestEvents_Admin(t *testing.T) {
+	t.Parallel()
+
+	catalyst, cleanup, _ := App(t)
+	t.Cleanup(cleanup)
+
+	server := httptest.NewServer(catalyst)
+	t.Cleanup(server.Close)
+
+	// admins only hold the admin permission, which grants all others
+	admin := accessToken(t, catalyst, data.AdminEmail)
+
+	ctx, cancel := context.WithTimeout(t.Context(), 10*time.Second)
+	defer cancel()
+
+	stream := func(lastEventID string) *bufio.Scanner {
+		req, err := http.NewRequestWithContext(ctx, http.MethodGet, server.URL+"/api/events", nil)
+		require.NoError(t, err)
+
+		req.Header.Set("Authorization", "Bearer "+admin)
+
+		if lastEventID != "" {
+			req.Header.Set("Last-Event-ID", lastEventID)
+		}
+
+		res, err := http.DefaultClient.Do(req)
+		require.NoError(t, err)
+		t.Cleanup(func() { res.Body.Close() })
+
+		require.Equal(t, http.StatusOK, res.StatusCode)
+
+		scanner := bufio.NewScanner(res.Body)
+
+		require.True(t, scanner.Scan())
+		require.Equal(t, "retry: 3000", scanner.Text())
+
+		return scanner
+	}
+
+	nextData := func(scanner *bufio.Scanner) string {
+		for scanner.Scan() {
+			if line := scanner.Text(); strings.HasPrefix(line, "data: ") {
+				return line
+			}
+		}
+
+		t.Fatalf("stream ended without event: %v", scanner.Err())
+
+		return ""
+	}
+
+	live := stream("")
+
+	createReq, err := http.NewRequestWithContext(ctx, http.MethodPost, server.URL+"/api/types", bytes.NewBufferString(`{"singular":"Bug","plural":"Bugs","icon":"Bug","schema":{}}`))
+	require.NoError(t, err)
+
+	createReq.Header.Set("Authorization", "Bearer "+admin)
+	createReq.Header.Set("Content-Type", "application/json")
+
+	createRes, err := http.DefaultClient.Do(createReq)
+	require.NoError(t, err)
+
+	createRes.Body.Close()
+
+	require.Equal(t, http.StatusOK, createRes.StatusCode)
+
+	assert.Contains(t, nextData(live), `"singular":"Bug"`)
+
+	// missed events are replayed
+	assert.Contains(t, nextData(stream("0")), `"singular":"Bug"`)
+}
+
+func accessToken(t *testing.T, catalyst *app.App, email string) string {
+	t.Helper()
+
+	user, err := catalyst.Queries.UserByEmail(t.Context(), &email)
+	require.NoError(t, err)
+
+	permissions, err := catalyst.Queries.ListUserPermissions(t.Context(), user.ID)
+	require.NoError(t, err)
+
+	token, err := auth.CreateAccessToken(t.Context(), &user, permissions, time.Hour, catalyst.Queries)
+	require.NoError(t, err)
+
+	return token
+}
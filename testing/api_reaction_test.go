@@ -100,6 +100,124 @@ func TestReactionsCollection(t *testing.T) {
 				},
 			},
 		},
+		{
+			baseTest: baseTest{
+				Name:           "CreateReactionWithInvalidCondition",
+				Method:         http.MethodPost,
+				RequestHeaders: map[string]string{"Content-Type": "application/json"},
+				URL:            "/api/reactions",
+				Body: s(map[string]any{
+					"name":        "test",
+					"trigger":     "hook",
+					"triggerdata": map[string]any{"collections": []string{"tickets"}, "events": []string{"create"}, "condition": "record.type =="},
+					"action":      "python",
+					"actiondata":  map[string]any{"script": "print('Hello, World!')"},
+				}),
+			},
+			userTests: []userTest{
+				{
+					Name:           "Admin",
+					Admin:          data.AdminEmail,
+					ExpectedStatus: http.StatusBadRequest,
+					ExpectedContent: []string{
+						`invalid condition`,
+					},
+					ExpectedEvents: map[string]int{},
+				},
+			},
+		},
+		{
+			baseTest: baseTest{
+				Name:           "CreateReactionWithPlainSignatureSecret",
+				Method:         http.MethodPost,
+				RequestHeaders: map[string]string{"Content-Type": "application/json"},
+				URL:            "/api/reactions",
+				Body: s(map[string]any{
+					"name":        "test",
+					"trigger":     "webhook",
+					"triggerdata": map[string]any{"path": "test", "signature": map[string]any{"header": "X-Signature", "secret": "plain"}},
+					"action":      "python",
+					"actiondata":  map[string]any{"script": "print('Hello, World!')"},
+				}),
+			},
+			userTests: []userTest{
+				{
+					Name:           "Admin",
+					Admin:          data.AdminEmail,
+					ExpectedStatus: http.StatusBadRequest,
+					ExpectedContent: []string{
+						`unknown field \"secret\"`,
+					},
+					ExpectedEvents: map[string]int{},
+				},
+			},
+		},
+		{
+			baseTest: baseTest{
+				Name:           "CreateReactionWithInvalidAction",
+				Method:         http.MethodPost,
+				RequestHeaders: map[string]string{"Content-Type": "application/json"},
+				URL:            "/api/reactions",
+				Body: s(map[string]any{
+					"name":        "test",
+					"trigger":     "webhook",
+					"triggerdata": map[string]any{"path": "test"},
+					"action":      "webhook",
+					"actiondata":  map[string]any{"url": "http://example.com", "unknown": true},
+				}),
+			},
+			userTests: []userTest{
+				{
+					Name:           "Admin",
+					Admin:          data.AdminEmail,
+					ExpectedStatus: http.StatusBadRequest,
+					ExpectedContent: []string{
+						`unknown field`,
+					},
+					ExpectedEvents: map[string]int{},
+				},
+			},
+		},
+		{
+			baseTest: baseTest{
+				Name:           "UpdateReactionWithInvalidAction",
+				Method:         http.MethodPatch,
+				RequestHeaders: map[string]string{"Content-Type": "application/json"},
+				URL:            "/api/reactions/r-test-webhook",
+				Body:           s(map[string]any{"action": "unknown"}),
+			},
+			userTests: []userTest{
+				{
+					Name:           "Admin",
+					Admin:          data.AdminEmail,
+					ExpectedStatus: http.StatusBadRequest,
+					ExpectedContent: []string{
+						`action \"unknown\" not found`,
+					},
+					ExpectedEvents: map[string]int{},
+				},
+			},
+		},
+		{
+			baseTest: baseTest{
+				Name:           "UpdateReactionWithInvalidTrigger",
+				Method:         http.MethodPatch,
+				RequestHeaders: map[string]string{"Content-Type": "application/json"},
+				URL:            "/api/reactions/r-test-webhook",
+				Body:           s(map[string]any{"trigger": "hook", "triggerdata": map[string]any{"collections": []string{"tickets"}, "events": []string{"create"}, "condition": "1 +"}}),
+			},
+			userTests: []userTest{
+				{
+					Name:           "Admin",
+					Admin:          data.AdminEmail,
+					ExpectedStatus: http.StatusBadRequest,
+					ExpectedContent: []string{
+						`invalid condition`,
+					},
+					ExpectedEvents: map[string]int{},
+				},
+			},
+		},
 		{
 			baseTest: baseTest{
 				Name:           "GetReaction",
@@ -210,6 +328,194 @@ func TestReactionsCollection(t *testing.T) {
 				},
 			},
 		},
+		{
+			baseTest: baseTest{
+				Name:   "ListReactionRuns",
+				Method: http.MethodGet,
+				URL:    "/api/reactions/r-test-webhook/runs",
+			},
+			userTests: []userTest{
+				{
+					Name:           "Unauthorized",
+					ExpectedStatus: http.StatusUnauthorized,
+					ExpectedContent: []string{
+						`"invalid bearer token"`,
+					},
+				},
+				{
+					Name:           "Analyst",
+					AuthRecord:     data.AnalystEmail,
+					ExpectedStatus: http.StatusUnauthorized,
+					ExpectedContent: []string{
+						`"missing required scopes"`,
+					},
+				},
+				{
+					Name:           "Admin",
+					Admin:          data.AdminEmail,
+					ExpectedStatus: http.StatusOK,
+					ExpectedContent: []string{
+						`"id":"x_test_run"`,
+						`"status":"success"`,
+						`"duration":1000`,
+					},
+					ExpectedHeaders: map[string]string{
+						"X-Total-Count": "1",
+					},
+					ExpectedEvents: map[string]int{"OnRecordsListRequest": 1},
+				},
+			},
+		},
+		{
+			baseTest: baseTest{
+				Name:   "GetReactionRun",
+				Method: http.MethodGet,
+				URL:    "/api/reactions/r-test-webhook/runs/x_test_run",
+			},
+			userTests: []userTest{
+				{
+					Name:           "Unauthorized",
+					ExpectedStatus: http.StatusUnauthorized,
+					ExpectedContent: []string{
+						`"invalid bearer token"`,
+					},
+				},
+				{
+					Name:           "Analyst",
+					AuthRecord:     data.AnalystEmail,
+					ExpectedStatus: http.StatusUnauthorized,
+					ExpectedContent: []string{
+						`"missing required scopes"`,
+					},
+				},
+				{
+					Name:           "Admin",
+					Admin:          data.AdminEmail,
+					ExpectedStatus: http.StatusOK,
+					ExpectedContent: []string{
+						`"id":"x_test_run"`,
+						`"output":"Hello, World!\n"`,
+						`"trigger":"webhook"`,
+					},
+					ExpectedEvents: map[string]int{"OnRecordViewRequest": 1},
+				},
+			},
+		},
+		{
+			baseTest: baseTest{
+				Name:           "DryRunReaction",
+				Method:         http.MethodPost,
+				RequestHeaders: map[string]string{"Content-Type": "application/json"},
+				URL:            "/api/reactions/r-test-webhook/run?dry_run=true",
+				Body:           s(map[string]any{"test": true}),
+			},
+			userTests: []userTest{
+				{
+					Name:           "Unauthorized",
+					ExpectedStatus: http.StatusUnauthorized,
+					ExpectedContent: []string{
+						`"invalid bearer token"`,
+					},
+				},
+				{
+					Name:           "Analyst",
+					AuthRecord:     data.AnalystEmail,
+					ExpectedStatus: http.StatusUnauthorized,
+					ExpectedContent: []string{
+						`"missing required scopes"`,
+					},
+				},
+				{
+					Name:           "Admin",
+					Admin:          data.AdminEmail,
+					ExpectedStatus: http.StatusOK,
+					ExpectedContent: []string{
+						`"dry_run":true`,
+					},
+					NotExpectedContent: []string{
+						`"error"`,
+					},
+				},
+			},
+		},
+		{
+			baseTest: baseTest{
+				Name:   "ListPythonEnvironments",
+				Method: http.MethodGet,
+				URL:    "/api/python_environments",
+			},
+			userTests: []userTest{
+				{
+					Name:           "Unauthorized",
+					ExpectedStatus: http.StatusUnauthorized,
+					ExpectedContent: []string{
+						`"invalid bearer token"`,
+					},
+				},
+				{
+					Name:           "Analyst",
+					AuthRecord:     data.AnalystEmail,
+					ExpectedStatus: http.StatusUnauthorized,
+					ExpectedContent: []string{
+						`"missing required scopes"`,
+					},
+				},
+				{
+					Name:           "Admin",
+					Admin:          data.AdminEmail,
+					ExpectedStatus: http.StatusOK,
+					ExpectedContent: []string{
+						`[]`,
+					},
+				},
+			},
+		},
+		{
+			baseTest: baseTest{
+				Name:   "PurgePythonEnvironments",
+				Method: http.MethodDelete,
+				URL:    "/api/python_environments",
+			},
+			userTests: []userTest{
+				{
+					Name:           "Unauthorized",
+					ExpectedStatus: http.StatusUnauthorized,
+					ExpectedContent: []string{
+						`"invalid bearer token"`,
+					},
+				},
+				{
+					Name:           "Analyst",
+					AuthRecord:     data.AnalystEmail,
+					ExpectedStatus: http.StatusUnauthorized,
+					ExpectedContent: []string{
+						`"missing required scopes"`,
+					},
+				},
+				{
+					Name:           "Admin",
+					Admin:          data.AdminEmail,
+					ExpectedStatus: http.StatusNoContent,
+				},
+			},
+		},
+		{
+			baseTest: baseTest{
+				Name:   "DeleteMissingPythonEnvironment",
+				Method: http.MethodDelete,
+				URL:    "/api/python_environments/0000000000000000000000000000000000000000000000000000000000000000",
+			},
+			userTests: []userTest{
+				{
+					Name:           "Admin",
+					Admin:          data.AdminEmail,
+					ExpectedStatus: http.StatusNotFound,
+					ExpectedContent: []string{
+						`"record not found"`,
+					},
+				},
+			},
+		},
 	}
 
 	for _, testSet := range testSets {
@@ -0,0 +1,111 @@
+package testing
+
+import (
+	"net/http"
+	"testing"
+
+	"github.com/SecurityBrewery/catalyst/app/data"
+)
+
+func TestSecretsCollection(t *testing.T) {
+	t.Parallel()
+
+	testSets := []catalystTest{
+		{
+			baseTest: baseTest{
+				Name:   "ListSecrets",
+				Method: http.MethodGet,
+				URL:    "/api/secrets",
+			},
+			userTests: []userTest{
+				{
+					Name:            "Unauthorized",
+					ExpectedStatus:  http.StatusUnauthorized,
+					ExpectedContent: []string{`"invalid bearer token"`},
+					ExpectedEvents:  map[string]int{},
+				},
+				{
+					Name:            "Analyst",
+					AuthRecord:      data.AnalystEmail,
+					ExpectedStatus:  http.StatusUnauthorized,
+					ExpectedContent: []string{`"missing required scopes"`},
+				},
+				{
+					Name:            "Admin",
+					Admin:           data.AdminEmail,
+					ExpectedStatus:  http.StatusOK,
+					ExpectedHeaders: map[string]string{"X-Total-Count": "0"},
+					ExpectedContent: []string{`[]`},
+					ExpectedEvents:  map[string]int{},
+				},
+			},
+		},
+		{
+			baseTest: baseTest{
+				Name:           "CreateSecret",
+				Method:         http.MethodPost,
+				RequestHeaders: map[string]string{"Content-Type": "application/json"},
+				URL:            "/api/secrets",
+				Body: s(map[string]any{
+					"name":  "VT_API_KEY",
+					"value": "secret",
+				}),
+			},
+			userTests: []userTest{
+				{
+					Name:            "Unauthorized",
+					ExpectedStatus:  http.StatusUnauthorized,
+					ExpectedContent: []string{`"invalid bearer token"`},
+				},
+				{
+					Name:            "Analyst",
+					AuthRecord:      data.AnalystEmail,
+					ExpectedStatus:  http.StatusUnauthorized,
+					ExpectedContent: []string{`"missing required scopes"`},
+				},
+				{
+					// the test app has no CATALYST_SECRET_KEY
+					Name:            "Admin",
+					Admin:           data.AdminEmail,
+					ExpectedStatus:  http.StatusInternalServerError,
+					ExpectedContent: []string{`secrets are not configured`},
+					ExpectedEvents:  map[string]int{},
+				},
+			},
+		},
+		{
+			baseTest: baseTest{
+				Name:           "CreateSecretWithInvalidName",
+				Method:         http.MethodPost,
+				RequestHeaders: map[string]string{"Content-Type": "application/json"},
+				URL:            "/api/secrets",
+				Body: s(map[string]any{
+					"name":  "CATALYST_TOKEN",
+					"value": "secret",
+				}),
+			},
+			userTests: []userTest{
+				{
+					Name:            "Admin",
+					Admin:           data.AdminEmail,
+					ExpectedStatus:  http.StatusBadRequest,
+					ExpectedContent: []string{`the CATALYST_ prefix is reserved`},
+					ExpectedEvents:  map[string]int{},
+				},
+			},
+		},
+	}
+	for _, testSet := range testSets {
+		t.Run(testSet.baseTest.Name, func(t *testing.T) {
+			t.Parallel()
+
+			for _, userTest := range testSet.userTests {
+				t.Run(userTest.Name, func(t *testing.T) {
+					t.Parallel()
+
+					runMatrixTest(t, testSet.baseTest, userTest)
+				})
+			}
+		})
+	}
+}
@@ -0,0 +1,239 @@
+package testing
+
+import (
+	"bytes"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/SecurityBrewery/catalyst/app"
+	"github.com/SecurityBrewery/catalyst/app/data"
+	"github.com/SecurityBrewery/catalyst/app/openapi"
+)
+
+func TestSessionsCollection(t *testing.T) {
+	t.Parallel()
+
+	testSets := []catalystTest{
+		{
+			baseTest: baseTest{
+				Name:   "ListSessions",
+				Method: http.MethodGet,
+				URL:    "/api/sessions",
+			},
+			userTests: []userTest{
+				{
+					Name:            "Unauthorized",
+					ExpectedStatus:  http.StatusUnauthorized,
+					ExpectedContent: []string{`"invalid bearer token"`},
+				},
+				{
+					Name:            "Analyst",
+					AuthRecord:      data.AnalystEmail,
+					ExpectedStatus:  http.StatusOK,
+					ExpectedHeaders: map[string]string{"X-Total-Count": "1"},
+					ExpectedContent: []string{`"user":"u_bob_analyst"`, `"current":true`},
+				},
+			},
+		},
+		{
+			baseTest: baseTest{
+				Name:   "ListUserSessions",
+				Method: http.MethodGet,
+				URL:    "/api/users/u_bob_analyst/sessions",
+			},
+			userTests: []userTest{
+				{
+					Name:            "Unauthorized",
+					ExpectedStatus:  http.StatusUnauthorized,
+					ExpectedContent: []string{`"invalid bearer token"`},
+				},
+				{
+					Name:            "Analyst",
+					AuthRecord:      data.AnalystEmail,
+					ExpectedStatus:  http.StatusUnauthorized,
+					ExpectedContent: []string{`"missing required scopes"`},
+				},
+				{
+					Name:            "Admin",
+					Admin:           data.AdminEmail,
+					ExpectedStatus:  http.StatusOK,
+					ExpectedContent: []string{`[]`},
+				},
+			},
+		},
+		{
+			baseTest: baseTest{
+				Name:   "DeleteUserSessions",
+				Method: http.MethodDelete,
+				URL:    "/api/users/u_bob_analyst/sessions",
+			},
+			userTests: []userTest{
+				{
+					Name:            "Analyst",
+					AuthRecord:      data.AnalystEmail,
+					ExpectedStatus:  http.StatusUnauthorized,
+					ExpectedContent: []string{`"missing required scopes"`},
+				},
+				{
+					Name:           "Admin",
+					Admin:          data.AdminEmail,
+					ExpectedStatus: http.StatusNoContent,
+				},
+			},
+		},
+	}
+	for _, testSet := range testSets {
+		t.Run(testSet.baseTest.Name, func(t *testing.T) {
+			t.Parallel()
+
+			for _, userTest := range testSet.userTests {
+				t.Run(userTest.Name, func(t *testing.T) {
+					t.Parallel()
+
+					runMatrixTest(t, testSet.baseTest, userTest)
+				})
+			}
+		})
+	}
+}
+
+func login(t *testing.T, catalyst *app.App, email string) string {
+	t.Helper()
+
+	req := httptest.NewRequest(http.MethodPost, "/auth/local/login", bytes.NewReader([]byte(`{"email":"`+email+`","password":"password123"}`)))
+	req.RemoteAddr = "192.0.2.10:51234"
+	req.Header.Set("User-Agent", "Mozilla/5.0 (test)")
+	req.Header.Set("Content-Type", "application/json")
+
+	rec := httptest.NewRecorder()
+	catalyst.ServeHTTP(rec, req)
+
+	require.Equal(t, http.StatusOK, rec.Code, rec.Body.String())
+
+	var response struct {
+		Token string `json:"token"`
+	}
+
+	require.NoError(t, json.Unmarshal(rec.Body.Bytes(), &response))
+
+	return response.Token
+}
+
+func sessions(t *testing.T, catalyst *app.App, url, token string) []openapi.Session {
+	t.Helper()
+
+	rec := serve(t, catalyst, http.MethodGet, url, token, nil)
+	require.Equal(t, http.StatusOK, rec.Code, rec.Body.String())
+
+	var sessions []openapi.Session
+	require.NoError(t, json.Unmarshal(rec.Body.Bytes(), &sessions))
+
+	return sessions
+}
+
+func TestSessions_Logout(t *testing.T) {
+	t.Parallel()
+
+	catalyst, cleanup, _ := App(t)
+	t.Cleanup(cleanup)
+
+	laptop := login(t, catalyst, data.AdminEmail)
+	phone := login(t, catalyst, data.AdminEmail)
+
+	// the login records the device of the session
+	list := sessions(t, catalyst, "/api/sessions", laptop)
+	require.Len(t, list, 2)
+
+	for _, session := range list {
+		assert.Equal(t, "Mozilla/5.0 (test)", session.UserAgent)
+		assert.Equal(t, "192.0.2.10", session.Ip)
+	}
+
+	assert.NotEqual(t, list[0].Current, list[1].Current)
+
+	rec := serve(t, catalyst, http.MethodPost, "/auth/logout", laptop, nil)
+	require.Equal(t, http.StatusOK, rec.Code, rec.Body.String())
+
+	rec = serve(t, catalyst, http.MethodGet, "/api/tickets", laptop, nil)
+	assert.Equal(t, http.StatusUnauthorized, rec.Code)
+	assert.Contains(t, rec.Body.String(), "invalid bearer token")
+
+	// other sessions stay valid
+	list = sessions(t, catalyst, "/api/sessions", phone)
+	require.Len(t, list, 1)
+	assert.True(t, list[0].Current)
+
+	laptop = login(t, catalyst, data.AdminEmail)
+
+	rec = serve(t, catalyst, http.MethodPost, "/auth/logout/all", phone, nil)
+	require.Equal(t, http.StatusOK, rec.Code, rec.Body.String())
+
+	for _, token := range []string{laptop, phone} {
+		rec = serve(t, catalyst, http.MethodGet, "/api/tickets", token, nil)
+		assert.Equal(t, http.StatusUnauthorized, rec.Code)
+	}
+}
+
+func TestSessions_RevokeByAdmin(t *testing.T) {
+	t.Parallel()
+
+	catalyst, cleanup, _ := App(t)
+	t.Cleanup(cleanup)
+
+	admin := accessToken(t, catalyst, data.AdminEmail)
+	first := accessToken(t, catalyst, data.AnalystEmail)
+	second := accessToken(t, catalyst, data.AnalystEmail)
+
+	list := sessions(t, catalyst, "/api/users/u_bob_analyst/sessions", admin)
+	require.Len(t, list, 2)
+
+	// the own session endpoint of the admin cannot revoke sessions of others
+	rec := serve(t, catalyst, http.MethodDelete, "/api/sessions/"+list[0].Id, admin, nil)
+	assert.Equal(t, http.StatusNotFound, rec.Code)
+
+	rec = serve(t, catalyst, http.MethodDelete, "/api/users/u_bob_analyst/sessions/"+list[0].Id, admin, nil)
+	require.Equal(t, http.StatusNoContent, rec.Code)
+
+	remaining := 0
+
+	for _, token := range []string{first, second} {
+		if serve(t, catalyst, http.MethodGet, "/api/tickets", token, nil).Code == http.StatusOK {
+			remaining++
+		}
+	}
+
+	assert.Equal(t, 1, remaining)
+
+	rec = serve(t, catalyst, http.MethodDelete, "/api/users/u_bob_analyst/sessions", admin, nil)
+	require.Equal(t, http.StatusNoContent, rec.Code)
+
+	for _, token := range []string{first, second} {
+		rec = serve(t, catalyst, http.MethodGet, "/api/tickets", token, nil)
+		assert.Equal(t, http.StatusUnauthorized, rec.Code)
+	}
+
+	// the sessions of the admin are not affected
+	rec = serve(t, catalyst, http.MethodGet, "/api/tickets", admin, nil)
+	assert.Equal(t, http.StatusOK, rec.Code)
+}
+
+func TestSessions_DeactivateUser(t *testing.T) {
+	t.Parallel()
+
+	catalyst, cleanup, _ := App(t)
+	t.Cleanup(cleanup)
+
+	admin := accessToken(t, catalyst, data.AdminEmail)
+	analyst := accessToken(t, catalyst, data.AnalystEmail)
+
+	rec := serve(t, catalyst, http.MethodPatch, "/api/users/u_bob_analyst", admin, map[string]any{"active": false})
+	require.Equal(t, http.StatusOK, rec.Code, rec.Body.String())
+
+	rec = serve(t, catalyst, http.MethodGet, "/api/tickets", analyst, nil)
+	assert.Equal(t, http.StatusUnauthorized, rec.Code)
+}
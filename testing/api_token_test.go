@@ -0,0 +1,319 @@
+package testing
+
+import (
+	"bytes"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/SecurityBrewery/catalyst/app"
+	"github.com/SecurityBrewery/catalyst/app/data"
+	"github.com/SecurityBrewery/catalyst/app/database/sqlc"
+	"github.com/SecurityBrewery/catalyst/app/openapi"
+)
+
+func TestApiTokensCollection(t *testing.T) {
+	t.Parallel()
+
+	testSets := []catalystTest{
+		{
+			baseTest: baseTest{
+				Name:   "ListApiTokens",
+				Method: http.MethodGet,
+				URL:    "/api/tokens",
+			},
+			userTests: []userTest{
+				{
+					Name:            "Unauthorized",
+					ExpectedStatus:  http.StatusUnauthorized,
+					ExpectedContent: []string{`"invalid bearer token"`},
+				},
+				{
+					Name:            "Analyst",
+					AuthRecord:      data.AnalystEmail,
+					ExpectedStatus:  http.StatusOK,
+					ExpectedHeaders: map[string]string{"X-Total-Count": "0"},
+					ExpectedContent: []string{`[]`},
+				},
+			},
+		},
+		{
+			baseTest: baseTest{
+				Name:           "CreateApiToken",
+				Method:         http.MethodPost,
+				RequestHeaders: map[string]string{"Content-Type": "application/json"},
+				URL:            "/api/tokens",
+				Body:           s(map[string]any{"name": "ingestion", "scopes": []string{"ticket:read", "ticket:write"}}),
+			},
+			userTests: []userTest{
+				{
+					Name:            "Unauthorized",
+					ExpectedStatus:  http.StatusUnauthorized,
+					ExpectedContent: []string{`"invalid bearer token"`},
+				},
+				{
+					Name:            "Analyst",
+					AuthRecord:      data.AnalystEmail,
+					ExpectedStatus:  http.StatusOK,
+					ExpectedContent: []string{`"name":"ingestion"`, `"user":"u_bob_analyst"`, `"scopes":["ticket:read","ticket:write"]`, `"token":"cat_`, `"prefix":"cat_`},
+				},
+			},
+		},
+		{
+			baseTest: baseTest{
+				Name:           "CreateApiTokenWithoutPermission",
+				Method:         http.MethodPost,
+				RequestHeaders: map[string]string{"Content-Type": "application/json"},
+				URL:            "/api/tokens",
+				Body:           s(map[string]any{"name": "escalate", "scopes": []string{"user:write"}}),
+			},
+			userTests: []userTest{
+				{
+					Name:            "Analyst",
+					AuthRecord:      data.AnalystEmail,
+					ExpectedStatus:  http.StatusBadRequest,
+					ExpectedContent: []string{`user does not have permission \"user:write\"`},
+				},
+			},
+		},
+		{
+			baseTest: baseTest{
+				Name:           "CreateApiTokenWithoutScopes",
+				Method:         http.MethodPost,
+				RequestHeaders: map[string]string{"Content-Type": "application/json"},
+				URL:            "/api/tokens",
+				Body:           s(map[string]any{"name": "empty", "scopes": []string{}}),
+			},
+			userTests: []userTest{
+				{
+					Name:            "Analyst",
+					AuthRecord:      data.AnalystEmail,
+					ExpectedStatus:  http.StatusBadRequest,
+					ExpectedContent: []string{`at least one scope is required`},
+				},
+			},
+		},
+		{
+			baseTest: baseTest{
+				Name:   "ListUserApiTokens",
+				Method: http.MethodGet,
+				URL:    "/api/users/u_bob_analyst/tokens",
+			},
+			userTests: []userTest{
+				{
+					Name:            "Unauthorized",
+					ExpectedStatus:  http.StatusUnauthorized,
+					ExpectedContent: []string{`"invalid bearer token"`},
+				},
+				{
+					Name:            "Analyst",
+					AuthRecord:      data.AnalystEmail,
+					ExpectedStatus:  http.StatusUnauthorized,
+					ExpectedContent: []string{`"missing required scopes"`},
+				},
+				{
+					Name:            "Admin",
+					Admin:           data.AdminEmail,
+					ExpectedStatus:  http.StatusOK,
+					ExpectedContent: []string{`[]`},
+				},
+			},
+		},
+		{
+			baseTest: baseTest{
+				Name:           "CreateUserApiToken",
+				Method:         http.MethodPost,
+				RequestHeaders: map[string]string{"Content-Type": "application/json"},
+				URL:            "/api/users/u_bob_analyst/tokens",
+				Body:           s(map[string]any{"name": "soar", "scopes": []string{"ticket:read"}}),
+			},
+			userTests: []userTest{
+				{
+					Name:            "Analyst",
+					AuthRecord:      data.AnalystEmail,
+					ExpectedStatus:  http.StatusUnauthorized,
+					ExpectedContent: []string{`"missing required scopes"`},
+				},
+				{
+					Name:            "Admin",
+					Admin:           data.AdminEmail,
+					ExpectedStatus:  http.StatusOK,
+					ExpectedContent: []string{`"name":"soar"`, `"user":"u_bob_analyst"`, `"token":"cat_`},
+				},
+			},
+		},
+	}
+	for _, testSet := range testSets {
+		t.Run(testSet.baseTest.Name, func(t *testing.T) {
+			t.Parallel()
+
+			for _, userTest := range testSet.userTests {
+				t.Run(userTest.Name, func(t *testing.T) {
+					t.Parallel()
+
+					runMatrixTest(t, testSet.baseTest, userTest)
+				})
+			}
+		})
+	}
+}
+
+func serve(t *testing.T, catalyst *app.App, method, url, token string, body any) *httptest.ResponseRecorder {
+	t.Helper()
+
+	var b []byte
+
+	if body != nil {
+		var err error
+
+		b, err = json.Marshal(body)
+		require.NoError(t, err)
+	}
+
+	req := httptest.NewRequest(method, url, bytes.NewReader(b))
+	req.RemoteAddr = "192.0.2.10:51234"
+	req.Header.Set("Authorization", "Bearer "+token)
+	req.Header.Set("Content-Type", "application/json")
+
+	rec := httptest.NewRecorder()
+	catalyst.ServeHTTP(rec, req)
+
+	return rec
+}
+
+func TestApiTokens_Lifecycle(t *testing.T) {
+	t.Parallel()
+
+	catalyst, cleanup, _ := App(t)
+	t.Cleanup(cleanup)
+
+	analyst := accessToken(t, catalyst, data.AnalystEmail)
+
+	rec := serve(t, catalyst, http.MethodPost, "/api/tokens", analyst, map[string]any{
+		"name":   "ingestion",
+		"scopes": []string{"ticket:read"},
+	})
+	require.Equal(t, http.StatusOK, rec.Code, rec.Body.String())
+
+	var created openapi.ApiToken
+	require.NoError(t, json.Unmarshal(rec.Body.Bytes(), &created))
+	require.NotNil(t, created.Token)
+
+	// the token is accepted alongside JWTs, limited to its scopes
+	rec = serve(t, catalyst, http.MethodGet, "/api/tickets", *created.Token, nil)
+	assert.Equal(t, http.StatusOK, rec.Code)
+
+	rec = serve(t, catalyst, http.MethodPost, "/api/comments", *created.Token, map[string]any{"ticket": "test-ticket", "author": "u_bob_analyst", "message": "hello"})
+	assert.Equal(t, http.StatusUnauthorized, rec.Code)
+	assert.Contains(t, rec.Body.String(), "missing required scopes")
+
+	// a token cannot create tokens, not even with fewer permissions
+	rec = serve(t, catalyst, http.MethodPost, "/api/tokens", *created.Token, map[string]any{"name": "copy", "scopes": []string{"ticket:read"}})
+	assert.Equal(t, http.StatusForbidden, rec.Code)
+	assert.Contains(t, rec.Body.String(), "api tokens cannot create api tokens")
+
+	rec = serve(t, catalyst, http.MethodGet, "/api/tokens", analyst, nil)
+	require.Equal(t, http.StatusOK, rec.Code)
+
+	var tokens []openapi.ApiToken
+	require.NoError(t, json.Unmarshal(rec.Body.Bytes(), &tokens))
+	require.Len(t, tokens, 1)
+
+	assert.Nil(t, tokens[0].Token)
+	assert.Equal(t, (*created.Token)[:10], tokens[0].Prefix)
+	require.NotNil(t, tokens[0].LastUsed)
+	require.NotNil(t, tokens[0].LastUsedIp)
+	assert.Equal(t, "192.0.2.10", *tokens[0].LastUsedIp)
+
+	// other users cannot revoke the token
+	admin := accessToken(t, catalyst, data.AdminEmail)
+
+	rec = serve(t, catalyst, http.MethodDelete, "/api/tokens/"+created.Id, admin, nil)
+	assert.Equal(t, http.StatusNotFound, rec.Code)
+
+	rec = serve(t, catalyst, http.MethodDelete, "/api/tokens/"+created.Id, analyst, nil)
+	assert.Equal(t, http.StatusNoContent, rec.Code)
+
+	rec = serve(t, catalyst, http.MethodGet, "/api/tickets", *created.Token, nil)
+	assert.Equal(t, http.StatusUnauthorized, rec.Code)
+	assert.Contains(t, rec.Body.String(), "invalid bearer token")
+}
+
+func TestApiTokens_UserTokens(t *testing.T) {
+	t.Parallel()
+
+	catalyst, cleanup, _ := App(t)
+	t.Cleanup(cleanup)
+
+	admin := accessToken(t, catalyst, data.AdminEmail)
+
+	rec := serve(t, catalyst, http.MethodPost, "/api/users", admin, map[string]any{"username": "svc-soar", "name": "SOAR", "active": true})
+	require.Equal(t, http.StatusOK, rec.Code, rec.Body.String())
+
+	var user openapi.User
+	require.NoError(t, json.Unmarshal(rec.Body.Bytes(), &user))
+
+	rec = serve(t, catalyst, http.MethodPost, "/api/users/"+user.Id+"/groups", admin, map[string]any{"group_id": "analyst"})
+	require.Equal(t, http.StatusCreated, rec.Code, rec.Body.String())
+
+	expires := time.Now().Add(time.Hour)
+
+	rec = serve(t, catalyst, http.MethodPost, "/api/users/"+user.Id+"/tokens", admin, map[string]any{"name": "soar", "scopes": []string{"ticket:read"}, "expires": expires})
+	require.Equal(t, http.StatusOK, rec.Code, rec.Body.String())
+
+	var created openapi.ApiToken
+	require.NoError(t, json.Unmarshal(rec.Body.Bytes(), &created))
+
+	rec = serve(t, catalyst, http.MethodGet, "/api/tickets", *created.Token, nil)
+	assert.Equal(t, http.StatusOK, rec.Code)
+
+	// removing the group of the user also removes the scope
+	rec = serve(t, catalyst, http.MethodDelete, "/api/users/"+user.Id+"/groups/analyst", admin, nil)
+	require.Equal(t, http.StatusNoContent, rec.Code)
+
+	rec = serve(t, catalyst, http.MethodGet, "/api/tickets", *created.Token, nil)
+	assert.Equal(t, http.StatusUnauthorized, rec.Code)
+	assert.Contains(t, rec.Body.String(), "missing required scopes")
+
+	rec = serve(t, catalyst, http.MethodDelete, "/api/users/"+user.Id+"/tokens/"+created.Id, admin, nil)
+	assert.Equal(t, http.StatusNoContent, rec.Code)
+}
+
+func TestApiTokens_Expired(t *testing.T) {
+	t.Parallel()
+
+	catalyst, cleanup, _ := App(t)
+	t.Cleanup(cleanup)
+
+	token := "cat_EXPIREDTOKEN"
+	hash := sha256.Sum256([]byte(token))
+	expires := time.Now().Add(-time.Minute)
+
+	_, err := catalyst.Queries.CreateAPIToken(t.Context(), sqlc.CreateAPITokenParams{
+		User:      "u_bob_analyst",
+		Name:      "expired",
+		TokenHash: hex.EncodeToString(hash[:]),
+		Prefix:    token[:10],
+		Scopes:    `["ticket:read"]`,
+		Expires:   &expires,
+		Now:       time.Now(),
+	})
+	require.NoError(t, err)
+
+	rec := serve(t, catalyst, http.MethodGet, "/api/tickets", token, nil)
+	assert.Equal(t, http.StatusUnauthorized, rec.Code)
+
+	// the same token is accepted until it expires
+	_, err = catalyst.Queries.WriteDB.ExecContext(t.Context(), "UPDATE api_tokens SET expires = NULL")
+	require.NoError(t, err)
+
+	rec = serve(t, catalyst, http.MethodGet, "/api/tickets", token, nil)
+	assert.Equal(t, http.StatusOK, rec.Code)
+}
@@ -50,6 +50,10 @@ func TestWebhooksCollection(t *testing.T) {
 					"name":        "new",
 					"collection":  "tickets",
 					"destination": "https://example.com/new",
+					"events":      []string{"create", "update"},
+					"filter":      `record.type == "alert"`,
+					"headers":     map[string]string{"Authorization": "Bearer key"},
+					"format":      "cloudevents",
 				}),
 			},
 			userTests: []userTest{
@@ -65,10 +69,11 @@ func TestWebhooksCollection(t *testing.T) {
 					ExpectedContent: []string{`"missing required scopes"`},
 				},
 				{
-					Name:            "Admin",
-					Admin:           data.AdminEmail,
-					ExpectedStatus:  http.StatusOK,
-					ExpectedContent: []string{`"name":"new"`},
+					Name:               "Admin",
+					Admin:              data.AdminEmail,
+					ExpectedStatus:     http.StatusOK,
+					ExpectedContent:    []string{`"name":"new"`, `"events":["create","update"]`, `"filter":"record.type == \"alert\""`, `"headers":{"Authorization":"********"}`, `"format":"cloudevents"`, `"secret":"whsec_`},
+					NotExpectedContent: []string{`Bearer key`},
 					ExpectedEvents: map[string]int{
 						"OnRecordAfterCreateRequest":  1,
 						"OnRecordBeforeCreateRequest": 1,
@@ -94,12 +99,54 @@ func TestWebhooksCollection(t *testing.T) {
 					ExpectedStatus:  http.StatusUnauthorized,
 					ExpectedContent: []string{`"missing required scopes"`},
 				},
+				{
+					Name:               "Admin",
+					Admin:              data.AdminEmail,
+					ExpectedStatus:     http.StatusOK,
+					ExpectedContent:    []string{`"id":"w_test_webhook"`, `"events":[]`, `"filter":""`, `"headers":{}`, `"format":"catalyst"`},
+					NotExpectedContent: []string{`"secret"`},
+					ExpectedEvents:     map[string]int{"OnRecordViewRequest": 1},
+				},
+			},
+		},
+		{
+			baseTest: baseTest{
+				Name:           "CreateWebhookWithInvalidEvent",
+				Method:         http.MethodPost,
+				RequestHeaders: map[string]string{"Content-Type": "application/json"},
+				URL:            "/api/webhooks",
+				Body: s(map[string]any{
+					"name":        "new",
+					"collection":  "tickets",
+					"destination": "https://example.com/new",
+					"events":      []string{"view"},
+				}),
+			},
+			userTests: []userTest{
 				{
 					Name:            "Admin",
 					Admin:           data.AdminEmail,
-					ExpectedStatus:  http.StatusOK,
-					ExpectedContent: []string{`"id":"w_test_webhook"`},
-					ExpectedEvents:  map[string]int{"OnRecordViewRequest": 1},
+					ExpectedStatus:  http.StatusBadRequest,
+					ExpectedContent: []string{`invalid event \"view\"`},
+					ExpectedEvents:  map[string]int{},
+				},
+			},
+		},
+		{
+			baseTest: baseTest{
+				Name:           "UpdateWebhookWithInvalidFilter",
+				Method:         http.MethodPatch,
+				RequestHeaders: map[string]string{"Content-Type": "application/json"},
+				URL:            "/api/webhooks/w_test_webhook",
+				Body:           s(map[string]any{"filter": "record.type =="}),
+			},
+			userTests: []userTest{
+				{
+					Name:            "Admin",
+					Admin:           data.AdminEmail,
+					ExpectedStatus:  http.StatusBadRequest,
+					ExpectedContent: []string{`invalid condition`},
+					ExpectedEvents:  map[string]int{},
 				},
 			},
 		},
@@ -135,6 +182,82 @@ func TestWebhooksCollection(t *testing.T) {
 				},
 			},
 		},
+		{
+			baseTest: baseTest{
+				Name:   "RotateWebhookSecret",
+				Method: http.MethodPost,
+				URL:    "/api/webhooks/w_test_webhook/rotate_secret",
+			},
+			userTests: []userTest{
+				{
+					Name:            "Unauthorized",
+					ExpectedStatus:  http.StatusUnauthorized,
+					ExpectedContent: []string{`"invalid bearer token"`},
+				},
+				{
+					Name:            "Analyst",
+					AuthRecord:      data.AnalystEmail,
+					ExpectedStatus:  http.StatusUnauthorized,
+					ExpectedContent: []string{`"missing required scopes"`},
+				},
+				{
+					Name:               "Admin",
+					Admin:              data.AdminEmail,
+					ExpectedStatus:     http.StatusOK,
+					ExpectedContent:    []string{`"id":"w_test_webhook"`, `"secret":"whsec_`},
+					NotExpectedContent: []string{`"secret":"whsec_test"`},
+					ExpectedEvents:     map[string]int{},
+				},
+			},
+		},
+		{
+			baseTest: baseTest{
+				Name:   "ListWebhookDeliveries",
+				Method: http.MethodGet,
+				URL:    "/api/webhooks/w_test_webhook/deliveries",
+			},
+			userTests: []userTest{
+				{
+					Name:            "Unauthorized",
+					ExpectedStatus:  http.StatusUnauthorized,
+					ExpectedContent: []string{`"invalid bearer token"`},
+				},
+				{
+					Name:            "Analyst",
+					AuthRecord:      data.AnalystEmail,
+					ExpectedStatus:  http.StatusUnauthorized,
+					ExpectedContent: []string{`"missing required scopes"`},
+				},
+				{
+					Name:            "Admin",
+					Admin:           data.AdminEmail,
+					ExpectedStatus:  http.StatusOK,
+					ExpectedHeaders: map[string]string{"X-Total-Count": "0"},
+					ExpectedContent: []string{`[]`},
+					ExpectedEvents:  map[string]int{},
+				},
+			},
+		},
+		{
+			baseTest: baseTest{
+				Name:   "RedeliverWebhookDelivery",
+				Method: http.MethodPost,
+				URL:    "/api/webhooks/w_test_webhook/deliveries/d_unknown/redeliver",
+			},
+			userTests: []userTest{
+				{
+					Name:            "Unauthorized",
+					ExpectedStatus:  http.StatusUnauthorized,
+					ExpectedContent: []string{`"invalid bearer token"`},
+				},
+				{
+					Name:            "Analyst",
+					AuthRecord:      data.AnalystEmail,
+					ExpectedStatus:  http.StatusUnauthorized,
+					ExpectedContent: []string{`"missing required scopes"`},
+				},
+			},
+		},
 		{
 			baseTest: baseTest{
 				Name:   "DeleteWebhook",
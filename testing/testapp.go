@@ -20,6 +20,8 @@ func App(t *testing.T) (*app.App, func(), *counter.Counter) {
 	catalyst, cleanup, err := app.New(t.Context(), dir)
 	require.NoError(t, err)
 
+	require.NoError(t, catalyst.Start(t.Context()))
+
 	data.DefaultTestData(t, dir, catalyst.Queries)
 
 	return catalyst, cleanup, countEvents(catalyst.Hooks)
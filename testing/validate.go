@@ -79,6 +79,7 @@ func ValidateUpgradeTestData(t *testing.T, queries *sqlc.Queries) {
 		assert.Equal(t, "python", reaction.Action)
 		assert.Equal(t, "pocketbase", gjson.GetBytes(reaction.Actiondata, "requirements").String())
 		equalWithoutSpace(t, data.Script, gjson.GetBytes(reaction.Actiondata, "script").String())
+		assert.False(t, gjson.GetBytes(reaction.Actiondata, "permissions").Exists(), "existing reactions start without permissions")
 	}
 }
 
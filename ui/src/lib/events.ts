@@ -0,0 +1,93 @@
+import { onScopeDispose } from 'vue'
+
+import { useAuthStore } from '@/store/auth'
+
+export interface RecordEvent {
+  action: 'create' | 'update' | 'delete'
+  collection: string
+  record: Record<string, any>
+}
+
+const reconnectDelay = 3000
+
+// useRecordEvents streams the record changes of /api/events. EventSource
+// cannot send the Authorization header, so the stream is read with fetch.
+// The stream is resumed with the Last-Event-ID after a disconnect.
+export function useRecordEvents(
+  params: Record<string, string>,
+  onEvent: (event: RecordEvent) => void
+) {
+  const authStore = useAuthStore()
+  const controller = new AbortController()
+
+  let lastEventID = ''
+
+  const connect = async () => {
+    const headers: Record<string, string> = {
+      Accept: 'text/event-stream',
+      Authorization: `Bearer ${authStore.token}`
+    }
+    if (lastEventID) {
+      headers['Last-Event-ID'] = lastEventID
+    }
+
+    const response = await fetch('/api/events?' + new URLSearchParams(params), {
+      headers,
+      signal: controller.signal
+    })
+    if (!response.ok || !response.body) {
+      throw new Error(`failed to connect to event stream: ${response.status}`)
+    }
+
+    const reader = response.body.pipeThrough(new TextDecoderStream()).getReader()
+
+    let buffer = ''
+    for (;;) {
+      const { value, done } = await reader.read()
+      if (done) {
+        return
+      }
+
+      buffer += value
+
+      let end = buffer.indexOf('\n\n')
+      while (end !== -1) {
+        const message = buffer.slice(0, end)
+        buffer = buffer.slice(end + 2)
+        end = buffer.indexOf('\n\n')
+
+        let data = ''
+        for (const line of message.split('\n')) {
+          if (line.startsWith('id: ')) {
+            lastEventID = line.slice(4)
+          } else if (line.startsWith('data: ')) {
+            data += line.slice(6)
+          }
+        }
+
+        if (data) {
+          onEvent(JSON.parse(data))
+        }
+      }
+    }
+  }
+
+  const run = async () => {
+    while (!controller.signal.aborted) {
+      try {
+        await connect()
+      } catch (error) {
+        if (controller.signal.aborted) {
+          return
+        }
+        console.warn(error)
+      }
+
+      await new Promise((resolve) => setTimeout(resolve, reconnectDelay))
+    }
+  }
+
+  run()
+
+  onScopeDispose(() => controller.abort())
+}
@@ -0,0 +1,94 @@
+package main
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log/slog"
+	"os"
+
+	"github.com/urfave/cli/v3"
+)
+
+func venvList(ctx context.Context, command *cli.Command) error {
+	catalyst, cleanup, err := setup(ctx, command)
+	if err != nil {
+		return fmt.Errorf("failed to setup catalyst: %w", err)
+	}
+
+	defer cleanup()
+
+	environments, err := catalyst.Venvs.List()
+	if err != nil {
+		return err
+	}
+
+	for _, environment := range environments {
+		fmt.Printf("%s\t%s\t%d\t%s\n", environment.ID, environment.PythonVersion, environment.Size, environment.LastUsed.Format("2006-01-02 15:04:05"))
+	}
+
+	return nil
+}
+
+func venvPrewarm(ctx context.Context, command *cli.Command) error {
+	catalyst, cleanup, err := setup(ctx, command)
+	if err != nil {
+		return fmt.Errorf("failed to setup catalyst: %w", err)
+	}
+
+	defer cleanup()
+
+	if command.Args().Len() > 1 {
+		return errors.New("usage: catalyst venv prewarm [requirements.txt]")
+	}
+
+	var requirements []byte
+
+	if command.Args().Len() == 1 {
+		requirements, err = os.ReadFile(command.Args().Get(0))
+		if err != nil {
+			return fmt.Errorf("failed to read requirements: %w", err)
+		}
+	}
+
+	environment, err := catalyst.Venvs.Prewarm(ctx, string(requirements))
+	if err != nil {
+		return err
+	}
+
+	slog.InfoContext(ctx, "Python environment ready", "id", environment.ID, "python_version", environment.PythonVersion)
+
+	return nil
+}
+
+func venvPurge(ctx context.Context, command *cli.Command) error {
+	catalyst, cleanup, err := setup(ctx, command)
+	if err != nil {
+		return fmt.Errorf("failed to setup catalyst: %w", err)
+	}
+
+	defer cleanup()
+
+	if command.Args().Len() > 1 {
+		return errors.New("usage: catalyst venv purge [id]")
+	}
+
+	if command.Args().Len() == 1 {
+		if err := catalyst.Venvs.Delete(command.Args().Get(0)); err != nil {
+			return err
+		}
+
+		slog.InfoContext(ctx, "Removed Python environment", "id", command.Args().Get(0))
+
+		return nil
+	}
+
+	removed, err := catalyst.Venvs.Purge(ctx)
+	if err != nil {
+		return err
+	}
+
+	slog.InfoContext(ctx, "Removed Python environments", "count", len(removed))
+
+	return nil
+}